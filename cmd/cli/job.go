@@ -55,6 +55,13 @@ func buildJobCmd() *cobra.Command {
 			},
 			InitFlags: job.InitViewFlags,
 		},
+		"explain": {
+			Short: "explain the scheduling standing of a job",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, job.ExplainJob(cmd.Context()))
+			},
+			InitFlags: job.InitExplainFlags,
+		},
 		"suspend": {
 			Short: "abort a job",
 			RunFunction: func(cmd *cobra.Command, args []string) {
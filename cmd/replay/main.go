@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// replay re-executes recorded session snapshots with the current and a modified scheduler
+// configuration and prints the decisions which differ, e.g. to check the effect of a plugin
+// argument change before rolling it out.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/simulate"
+
+	// Import default actions/plugins.
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+)
+
+// snapshotDiff is the decisions which differ for one recorded snapshot.
+type snapshotDiff struct {
+	Snapshot string         `json:"snapshot"`
+	Diff     *simulate.Diff `json:"diff"`
+}
+
+func main() {
+	klog.InitFlags(nil)
+
+	fs := pflag.CommandLine
+	snapshotPaths := fs.StringSlice("snapshot", nil, "YAML files with the recorded nodes, pods, podgroups, queues and priority classes to replay")
+	oldConfPath := fs.String("scheduler-conf", "", "The scheduler configuration the snapshots were recorded with")
+	newConfPath := fs.String("new-scheduler-conf", "", "The modified scheduler configuration to compare")
+	sessions := fs.Int("sessions", 1, "The number of sessions to run per snapshot")
+	pflag.Parse()
+
+	if err := run(*snapshotPaths, *oldConfPath, *newConfPath, *sessions); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(snapshotPaths []string, oldConfPath, newConfPath string, sessions int) error {
+	if len(snapshotPaths) == 0 {
+		return fmt.Errorf("--snapshot is required")
+	}
+	if oldConfPath == "" || newConfPath == "" {
+		return fmt.Errorf("--scheduler-conf and --new-scheduler-conf are required")
+	}
+	oldConf, err := os.ReadFile(oldConfPath)
+	if err != nil {
+		return fmt.Errorf("failed to read scheduler configuration: %v", err)
+	}
+	newConf, err := os.ReadFile(newConfPath)
+	if err != nil {
+		return fmt.Errorf("failed to read new scheduler configuration: %v", err)
+	}
+
+	var diffs []snapshotDiff
+	for _, path := range snapshotPaths {
+		snapshot, err := simulate.LoadSnapshot(path)
+		if err != nil {
+			return fmt.Errorf("failed to load snapshot %s: %v", path, err)
+		}
+		oldResult, err := simulate.Run(snapshot, simulate.Options{SchedulerConf: string(oldConf), Sessions: sessions})
+		if err != nil {
+			return fmt.Errorf("failed to replay snapshot %s: %v", path, err)
+		}
+		newResult, err := simulate.Run(snapshot, simulate.Options{SchedulerConf: string(newConf), Sessions: sessions})
+		if err != nil {
+			return fmt.Errorf("failed to replay snapshot %s with the new configuration: %v", path, err)
+		}
+		diffs = append(diffs, snapshotDiff{Snapshot: path, Diff: simulate.DiffResults(oldResult, newResult)})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diffs)
+}
@@ -83,7 +83,7 @@ func Run(opt *options.ServerOption) error {
 	// k8smetrics.Goroutines which is used by Kubernetes scheduler framework plugins
 	metrics.InitKubeSchedulerRelatedMetrics()
 
-	if opt.EnableMetrics || opt.EnablePprof {
+	if opt.EnableMetrics || opt.EnablePprof || opt.EnablePluginDebug {
 		go startMetricsServer(opt)
 	}
 
@@ -169,6 +169,10 @@ func startMetricsServer(opt *options.ServerOption) {
 		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	}
 
+	if opt.EnablePluginDebug {
+		mux.Handle("/debug/plugins", framework.PluginDebugHandler())
+	}
+
 	server := &http.Server{
 		Addr:              opt.ListenAddress,
 		Handler:           mux,
@@ -178,7 +182,7 @@ func startMetricsServer(opt *options.ServerOption) {
 	}
 
 	if err := server.ListenAndServe(); err != nil {
-		klog.Errorf("start metrics/pprof http server failed: %v", err)
+		klog.Errorf("start metrics/pprof/debug http server failed: %v", err)
 	}
 }
 
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/scheduler/simulate"
+
+	// Import default actions/plugins.
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+)
+
+func main() {
+	klog.InitFlags(nil)
+
+	fs := pflag.CommandLine
+	snapshotPath := fs.String("snapshot", "", "YAML file with the nodes, pods, podgroups, queues and priority classes to simulate; the live cluster is read if empty")
+	confPath := fs.String("scheduler-conf", "", "The scheduler configuration file to simulate")
+	sessions := fs.Int("sessions", 1, "The number of sessions to run")
+	master := fs.String("master", "", "The address of the Kubernetes API server, used when --snapshot is empty")
+	kubeconfig := fs.String("kubeconfig", os.Getenv("KUBECONFIG"), "Path to kubeconfig file, used when --snapshot is empty")
+	pflag.Parse()
+
+	if err := run(*snapshotPath, *confPath, *sessions, *master, *kubeconfig); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(snapshotPath, confPath string, sessions int, master, kubeconfig string) error {
+	if confPath == "" {
+		return fmt.Errorf("--scheduler-conf is required")
+	}
+	confData, err := os.ReadFile(confPath)
+	if err != nil {
+		return fmt.Errorf("failed to read scheduler configuration: %v", err)
+	}
+
+	var snapshot *simulate.Snapshot
+	if snapshotPath != "" {
+		snapshot, err = simulate.LoadSnapshot(snapshotPath)
+	} else {
+		snapshot, err = loadClusterSnapshot(master, kubeconfig)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load snapshot: %v", err)
+	}
+
+	result, err := simulate.Run(snapshot, simulate.Options{SchedulerConf: string(confData), Sessions: sessions})
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+func loadClusterSnapshot(master, kubeconfig string) (*simulate.Snapshot, error) {
+	config, err := util.BuildConfig(master, kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	vcClient, err := vcclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return simulate.SnapshotFromCluster(context.TODO(), kubeClient, vcClient)
+}
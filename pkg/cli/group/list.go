@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/volcano/pkg/cli/util"
+)
+
+const (
+	// The ConfigMap written by the groupquota scheduler plugin with statusReport enabled.
+	defaultReportNamespace = "volcano-system"
+	defaultReportName      = "groupquota-status"
+	reportDataKey          = "report"
+
+	outputJSON = "json"
+	outputWide = "wide"
+)
+
+type listFlags struct {
+	util.CommonFlags
+
+	Namespace string
+	Name      string
+	Output    string
+}
+
+// StatusReport is the standing of every group reported by the groupquota scheduler plugin.
+type StatusReport struct {
+	UpdateTime metav1.Time            `json:"updateTime"`
+	Groups     map[string]GroupStatus `json:"groups"`
+}
+
+// GroupStatus is the standing of one group.
+type GroupStatus struct {
+	Usage             v1.ResourceList `json:"usage"`
+	Quota             v1.ResourceList `json:"quota"`
+	OverQuota         bool            `json:"overQuota"`
+	DeprioritizedJobs int             `json:"deprioritizedJobs"`
+	RejectedJobs      int             `json:"rejectedJobs"`
+}
+
+var listGroupFlags = &listFlags{}
+
+// InitListFlags inits all flags.
+func InitListFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &listGroupFlags.CommonFlags)
+
+	cmd.Flags().StringVarP(&listGroupFlags.Namespace, "report-namespace", "", defaultReportNamespace, "the namespace of the groupquota status report")
+	cmd.Flags().StringVarP(&listGroupFlags.Name, "report-name", "", defaultReportName, "the name of the groupquota status report")
+	cmd.Flags().StringVarP(&listGroupFlags.Output, "output", "o", "", "the format of output, json or wide")
+}
+
+// ListGroups lists the usage and quota of every group.
+func ListGroups(ctx context.Context) error {
+	if listGroupFlags.Output != "" && listGroupFlags.Output != outputJSON && listGroupFlags.Output != outputWide {
+		return fmt.Errorf("unknown output format %q, expected %s or %s", listGroupFlags.Output, outputJSON, outputWide)
+	}
+
+	config, err := util.BuildConfig(listGroupFlags.Master, listGroupFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	kubeClient := kubernetes.NewForConfigOrDie(config)
+	cm, err := kubeClient.CoreV1().ConfigMaps(listGroupFlags.Namespace).Get(ctx, listGroupFlags.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get the groupquota status report, is statusReport enabled in the scheduler: %v", err)
+	}
+
+	report := &StatusReport{}
+	if err := json.Unmarshal([]byte(cm.Data[reportDataKey]), report); err != nil {
+		return fmt.Errorf("failed to decode the groupquota status report: %v", err)
+	}
+
+	if len(report.Groups) == 0 {
+		fmt.Printf("No resources found\n")
+		return nil
+	}
+
+	return PrintGroups(report, listGroupFlags.Output, os.Stdout)
+}
+
+// PrintGroups prints the usage and quota of every group, one line per group and resource.
+func PrintGroups(report *StatusReport, output string, writer io.Writer) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	wide := output == outputWide
+	if wide {
+		_, err := fmt.Fprintf(writer, "%-25s%-25s%-12s%-12s%-11s%-15s%-10s\n",
+			"Name", "Resource", "Usage", "Quota", "OverQuota", "Deprioritized", "Rejected")
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := fmt.Fprintf(writer, "%-25s%-25s%-12s%-12s%-11s\n", "Name", "Resource", "Usage", "Quota", "OverQuota")
+		if err != nil {
+			return err
+		}
+	}
+
+	groups := make([]string, 0, len(report.Groups))
+	for group := range report.Groups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	for _, group := range groups {
+		status := report.Groups[group]
+		for _, name := range resourceNames(status) {
+			usage, quota := "-", "-"
+			if q, found := status.Usage[name]; found {
+				usage = q.String()
+			}
+			if q, found := status.Quota[name]; found {
+				quota = q.String()
+			}
+
+			var err error
+			if wide {
+				_, err = fmt.Fprintf(writer, "%-25s%-25s%-12s%-12s%-11t%-15d%-10d\n",
+					group, name, usage, quota, status.OverQuota, status.DeprioritizedJobs, status.RejectedJobs)
+			} else {
+				_, err = fmt.Fprintf(writer, "%-25s%-25s%-12s%-12s%-11t\n", group, name, usage, quota, status.OverQuota)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resourceNames returns the resources either used or limited by the group, sorted.
+func resourceNames(status GroupStatus) []v1.ResourceName {
+	seen := map[v1.ResourceName]bool{}
+	for name := range status.Usage {
+		seen[name] = true
+	}
+	for name := range status.Quota {
+		seen[name] = true
+	}
+	names := make([]v1.ResourceName, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
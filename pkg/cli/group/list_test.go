@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestPrintGroups(t *testing.T) {
+	report := &StatusReport{Groups: map[string]GroupStatus{
+		"team-b": {
+			Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+		},
+		"team-a": {
+			Usage:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("6"), "nvidia.com/gpu": resource.MustParse("1")},
+			Quota:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+			OverQuota:    true,
+			RejectedJobs: 2,
+		},
+	}}
+
+	tests := []struct {
+		name        string
+		output      string
+		expectLines [][]string
+	}{
+		{
+			name:   "default output",
+			output: "",
+			expectLines: [][]string{
+				{"Name", "Resource", "Usage", "Quota", "OverQuota"},
+				{"team-a", "cpu", "6", "4", "true"},
+				{"team-a", "nvidia.com/gpu", "1", "-", "true"},
+				{"team-b", "cpu", "2", "-", "false"},
+			},
+		},
+		{
+			name:   "wide output",
+			output: outputWide,
+			expectLines: [][]string{
+				{"Name", "Resource", "Usage", "Quota", "OverQuota", "Deprioritized", "Rejected"},
+				{"team-a", "cpu", "6", "4", "true", "0", "2"},
+				{"team-a", "nvidia.com/gpu", "1", "-", "true", "0", "2"},
+				{"team-b", "cpu", "2", "-", "false", "0", "0"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := PrintGroups(report, test.output, &buf); err != nil {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			if len(lines) != len(test.expectLines) {
+				t.Fatalf("expected %d lines, got %q", len(test.expectLines), buf.String())
+			}
+			for i, line := range lines {
+				if got := strings.Fields(line); strings.Join(got, " ") != strings.Join(test.expectLines[i], " ") {
+					t.Errorf("line %d: expected %v, got %v", i, test.expectLines[i], got)
+				}
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := PrintGroups(report, outputJSON, &buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded := &StatusReport{}
+	if err := json.Unmarshal(buf.Bytes(), decoded); err != nil {
+		t.Fatalf("invalid json output %s: %v", buf.String(), err)
+	}
+	if !decoded.Groups["team-a"].OverQuota || len(decoded.Groups) != 2 {
+		t.Errorf("unexpected json output %s", buf.String())
+	}
+}
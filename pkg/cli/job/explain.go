@@ -0,0 +1,311 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	coreV1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/util"
+)
+
+type explainFlags struct {
+	util.CommonFlags
+
+	Namespace string
+	JobName   string
+
+	// GroupAnnotation is the PodGroup annotation holding the group of the groupquota plugin.
+	GroupAnnotation string
+	// StatusNamespace and StatusName locate the ConfigMap of the groupquota status report.
+	StatusNamespace string
+	StatusName      string
+}
+
+const (
+	defaultGroupAnnotation = "example.com/group"
+	defaultStatusNamespace = "volcano-system"
+	defaultStatusName      = "groupquota-status"
+	// groupQuotaReportKey is the data key of the groupquota status report ConfigMap.
+	groupQuotaReportKey = "report"
+	// maxBlockingJobs is the maximum number of jobs printed as waiting ahead of the job.
+	maxBlockingJobs = 10
+)
+
+var explainJobFlags = &explainFlags{}
+
+// InitExplainFlags init the explain command flags.
+func InitExplainFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &explainJobFlags.CommonFlags)
+
+	cmd.Flags().StringVarP(&explainJobFlags.Namespace, "namespace", "n", "default", "the namespace of job")
+	cmd.Flags().StringVarP(&explainJobFlags.JobName, "name", "N", "", "the name of job")
+	cmd.Flags().StringVar(&explainJobFlags.GroupAnnotation, "group-annotation", defaultGroupAnnotation, "the podgroup annotation holding the group of the groupquota plugin")
+	cmd.Flags().StringVar(&explainJobFlags.StatusNamespace, "status-namespace", defaultStatusNamespace, "the namespace of the groupquota status report configmap")
+	cmd.Flags().StringVar(&explainJobFlags.StatusName, "status-name", defaultStatusName, "the name of the groupquota status report configmap")
+}
+
+// GroupQuotaReport is the part of the groupquota status report used to explain a job.
+type GroupQuotaReport struct {
+	UpdateTime metav1.Time                 `json:"updateTime"`
+	Groups     map[string]GroupQuotaStatus `json:"groups"`
+}
+
+// GroupQuotaStatus is the standing of one group in the groupquota status report.
+type GroupQuotaStatus struct {
+	Usage            coreV1.ResourceList `json:"usage"`
+	Quota            coreV1.ResourceList `json:"quota"`
+	OverQuota        bool                `json:"overQuota"`
+	RejectedJobNames []string            `json:"rejectedJobNames"`
+}
+
+// JobExplanation is the scheduling standing of a job.
+type JobExplanation struct {
+	Job      string
+	Phase    string
+	PodGroup string
+	PGPhase  v1beta1.PodGroupPhase
+	Queue    string
+	Priority int32
+	// Conditions are the scheduling conditions of the PodGroup, e.g. the plugin rejecting it.
+	Conditions []v1beta1.PodGroupCondition
+
+	// Group is the group of the groupquota plugin, empty if the job has none.
+	Group       string
+	GroupStatus *GroupQuotaStatus
+	// GroupRejected is whether the groupquota plugin rejected the enqueue of the job.
+	GroupRejected bool
+	ReportTime    metav1.Time
+
+	// BlockedBy are the jobs of the same queue waiting ahead of the job.
+	BlockedBy []string
+	// Preemptable are the running jobs of the same queue with a lower priority.
+	Preemptable []string
+	// PreemptionNever is whether the priority class of the job forbids preemption.
+	PreemptionNever bool
+}
+
+// ExplainJob prints why a job is, or is not, scheduled.
+func ExplainJob(ctx context.Context) error {
+	config, err := util.BuildConfig(explainJobFlags.Master, explainJobFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	if explainJobFlags.JobName == "" {
+		err := fmt.Errorf("job name (specified by --name or -N) is mandatory to explain a particular job")
+		return err
+	}
+
+	vcClient := versioned.NewForConfigOrDie(config)
+	kubeClient := kubernetes.NewForConfigOrDie(config)
+
+	job, err := vcClient.BatchV1alpha1().Jobs(explainJobFlags.Namespace).Get(ctx, explainJobFlags.JobName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pgName := fmt.Sprintf("%s-%s", job.Name, job.UID)
+	pg, err := vcClient.SchedulingV1beta1().PodGroups(job.Namespace).Get(ctx, pgName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get podgroup %s of job %s/%s: %v", pgName, job.Namespace, job.Name, err)
+	}
+	queuePGs, err := vcClient.SchedulingV1beta1().PodGroups("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list podgroups: %v", err)
+	}
+	pcs, err := kubeClient.SchedulingV1().PriorityClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list priority classes: %v", err)
+	}
+
+	var report *GroupQuotaReport
+	cm, err := kubeClient.CoreV1().ConfigMaps(explainJobFlags.StatusNamespace).Get(ctx, explainJobFlags.StatusName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		report = &GroupQuotaReport{}
+		if err := json.Unmarshal([]byte(cm.Data[groupQuotaReportKey]), report); err != nil {
+			return fmt.Errorf("failed to decode groupquota status report: %v", err)
+		}
+	case !apierrors.IsNotFound(err):
+		return fmt.Errorf("failed to get groupquota status report: %v", err)
+	}
+
+	explanation := BuildJobExplanation(job, pg, queuePGs.Items, pcs.Items, report, explainJobFlags.GroupAnnotation)
+	PrintJobExplanation(explanation, os.Stdout)
+
+	events, err := kubeClient.CoreV1().Events(job.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", pg.Name).String(),
+	})
+	if err == nil {
+		PrintEvents(events.Items, os.Stdout)
+	}
+	return nil
+}
+
+// BuildJobExplanation computes the standing of the job from its PodGroup, the PodGroups of
+// every queue, the priority classes and the groupquota status report, which may be nil.
+func BuildJobExplanation(job *v1alpha1.Job, pg *v1beta1.PodGroup, pgs []v1beta1.PodGroup, pcs []schedulingv1.PriorityClass,
+	report *GroupQuotaReport, groupAnnotation string) *JobExplanation {
+	priorities := make(map[string]int32, len(pcs))
+	never := make(map[string]bool, len(pcs))
+	var defaultPriority int32
+	for _, pc := range pcs {
+		priorities[pc.Name] = pc.Value
+		never[pc.Name] = pc.PreemptionPolicy != nil && *pc.PreemptionPolicy == coreV1.PreemptNever
+		if pc.GlobalDefault {
+			defaultPriority = pc.Value
+		}
+	}
+	priorityOf := func(pg *v1beta1.PodGroup) int32 {
+		if value, found := priorities[pg.Spec.PriorityClassName]; found {
+			return value
+		}
+		return defaultPriority
+	}
+
+	explanation := &JobExplanation{
+		Job:             job.Namespace + "/" + job.Name,
+		Phase:           string(job.Status.State.Phase),
+		PodGroup:        pg.Name,
+		PGPhase:         pg.Status.Phase,
+		Queue:           pg.Spec.Queue,
+		Priority:        priorityOf(pg),
+		Conditions:      pg.Status.Conditions,
+		Group:           pg.Annotations[groupAnnotation],
+		PreemptionNever: never[pg.Spec.PriorityClassName],
+	}
+
+	if report != nil && explanation.Group != "" {
+		explanation.ReportTime = report.UpdateTime
+		if status, found := report.Groups[explanation.Group]; found {
+			explanation.GroupStatus = &status
+			for _, name := range status.RejectedJobNames {
+				if name == pg.Namespace+"/"+pg.Name {
+					explanation.GroupRejected = true
+				}
+			}
+		}
+	}
+
+	waiting := pg.Status.Phase == v1beta1.PodGroupPending || pg.Status.Phase == v1beta1.PodGroupInqueue
+	for i := range pgs {
+		other := &pgs[i]
+		if other.Spec.Queue != pg.Spec.Queue || (other.Namespace == pg.Namespace && other.Name == pg.Name) {
+			continue
+		}
+		name := other.Namespace + "/" + other.Name
+		priority := priorityOf(other)
+		switch other.Status.Phase {
+		case v1beta1.PodGroupPending, v1beta1.PodGroupInqueue:
+			if !waiting {
+				continue
+			}
+			if priority > explanation.Priority ||
+				(priority == explanation.Priority && other.CreationTimestamp.Before(&pg.CreationTimestamp)) {
+				explanation.BlockedBy = append(explanation.BlockedBy, name)
+			}
+		case v1beta1.PodGroupRunning:
+			if priority < explanation.Priority {
+				explanation.Preemptable = append(explanation.Preemptable, name)
+			}
+		}
+	}
+	sort.Strings(explanation.BlockedBy)
+	sort.Strings(explanation.Preemptable)
+	return explanation
+}
+
+// PrintJobExplanation prints the standing of the job into writer.
+func PrintJobExplanation(explanation *JobExplanation, writer io.Writer) {
+	WriteLine(writer, Level0, "Job:      \t%s\n", explanation.Job)
+	WriteLine(writer, Level0, "Phase:    \t%s\n", explanation.Phase)
+	WriteLine(writer, Level0, "PodGroup: \t%s (%s)\n", explanation.PodGroup, explanation.PGPhase)
+	WriteLine(writer, Level0, "Queue:    \t%s\n", explanation.Queue)
+	WriteLine(writer, Level0, "Priority: \t%d\n", explanation.Priority)
+
+	WriteLine(writer, Level0, "Conditions:\n")
+	if len(explanation.Conditions) == 0 {
+		WriteLine(writer, Level1, "<none>\n")
+	}
+	for _, c := range explanation.Conditions {
+		WriteLine(writer, Level1, "%s=%s\t%s\t%s\n", c.Type, c.Status, c.Reason, strings.TrimSpace(c.Message))
+	}
+
+	WriteLine(writer, Level0, "Group Quota:\n")
+	switch {
+	case explanation.Group == "":
+		WriteLine(writer, Level1, "<no group>\n")
+	case explanation.GroupStatus == nil:
+		WriteLine(writer, Level1, "Group:\t%s (no status reported)\n", explanation.Group)
+	default:
+		status := explanation.GroupStatus
+		WriteLine(writer, Level1, "Group:     \t%s\n", explanation.Group)
+		WriteLine(writer, Level1, "Over Quota:\t%t\n", status.OverQuota)
+		WriteLine(writer, Level1, "Rejected:  \t%t\n", explanation.GroupRejected)
+		names := make([]string, 0, len(status.Quota))
+		for name := range status.Quota {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			usage := status.Usage[coreV1.ResourceName(name)]
+			quota := status.Quota[coreV1.ResourceName(name)]
+			WriteLine(writer, Level1, "%s:\t%s/%s\n", name, usage.String(), quota.String())
+		}
+		WriteLine(writer, Level1, "Reported:  \t%s\n", util.TranslateTimestampSince(explanation.ReportTime))
+	}
+
+	WriteLine(writer, Level0, "Waiting Behind:\n")
+	printJobNames(writer, explanation.BlockedBy)
+
+	WriteLine(writer, Level0, "Preemption Candidates:\n")
+	if explanation.PreemptionNever {
+		WriteLine(writer, Level1, "<disabled by the priority class of the job>\n")
+	} else {
+		printJobNames(writer, explanation.Preemptable)
+	}
+}
+
+func printJobNames(writer io.Writer, names []string) {
+	if len(names) == 0 {
+		WriteLine(writer, Level1, "<none>\n")
+		return
+	}
+	for i, name := range names {
+		if i == maxBlockingJobs {
+			WriteLine(writer, Level1, "... and %d more\n", len(names)-maxBlockingJobs)
+			break
+		}
+		WriteLine(writer, Level1, "%s\n", name)
+	}
+}
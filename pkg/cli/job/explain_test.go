@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func buildExplainPodGroup(name, priorityClass string, phase v1beta1.PodGroupPhase, created time.Time, annotations map[string]string) v1beta1.PodGroup {
+	return v1beta1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns1",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations:       annotations,
+		},
+		Spec: v1beta1.PodGroupSpec{
+			Queue:             "q1",
+			PriorityClassName: priorityClass,
+		},
+		Status: v1beta1.PodGroupStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestBuildJobExplanation(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	group := map[string]string{defaultGroupAnnotation: "team-a"}
+
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "job1", UID: "uid1"},
+		Status:     v1alpha1.JobStatus{State: v1alpha1.JobState{Phase: v1alpha1.Pending}},
+	}
+	pg := buildExplainPodGroup("job1-uid1", "medium", v1beta1.PodGroupPending, created, group)
+	pg.Status.Conditions = []v1beta1.PodGroupCondition{{
+		Type:    v1beta1.PodGroupUnschedulableType,
+		Status:  v1.ConditionTrue,
+		Reason:  "JobInvalid",
+		Message: "plugin gang: 1/2 tasks in gang unschedulable",
+	}}
+
+	pgs := []v1beta1.PodGroup{
+		pg,
+		buildExplainPodGroup("high-pending", "high", v1beta1.PodGroupInqueue, created.Add(time.Hour), nil),
+		buildExplainPodGroup("medium-older", "medium", v1beta1.PodGroupPending, created.Add(-time.Hour), nil),
+		buildExplainPodGroup("medium-newer", "medium", v1beta1.PodGroupPending, created.Add(time.Hour), nil),
+		buildExplainPodGroup("low-running", "low", v1beta1.PodGroupRunning, created, nil),
+		buildExplainPodGroup("high-running", "high", v1beta1.PodGroupRunning, created, nil),
+	}
+	other := buildExplainPodGroup("other-queue", "high", v1beta1.PodGroupPending, created, nil)
+	other.Spec.Queue = "q2"
+	pgs = append(pgs, other)
+
+	pcs := []schedulingv1.PriorityClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 1000},
+		{ObjectMeta: metav1.ObjectMeta{Name: "medium"}, Value: 100},
+		{ObjectMeta: metav1.ObjectMeta{Name: "low"}, Value: 10},
+	}
+	report := &GroupQuotaReport{
+		Groups: map[string]GroupQuotaStatus{
+			"team-a": {
+				Usage:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+				Quota:            v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")},
+				OverQuota:        true,
+				RejectedJobNames: []string{"ns1/job1-uid1"},
+			},
+		},
+	}
+
+	explanation := BuildJobExplanation(job, &pg, pgs, pcs, report, defaultGroupAnnotation)
+	if explanation.Priority != 100 {
+		t.Errorf("expected priority 100, got %d", explanation.Priority)
+	}
+	if explanation.Group != "team-a" || explanation.GroupStatus == nil || !explanation.GroupRejected {
+		t.Errorf("expected the job to be rejected by the quota of team-a, got %+v", explanation)
+	}
+	if expected := []string{"ns1/high-pending", "ns1/medium-older"}; !reflect.DeepEqual(explanation.BlockedBy, expected) {
+		t.Errorf("expected blocked by %v, got %v", expected, explanation.BlockedBy)
+	}
+	if expected := []string{"ns1/low-running"}; !reflect.DeepEqual(explanation.Preemptable, expected) {
+		t.Errorf("expected preemption candidates %v, got %v", expected, explanation.Preemptable)
+	}
+
+	buf := &bytes.Buffer{}
+	PrintJobExplanation(explanation, buf)
+	for _, expected := range []string{"JobInvalid", "plugin gang", "Over Quota:\ttrue", "cpu:\t4/4", "ns1/medium-older"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, buf.String())
+		}
+	}
+}
+
+func TestBuildJobExplanationPreemptNever(t *testing.T) {
+	never := v1.PreemptNever
+	job := &v1alpha1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "job1", UID: "uid1"}}
+	pg := buildExplainPodGroup("job1-uid1", "batch", v1beta1.PodGroupPending, time.Now(), nil)
+	pcs := []schedulingv1.PriorityClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "batch"}, Value: 100, PreemptionPolicy: &never},
+	}
+
+	explanation := BuildJobExplanation(job, &pg, []v1beta1.PodGroup{pg}, pcs, nil, defaultGroupAnnotation)
+	if !explanation.PreemptionNever {
+		t.Errorf("expected preemption to be disabled by the priority class")
+	}
+	buf := &bytes.Buffer{}
+	PrintJobExplanation(explanation, buf)
+	if !strings.Contains(buf.String(), "<no group>") {
+		t.Errorf("expected no group in output, got:\n%s", buf.String())
+	}
+}
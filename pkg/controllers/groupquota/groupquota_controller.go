@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglisters "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota/accounting"
+)
+
+func init() {
+	framework.RegisterController(&groupQuotaController{})
+}
+
+const (
+	controllerName = "groupquota-controller"
+
+	// The ConfigMap the groupquota scheduler plugin writes its status report to, with
+	// statusReport enabled and left to its default namespace and name.
+	statusReportNamespace = "volcano-system"
+	statusReportName      = "groupquota-status"
+	statusReportDataKey   = "report"
+)
+
+// statusReport is the part of the status report of the groupquota scheduler plugin
+// used by the controller.
+type statusReport struct {
+	UpdateTime metav1.Time            `json:"updateTime"`
+	Groups     map[string]groupStatus `json:"groups"`
+}
+
+type groupStatus struct {
+	Usage     v1.ResourceList `json:"usage"`
+	OverQuota bool            `json:"overQuota"`
+}
+
+// groupQuotaController copies the usage of each group reported by the scheduler to the
+// status of its GroupQuota object, and releases the pods gated by the groupquota admission
+// webhook once their group is back under its hard quota.
+type groupQuotaController struct {
+	kubeClient        kubernetes.Interface
+	vcClient          vcclientset.Interface
+	informerFactory   informers.SharedInformerFactory
+	vcInformerFactory vcinformer.SharedInformerFactory
+
+	groupQuotaLister schedulinglisters.GroupQuotaLister
+	configMapLister  corelisters.ConfigMapLister
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Name returns the name of the controller.
+func (c *groupQuotaController) Name() string {
+	return controllerName
+}
+
+// Initialize initializes the controller.
+func (c *groupQuotaController) Initialize(opt *framework.ControllerOption) error {
+	c.kubeClient = opt.KubeClient
+	c.vcClient = opt.VolcanoClient
+	c.informerFactory = opt.SharedInformerFactory
+	c.vcInformerFactory = opt.VCSharedInformerFactory
+	c.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	groupQuotaInformer := c.vcInformerFactory.Scheduling().V1beta1().GroupQuotas()
+	c.groupQuotaLister = groupQuotaInformer.Lister()
+	groupQuotaInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueGroupQuota,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.enqueueGroupQuota(newObj)
+		},
+		DeleteFunc: c.deleteGroupQuota,
+	})
+
+	// Only list/watch the ConfigMap of the status report.
+	configMapInformer := coreinformers.NewFilteredConfigMapInformer(c.kubeClient, statusReportNamespace, 0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fmt.Sprintf("metadata.name=%s", statusReportName)
+		})
+	c.configMapLister = corelisters.NewConfigMapLister(configMapInformer.GetIndexer())
+	configMapInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueAll,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.enqueueAll(newObj)
+		},
+		DeleteFunc: c.enqueueAll,
+	})
+	c.informerFactory.InformerFor(&v1.ConfigMap{}, func(kubernetes.Interface, time.Duration) cache.SharedIndexInformer {
+		return configMapInformer
+	})
+
+	return nil
+}
+
+// Run starts the controller.
+func (c *groupQuotaController) Run(stopCh <-chan struct{}) {
+	c.informerFactory.Start(stopCh)
+	c.vcInformerFactory.Start(stopCh)
+	for informerType, ok := range c.informerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.ErrorS(nil, "Failed to sync informer cache", "informerType", informerType)
+			return
+		}
+	}
+	for informerType, ok := range c.vcInformerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.ErrorS(nil, "Failed to sync informer cache", "informerType", informerType)
+			return
+		}
+	}
+
+	klog.V(3).InfoS("groupQuotaController starting")
+
+	ctx := wait.ContextForChannel(stopCh)
+	go func() {
+		defer runtime.HandleCrash()
+		wait.UntilWithContext(ctx, c.processQueue, time.Second)
+	}()
+	<-ctx.Done()
+	c.queue.ShutDown()
+
+	klog.V(3).InfoS("groupQuotaController stopped")
+}
+
+func (c *groupQuotaController) enqueueGroupQuota(obj interface{}) {
+	gq, ok := obj.(*schedulingv1beta1.GroupQuota)
+	if !ok {
+		klog.ErrorS(nil, "Cannot convert to *v1beta1.GroupQuota", "obj", obj)
+		return
+	}
+	c.queue.Add(gq.Name)
+}
+
+// deleteGroupQuota releases the pods gated by the admission webhook for the group of the
+// deleted GroupQuota, which has no hard quota anymore.
+func (c *groupQuotaController) deleteGroupQuota(obj interface{}) {
+	gq, ok := obj.(*schedulingv1beta1.GroupQuota)
+	if !ok {
+		tombstone, isTombstone := obj.(cache.DeletedFinalStateUnknown)
+		if !isTombstone {
+			klog.ErrorS(nil, "Cannot convert to *v1beta1.GroupQuota", "obj", obj)
+			return
+		}
+		gq, ok = tombstone.Obj.(*schedulingv1beta1.GroupQuota)
+		if !ok {
+			klog.ErrorS(nil, "Cannot convert tombstone to *v1beta1.GroupQuota", "obj", tombstone.Obj)
+			return
+		}
+	}
+	if err := c.releaseGatedPods(context.TODO(), gq.Spec.Group); err != nil {
+		klog.ErrorS(err, "Failed to release gated pods of deleted GroupQuota", "groupQuota", gq.Name)
+	}
+}
+
+// enqueueAll enqueues every GroupQuota object, as a new status report may change all of them.
+func (c *groupQuotaController) enqueueAll(obj interface{}) {
+	gqs, err := c.groupQuotaLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list GroupQuotas")
+		return
+	}
+	for _, gq := range gqs {
+		c.queue.Add(gq.Name)
+	}
+}
+
+func (c *groupQuotaController) processQueue(ctx context.Context) {
+	for {
+		key, quit := c.queue.Get()
+		if quit {
+			return
+		}
+
+		func() {
+			defer c.queue.Done(key)
+
+			if err := c.syncGroupQuota(ctx, key); err != nil {
+				klog.ErrorS(err, "Failed to sync GroupQuota", "name", key)
+				c.queue.AddRateLimited(key)
+				return
+			}
+			c.queue.Forget(key)
+		}()
+	}
+}
+
+// syncGroupQuota sets the status of the GroupQuota object from the latest status report.
+func (c *groupQuotaController) syncGroupQuota(ctx context.Context, name string) error {
+	gq, err := c.groupQuotaLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get GroupQuota: %w", err)
+	}
+
+	report, err := c.loadStatusReport()
+	if err != nil {
+		return err
+	}
+	if report == nil {
+		klog.V(4).InfoS("No groupquota status report yet", "groupQuota", name)
+		return nil
+	}
+
+	updated := gq.DeepCopy()
+	updated.Status = desiredStatus(gq, report)
+	if !equality.Semantic.DeepEqual(gq.Status, updated.Status) {
+		if _, err := c.vcClient.SchedulingV1beta1().GroupQuotas().UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update status of GroupQuota: %w", err)
+		}
+		klog.V(4).InfoS("Updated GroupQuota status", "groupQuota", name, "overQuota", updated.Status.OverQuota)
+	}
+
+	if accounting.IsOverHardQuota(updated) {
+		return nil
+	}
+	return c.releaseGatedPods(ctx, gq.Spec.Group)
+}
+
+// releaseGatedPods removes the scheduling gate added by the groupquota admission webhook
+// from the pods of the group.
+func (c *groupQuotaController) releaseGatedPods(ctx context.Context, group string) error {
+	pods, err := c.kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: accounting.GatedLabelKey + "=true",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list gated pods: %w", err)
+	}
+
+	var errs []error
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Annotations[accounting.GatedGroupAnnotationKey] != group {
+			continue
+		}
+
+		updated := pod.DeepCopy()
+		updated.Spec.SchedulingGates = nil
+		for _, gate := range pod.Spec.SchedulingGates {
+			if gate.Name != accounting.SchedulingGateName {
+				updated.Spec.SchedulingGates = append(updated.Spec.SchedulingGates, gate)
+			}
+		}
+		delete(updated.Labels, accounting.GatedLabelKey)
+		delete(updated.Annotations, accounting.GatedGroupAnnotationKey)
+		if _, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release pod %s/%s: %w", pod.Namespace, pod.Name, err))
+			continue
+		}
+		klog.V(3).InfoS("Released gated pod", "pod", klog.KObj(pod), "group", group)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// loadStatusReport returns the status report of the scheduler, or nil if there is none.
+func (c *groupQuotaController) loadStatusReport() (*statusReport, error) {
+	cm, err := c.configMapLister.ConfigMaps(statusReportNamespace).Get(statusReportName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status report ConfigMap: %w", err)
+	}
+	data, found := cm.Data[statusReportDataKey]
+	if !found {
+		return nil, nil
+	}
+
+	report := &statusReport{}
+	if err := json.Unmarshal([]byte(data), report); err != nil {
+		// A malformed report does not get better by retrying, wait for the next one.
+		klog.ErrorS(err, "Failed to decode groupquota status report")
+		return nil, nil
+	}
+	return report, nil
+}
+
+// desiredStatus returns the status of the GroupQuota object in the report. A group absent
+// from the report has no job using resources.
+func desiredStatus(gq *schedulingv1beta1.GroupQuota, report *statusReport) schedulingv1beta1.GroupQuotaStatus {
+	updateTime := report.UpdateTime
+	status := schedulingv1beta1.GroupQuotaStatus{
+		LastUpdateTime: &updateTime,
+	}
+	if group, found := report.Groups[gq.Spec.Group]; found {
+		status.Usage = group.Usage
+		status.OverQuota = group.OverQuota
+	}
+	return status
+}
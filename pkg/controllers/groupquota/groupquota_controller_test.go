@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakevcclientset "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/controllers/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota/accounting"
+)
+
+func newFakeController(t *testing.T, report string, gqs ...*schedulingv1beta1.GroupQuota) *groupQuotaController {
+	var kubeObjects []runtime.Object
+	if report != "" {
+		kubeObjects = append(kubeObjects, &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: statusReportNamespace, Name: statusReportName},
+			Data:       map[string]string{statusReportDataKey: report},
+		})
+	}
+	var vcObjects []runtime.Object
+	for _, gq := range gqs {
+		vcObjects = append(vcObjects, gq)
+	}
+	kubeClient := fake.NewSimpleClientset(kubeObjects...)
+	vcClient := fakevcclientset.NewSimpleClientset(vcObjects...)
+
+	c := &groupQuotaController{}
+	opt := &framework.ControllerOption{
+		KubeClient:              kubeClient,
+		VolcanoClient:           vcClient,
+		SharedInformerFactory:   informers.NewSharedInformerFactory(kubeClient, 0),
+		VCSharedInformerFactory: vcinformer.NewSharedInformerFactory(vcClient, 0),
+	}
+	if err := c.Initialize(opt); err != nil {
+		t.Fatalf("failed to initialize controller: %v", err)
+	}
+
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	opt.SharedInformerFactory.Start(stopCh)
+	opt.VCSharedInformerFactory.Start(stopCh)
+	opt.SharedInformerFactory.WaitForCacheSync(stopCh)
+	opt.VCSharedInformerFactory.WaitForCacheSync(stopCh)
+	return c
+}
+
+func TestSyncGroupQuota(t *testing.T) {
+	reportTime := metav1.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := `{"updateTime":"2026-01-01T00:00:00Z","groups":{"a":{"usage":{"cpu":"3"},"quota":{"cpu":"2"},"overQuota":true}}}`
+	tests := []struct {
+		name         string
+		report       string
+		group        string
+		expectStatus schedulingv1beta1.GroupQuotaStatus
+	}{
+		{
+			name:   "group in the report",
+			report: report,
+			group:  "a",
+			expectStatus: schedulingv1beta1.GroupQuotaStatus{
+				Usage:          v1.ResourceList{v1.ResourceCPU: resource.MustParse("3")},
+				OverQuota:      true,
+				LastUpdateTime: &reportTime,
+			},
+		},
+		{
+			name:   "group without usage",
+			report: report,
+			group:  "b",
+			expectStatus: schedulingv1beta1.GroupQuotaStatus{
+				LastUpdateTime: &reportTime,
+			},
+		},
+		{
+			name:  "no report",
+			group: "a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gq := &schedulingv1beta1.GroupQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "gq"},
+				Spec:       schedulingv1beta1.GroupQuotaSpec{Group: test.group},
+			}
+			c := newFakeController(t, test.report, gq)
+
+			assert.NoError(t, c.syncGroupQuota(context.TODO(), "gq"))
+			got, err := c.vcClient.SchedulingV1beta1().GroupQuotas().Get(context.TODO(), "gq", metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectStatus.OverQuota, got.Status.OverQuota)
+			assert.True(t, test.expectStatus.Usage.Cpu().Equal(*got.Status.Usage.Cpu()), "usage %v", got.Status.Usage)
+			if test.expectStatus.LastUpdateTime == nil {
+				assert.Nil(t, got.Status.LastUpdateTime)
+			} else {
+				assert.True(t, test.expectStatus.LastUpdateTime.Equal(got.Status.LastUpdateTime))
+			}
+		})
+	}
+}
+
+func TestSyncDeletedGroupQuota(t *testing.T) {
+	c := newFakeController(t, "")
+	assert.NoError(t, c.syncGroupQuota(context.TODO(), "missing"))
+}
+
+func TestReleaseGatedPods(t *testing.T) {
+	gatedPod := func(name, group string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns1",
+				Name:        name,
+				Labels:      map[string]string{accounting.GatedLabelKey: "true"},
+				Annotations: map[string]string{accounting.GatedGroupAnnotationKey: group},
+			},
+			Spec: v1.PodSpec{SchedulingGates: []v1.PodSchedulingGate{{Name: "other"}, {Name: accounting.SchedulingGateName}}},
+		}
+	}
+	report := `{"updateTime":"2026-01-01T00:00:00Z","groups":{"a":{"usage":{"cpu":"1"}},"b":{"usage":{"cpu":"3"}}}}`
+	hardQuota := func(name, group string) *schedulingv1beta1.GroupQuota {
+		return &schedulingv1beta1.GroupQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: schedulingv1beta1.GroupQuotaSpec{
+				Group:           group,
+				Limits:          v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+				BorrowingPolicy: schedulingv1beta1.BorrowingPolicyNever,
+			},
+		}
+	}
+	c := newFakeController(t, report, hardQuota("gq-a", "a"), hardQuota("gq-b", "b"))
+	for _, pod := range []*v1.Pod{gatedPod("pod-a", "a"), gatedPod("pod-b", "b")} {
+		if _, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.NoError(t, c.syncGroupQuota(context.TODO(), "gq-a"))
+	assert.NoError(t, c.syncGroupQuota(context.TODO(), "gq-b"))
+
+	released, err := c.kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "pod-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1.PodSchedulingGate{{Name: "other"}}, released.Spec.SchedulingGates)
+	assert.NotContains(t, released.Labels, accounting.GatedLabelKey)
+	assert.NotContains(t, released.Annotations, accounting.GatedGroupAnnotationKey)
+
+	gated, err := c.kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "pod-b", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, gated.Spec.SchedulingGates, 2, "expected the pod of the group over its hard quota to stay gated")
+}
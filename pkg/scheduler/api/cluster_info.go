@@ -41,6 +41,8 @@ type ClusterInfo struct {
 	NodeList                  []string
 	CSINodesStatus            map[string]*CSINodeStatusInfo
 	NodesInShard              sets.Set[string]
+	// PriorityClasses maps the name of each PriorityClass to its value.
+	PriorityClasses map[string]int32
 }
 
 func (ci ClusterInfo) String() string {
@@ -45,6 +45,8 @@ type SubJobInfo struct {
 	MinAvailable int32
 	Priority     int32 // determined by the highest priority task in the subJob
 	MatchIndex   int   // the first label value match to the pods in the subJob
+	// CreationTimestamp is the creation time of the oldest pod in the subJob.
+	CreationTimestamp metav1.Time
 
 	Tasks           map[TaskID]*TaskInfo
 	TaskStatusIndex map[TaskStatus]TasksMap
@@ -118,6 +120,11 @@ func (sji *SubJobInfo) addTask(ti *TaskInfo) {
 	if ti.Priority > sji.Priority {
 		sji.Priority = ti.Priority
 	}
+
+	if created := taskCreationTimestamp(ti); !created.IsZero() &&
+		(sji.CreationTimestamp.IsZero() || created.Before(&sji.CreationTimestamp)) {
+		sji.CreationTimestamp = created
+	}
 }
 
 func (sji *SubJobInfo) deleteTask(ti *TaskInfo) {
@@ -139,6 +146,27 @@ func (sji *SubJobInfo) deleteTask(ti *TaskInfo) {
 			}
 		}
 	}
+
+	if created := taskCreationTimestamp(ti); !created.IsZero() && created.Equal(&sji.CreationTimestamp) {
+		sji.CreationTimestamp = sji.getTaskEarliestCreationTimestamp()
+	}
+}
+
+func (sji *SubJobInfo) getTaskEarliestCreationTimestamp() metav1.Time {
+	var earliest metav1.Time
+	for _, task := range sji.Tasks {
+		if created := taskCreationTimestamp(task); !created.IsZero() && (earliest.IsZero() || created.Before(&earliest)) {
+			earliest = created
+		}
+	}
+	return earliest
+}
+
+func taskCreationTimestamp(ti *TaskInfo) metav1.Time {
+	if ti.Pod == nil {
+		return metav1.Time{}
+	}
+	return ti.Pod.CreationTimestamp
 }
 
 func (sji *SubJobInfo) getTaskHighestPriority() int32 {
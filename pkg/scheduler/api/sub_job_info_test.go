@@ -18,6 +18,7 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -859,3 +860,27 @@ func TestSubJobInfo_getTaskHighestPriority(t *testing.T) {
 	}
 	assert.Equal(t, int32(0), sji.getTaskHighestPriority(), "Expected -1 when taskPriorities has multiple negative elements")
 }
+
+func TestSubJobInfo_CreationTimestamp(t *testing.T) {
+	newTask := func(uid string, created time.Time) *TaskInfo {
+		return &TaskInfo{
+			UID: TaskID(uid),
+			Pod: &v1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}},
+		}
+	}
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sji := NewSubJobInfo("gid", "uid", "job", nil, nil)
+
+	second := newTask("task2", base.Add(time.Minute))
+	first := newTask("task1", base)
+	sji.addTask(second)
+	sji.addTask(first)
+	sji.addTask(&TaskInfo{UID: "no-pod"})
+	assert.True(t, sji.CreationTimestamp.Time.Equal(base), "expected the creation time of the oldest pod")
+
+	sji.deleteTask(first)
+	assert.True(t, sji.CreationTimestamp.Time.Equal(base.Add(time.Minute)), "expected the creation time of the remaining pod")
+
+	sji.deleteTask(second)
+	assert.True(t, sji.CreationTimestamp.IsZero(), "expected no creation time without pods")
+}
@@ -329,6 +329,10 @@ type BestNodeFn func(*TaskInfo, map[float64][]*NodeInfo) *NodeInfo
 // EvictableFn is the func declaration used to evict tasks.
 type EvictableFn func(*TaskInfo, []*TaskInfo) ([]*TaskInfo, int)
 
+// VictimFilterFn is the func declaration used to filter out the evictees that may not be
+// evicted by the evictor, before the plugins of any tier vote on the victims.
+type VictimFilterFn func(evictor *TaskInfo, evictees []*TaskInfo) []*TaskInfo
+
 // NodeOrderFn is the func declaration used to get priority score for a node for a particular task.
 type NodeOrderFn func(*TaskInfo, *NodeInfo) (float64, error)
 
@@ -362,6 +366,21 @@ type ReservedNodesFn func()
 // VictimTasksFn is the func declaration used to select victim tasks
 type VictimTasksFn func([]*TaskInfo) []*TaskInfo
 
+// VictimScoreFn is the func declaration used to score how much evicting a victim is preferred
+// for the preemptor, victims with a higher score are evicted first
+type VictimScoreFn func(preemptor, victim *TaskInfo) float64
+
+// JobMutation is the change of a job proposed by a plugin before the actions run
+type JobMutation struct {
+	// Priority is the priority the job is given for the session, nil to keep it
+	Priority *int32
+	// Reason explains the mutation in the logs
+	Reason string
+}
+
+// JobMutateFn is the func declaration used to propose a mutation of a job, nil for none
+type JobMutateFn func(*JobInfo) *JobMutation
+
 // AllocatableFn is the func declaration used to check whether the task can be allocated
 type AllocatableFn func(*QueueInfo, *TaskInfo) bool
 
@@ -1437,9 +1437,13 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 		NodeList:             make([]string, len(sc.NodeList)),
 		CSINodesStatus:       make(map[string]*schedulingapi.CSINodeStatusInfo),
 		NodesInShard:         sets.Set[string]{},
+		PriorityClasses:      make(map[string]int32, len(sc.PriorityClasses)),
 	}
 
 	copy(snapshot.NodeList, sc.NodeList)
+	for name, pc := range sc.PriorityClasses {
+		snapshot.PriorityClasses[name] = pc.Value
+	}
 	snapshot.NodesInShard = sc.InUseNodesInShard.Clone()
 	for _, value := range sc.Nodes {
 		value.RefreshNumaSchedulerInfoByCrd()
@@ -162,6 +162,9 @@ func (sc *SchedulerCache) initMockInformers() {
 
 	// Create node informer
 	sc.nodeInformer = informerFactory.Core().V1().Nodes()
+	// Register the namespace informer, as the real cache does, so that namespace listers
+	// see the namespaces created before Run.
+	informerFactory.Core().V1().Namespaces().Informer()
 
 	// Initialize DRA manager if feature is enabled
 	if utilfeature.DefaultFeatureGate.Enabled(kubefeatures.DynamicResourceAllocation) {
@@ -33,6 +33,9 @@ type SchedulerConfiguration struct {
 	// Configurations is configuration for actions
 	Configurations       []Configuration   `yaml:"configurations"`
 	MetricsConfiguration map[string]string `yaml:"metrics"`
+	// PrioritySelectors are named priority selectors that the arguments of the plugins
+	// reference with selectorRef instead of repeating their expressions.
+	PrioritySelectors map[string]interface{} `yaml:"prioritySelectors"`
 }
 
 // Tier defines plugin tier
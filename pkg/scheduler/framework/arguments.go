@@ -23,6 +23,8 @@ limitations under the License.
 package framework
 
 import (
+	"fmt"
+
 	"github.com/mitchellh/mapstructure"
 	"k8s.io/klog/v2"
 
@@ -135,6 +137,70 @@ func Get[T any](a Arguments, key string) (T, bool) {
 	return result, true
 }
 
+// ArgumentError is returned by GetStrict and ParseConfig when an argument does not decode
+// into the requested type.
+type ArgumentError struct {
+	// Key is the argument which failed to decode, empty for the whole arguments.
+	Key string
+	// Type is the type the argument was decoded into.
+	Type string
+	Err  error
+}
+
+func (e *ArgumentError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("could not parse arguments to type %s: %v", e.Type, e.Err)
+	}
+	return fmt.Sprintf("could not parse argument for key %s to type %s: %v", e.Key, e.Type, e.Err)
+}
+
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+// decodeStrict decodes the input into the result, reporting the fields of the input unknown
+// to the result. Struct fields are named by their json tag, and durations may be given as
+// strings, e.g. "5m".
+func decodeStrict(input, result interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  mapstructure.StringToTimeDurationHookFunc(),
+		ErrorUnused: true,
+		TagName:     "json",
+		Result:      result,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(input)
+}
+
+// GetStrict converts the parameter according to the passed generic T type like Get, but reports
+// fields unknown to T and conversion failures as an *ArgumentError instead of terminating the
+// program. If the parameter does not exist, it returns the zero value of T and no error.
+func GetStrict[T any](a Arguments, key string) (T, error) {
+	var result T
+	argv, ok := a[key]
+	if !ok {
+		return result, nil
+	}
+
+	if err := decodeStrict(argv, &result); err != nil {
+		var zero T
+		return zero, &ArgumentError{Key: key, Type: fmt.Sprintf("%T", result), Err: err}
+	}
+	return result, nil
+}
+
+// ParseConfig decodes all the arguments into the struct pointed to by config, reporting the
+// arguments unknown to the struct. It is meant to be used by the ArgumentsValidator of a plugin,
+// so that a bad configuration is rejected when it is loaded.
+func ParseConfig(a Arguments, config interface{}) error {
+	if err := decodeStrict(map[string]interface{}(a), config); err != nil {
+		return &ArgumentError{Type: fmt.Sprintf("%T", config), Err: err}
+	}
+	return nil
+}
+
 // GetArgOfActionFromConf return argument of action reading from configuration of schedule
 func GetArgOfActionFromConf(configurations []conf.Configuration, actionName string) Arguments {
 	for _, c := range configurations {
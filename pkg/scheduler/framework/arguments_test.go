@@ -21,7 +21,9 @@ limitations under the License.
 package framework
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 
@@ -248,3 +250,52 @@ func TestArgumentsGetString(t *testing.T) {
 		}
 	}
 }
+
+func TestGetStrict(t *testing.T) {
+	type selector struct {
+		Name    string        `json:"name"`
+		Timeout time.Duration `json:"gracePeriod"`
+	}
+
+	args := Arguments{
+		"valid":   map[interface{}]interface{}{"name": "a", "gracePeriod": "5m"},
+		"unknown": map[string]interface{}{"name": "a", "nmae": "b"},
+		"shape":   []interface{}{"a"},
+	}
+
+	got, err := GetStrict[selector](args, "valid")
+	if err != nil || got.Name != "a" || got.Timeout != 5*time.Minute {
+		t.Errorf("expected valid argument to decode, got %+v, error %v", got, err)
+	}
+	if got, err := GetStrict[selector](args, "missing"); err != nil || got != (selector{}) {
+		t.Errorf("expected missing argument to return the zero value, got %+v, error %v", got, err)
+	}
+	for _, key := range []string{"unknown", "shape"} {
+		_, err := GetStrict[selector](args, key)
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) || argErr.Key != key {
+			t.Errorf("expected an ArgumentError for key %s, got %v", key, err)
+		}
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	type config struct {
+		Weight int    `json:"weight"`
+		Mode   string `json:"packingMode"`
+	}
+
+	c := &config{}
+	if err := ParseConfig(Arguments{"weight": 2, "packingMode": "pack"}, c); err != nil || c.Weight != 2 || c.Mode != "pack" {
+		t.Errorf("expected arguments to decode, got %+v, error %v", c, err)
+	}
+	if err := ParseConfig(Arguments{"mode": "pack"}, &config{}); err == nil {
+		t.Errorf("expected an error for an argument named after the field instead of its tag")
+	}
+	if err := ParseConfig(Arguments{"weight": 2, "mdoe": "pack"}, &config{}); err == nil {
+		t.Errorf("expected an error for unknown arguments")
+	}
+	if err := ParseConfig(Arguments{"weight": "heavy"}, &config{}); err == nil {
+		t.Errorf("expected an error for arguments of the wrong type")
+	}
+}
@@ -35,6 +35,9 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 	ssn := openSession(cache)
 	ssn.Tiers = tiers
 	ssn.Configurations = configurations
+	ssn.victimAudit = newVictimAudit(GetArgOfActionFromConf(configurations, VictimAuditConfName))
+	ssn.victimBudget = newVictimBudget(GetArgOfActionFromConf(configurations, VictimBudgetConfName))
+	ssn.pluginMetrics = pluginMetricsEnabled(GetArgOfActionFromConf(configurations, PluginMetricsConfName))
 	ssn.NodeMap = GenerateNodeMapAndSlice(ssn.Nodes)
 	ssn.PodLister = NewPodLister(ssn)
 
@@ -51,6 +54,8 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 			}
 		}
 	}
+	ssn.mutateJobs()
+	ssn.SessionPostOpen()
 
 	ssn.InitCycleState()
 
@@ -59,12 +64,33 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 
 // CloseSession close the session
 func CloseSession(ssn *Session) {
+	if pluginDebugEnabled() {
+		recordPluginDebugState(ssn)
+	}
 	for _, plugin := range ssn.plugins {
 		onSessionCloseStart := time.Now()
 		plugin.OnSessionClose(ssn)
 		metrics.UpdatePluginDuration(plugin.Name(), metrics.OnSessionClose, metrics.Duration(onSessionCloseStart))
 	}
 
+	updateJobEffectivePriorityMetrics(ssn)
+	ssn.updateJobValidConditions()
+	ssn.victimAudit.flush(ssn.kubeClient)
 	closeSession(ssn)
 	ssn.cache.OnSessionClose()
 }
+
+// updateJobEffectivePriorityMetrics records the priority of the jobs once all plugins ran,
+// so that the priority changed by plugins is visible next to the PriorityClass value.
+func updateJobEffectivePriorityMetrics(ssn *Session) {
+	jobs := make([]metrics.JobPriority, 0, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		jobs = append(jobs, metrics.JobPriority{
+			Queue:     string(job.Queue),
+			Namespace: job.Namespace,
+			Job:       job.Name,
+			Priority:  job.Priority,
+		})
+	}
+	metrics.UpdateJobEffectivePriorities(jobs)
+}
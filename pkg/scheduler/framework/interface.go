@@ -50,6 +50,9 @@ type Plugin interface {
 	OnSessionClose(ssn *Session)
 }
 
+// SessionPostOpenFn is called once every plugin of the session is opened, before the actions run.
+type SessionPostOpenFn func(ssn *Session)
+
 type BindContextHandler interface {
 	// SetupBindContextExtension allows the plugin to set up extension information in the bind context
 	SetupBindContextExtension(state *k8sframework.CycleState, bindCtx *cache.BindContext)
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// mutateJobs applies the mutations proposed by the job mutate functions, once every plugin
+// opened the session and before the actions run. The plugins are asked in the order of the
+// tiers, and the first plugin setting the priority of a job wins: a later plugin setting a
+// different priority is reported as a conflict and ignored. The mutations are computed on
+// the jobs as they were before any of them is applied, so that they do not depend on the
+// order of the jobs.
+func (ssn *Session) mutateJobs() {
+	if len(ssn.jobMutateFns) == 0 {
+		return
+	}
+
+	jobIDs := make([]api.JobID, 0, len(ssn.Jobs))
+	for jobID := range ssn.Jobs {
+		jobIDs = append(jobIDs, jobID)
+	}
+	sort.Slice(jobIDs, func(i, j int) bool { return jobIDs[i] < jobIDs[j] })
+
+	priorities := make(map[api.JobID]int32)
+	for _, jobID := range jobIDs {
+		job := ssn.Jobs[jobID]
+		var owner string
+		for _, tier := range ssn.Tiers {
+			for _, plugin := range tier.Plugins {
+				fn, found := ssn.jobMutateFns[plugin.Name]
+				if !found {
+					continue
+				}
+				mutation := fn(job)
+				if mutation == nil || mutation.Priority == nil {
+					continue
+				}
+				if owner == "" {
+					owner = plugin.Name
+					priorities[jobID] = *mutation.Priority
+					klog.V(3).Infof("Plugin %s sets the priority of job <%s/%s> from %d to %d: %s",
+						plugin.Name, job.Namespace, job.Name, job.Priority, *mutation.Priority, mutation.Reason)
+				} else if priorities[jobID] != *mutation.Priority {
+					klog.Warningf("Plugin %s sets the priority of job <%s/%s> to %d, conflicting with priority %d set by plugin %s, ignoring it: %s",
+						plugin.Name, job.Namespace, job.Name, *mutation.Priority, priorities[jobID], owner, mutation.Reason)
+				}
+			}
+		}
+	}
+
+	for jobID, priority := range priorities {
+		ssn.Jobs[jobID].Priority = priority
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// JobInvalidReason is the reason of the Unschedulable condition of a job rejected by a
+// plugin which gave no reason.
+const JobInvalidReason = "JobInvalid"
+
+// jobValidation is the rejection of a job by the jobValidFn of a plugin.
+type jobValidation struct {
+	plugin string
+	result *api.ValidateResult
+}
+
+// recordJobValidation keeps the latest rejection of the job, or forgets it once the job is valid.
+func (ssn *Session) recordJobValidation(obj interface{}, plugin string, vr *api.ValidateResult) {
+	job, ok := obj.(*api.JobInfo)
+	if !ok {
+		return
+	}
+	if vr == nil || vr.Pass {
+		ssn.jobValidations.Delete(job.UID)
+		return
+	}
+	ssn.jobValidations.Store(job.UID, &jobValidation{plugin: plugin, result: vr})
+}
+
+// updateJobValidConditions sets the Unschedulable condition of the jobs still rejected by a
+// plugin at the end of the session, with the reason and message of the plugin, so that users
+// and controllers see why the job is not scheduled.
+func (ssn *Session) updateJobValidConditions() {
+	ssn.jobValidations.Range(func(key, value interface{}) bool {
+		job, found := ssn.Jobs[key.(api.JobID)]
+		if !found || job.PodGroup == nil {
+			return true
+		}
+		validation := value.(*jobValidation)
+		reason := validation.result.Reason
+		if reason == "" {
+			reason = JobInvalidReason
+		}
+		message := validation.result.Message
+		if message == "" {
+			message = reason
+		}
+		jc := &scheduling.PodGroupCondition{
+			Type:               scheduling.PodGroupUnschedulableType,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			TransitionID:       string(ssn.UID),
+			Reason:             reason,
+			Message:            fmt.Sprintf("plugin %s: %s", validation.plugin, message),
+		}
+		if err := ssn.UpdatePodGroupCondition(job, jc); err != nil {
+			klog.Errorf("Failed to update job <%s/%s> condition: %v", job.Namespace, job.Name, err)
+		}
+		return true
+	})
+}
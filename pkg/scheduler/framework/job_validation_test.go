@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestJobValidConditions(t *testing.T) {
+	blocked := true
+	newJob := func(uid string) *api.JobInfo {
+		job := api.NewJobInfo(api.JobID(uid))
+		job.PodGroup = &api.PodGroup{}
+		return job
+	}
+	blockedJob, validJob, unblockedJob := newJob("ns1/blocked"), newJob("ns1/valid"), newJob("ns1/unblocked")
+
+	ssn := &Session{
+		UID:   "session-1",
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{Name: "blocker"}}}},
+		Jobs: map[api.JobID]*api.JobInfo{
+			blockedJob.UID:   blockedJob,
+			validJob.UID:     validJob,
+			unblockedJob.UID: unblockedJob,
+		},
+		jobValidFns: map[string]api.ValidateExFn{
+			"blocker": func(obj interface{}) *api.ValidateResult {
+				job := obj.(*api.JobInfo)
+				if job.UID == validJob.UID || (job.UID == unblockedJob.UID && !blocked) {
+					return nil
+				}
+				return &api.ValidateResult{Pass: false, Reason: "BlockedByHigherPriority", Message: "blocked by higher priority job ns1/high"}
+			},
+		},
+	}
+
+	for _, job := range ssn.Jobs {
+		ssn.JobValid(job)
+	}
+	// the job is valid by the end of the session, e.g. after the higher priority job was allocated
+	blocked = false
+	ssn.JobValid(unblockedJob)
+
+	ssn.updateJobValidConditions()
+
+	assert.Equal(t, 1, len(blockedJob.PodGroup.Status.Conditions))
+	cond := blockedJob.PodGroup.Status.Conditions[0]
+	assert.Equal(t, scheduling.PodGroupUnschedulableType, cond.Type)
+	assert.Equal(t, v1.ConditionTrue, cond.Status)
+	assert.Equal(t, "BlockedByHigherPriority", cond.Reason)
+	assert.Equal(t, "plugin blocker: blocked by higher priority job ns1/high", cond.Message)
+	assert.Equal(t, "session-1", cond.TransitionID)
+
+	assert.Empty(t, validJob.PodGroup.Status.Conditions)
+	assert.Empty(t, unblockedJob.PodGroup.Status.Conditions)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import "fmt"
+
+// pluginDatum is a value published by a plugin for the other plugins of the session.
+type pluginDatum struct {
+	owner string
+	value interface{}
+}
+
+// SetPluginData publishes the value under the key for the other plugins of the session,
+// e.g. in OnSessionOpen. The first plugin setting a key owns it for the session: only the
+// owner may replace the value, other plugins get an error. Values are shared, not copied,
+// so readers must not modify them; the data is dropped with the session.
+func (ssn *Session) SetPluginData(owner, key string, value interface{}) error {
+	datum := &pluginDatum{owner: owner, value: value}
+	if existing, loaded := ssn.pluginData.LoadOrStore(key, datum); loaded {
+		if existingOwner := existing.(*pluginDatum).owner; existingOwner != owner {
+			return fmt.Errorf("plugin data %s is owned by plugin %s, not %s", key, existingOwner, owner)
+		}
+		ssn.pluginData.Store(key, datum)
+	}
+	return nil
+}
+
+// PluginData returns the value published under the key by a plugin of the session.
+func (ssn *Session) PluginData(key string) (interface{}, bool) {
+	datum, found := ssn.pluginData.Load(key)
+	if !found {
+		return nil, false
+	}
+	return datum.(*pluginDatum).value, true
+}
+
+// GetPluginData returns the value published under the key if it is of type T.
+// Plugins reading the data of another plugin must be ordered after it in the
+// configuration, as plugins are opened in order.
+func GetPluginData[T any](ssn *Session, key string) (T, bool) {
+	var result T
+	value, found := ssn.PluginData(key)
+	if !found {
+		return result, false
+	}
+	result, ok := value.(T)
+	return result, ok
+}
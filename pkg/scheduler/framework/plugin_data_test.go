@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import "testing"
+
+func TestPluginData(t *testing.T) {
+	ssn := &Session{}
+
+	if _, found := ssn.PluginData("usage"); found {
+		t.Errorf("expected no data before it is set")
+	}
+	if err := ssn.SetPluginData("owner", "usage", map[string]int{"a": 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ssn.SetPluginData("owner", "usage", map[string]int{"a": 2}); err != nil {
+		t.Errorf("expected the owner to replace its data, got %v", err)
+	}
+	if err := ssn.SetPluginData("other", "usage", map[string]int{"a": 3}); err == nil {
+		t.Errorf("expected another plugin not to replace the data")
+	}
+
+	usage, found := GetPluginData[map[string]int](ssn, "usage")
+	if !found || usage["a"] != 2 {
+		t.Errorf("expected the data of the owner, got %v", usage)
+	}
+	if _, found := GetPluginData[string](ssn, "usage"); found {
+		t.Errorf("expected data of another type not to be found")
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+)
+
+// PluginDebugger is implemented by the plugins exposing their session state on the
+// /debug/plugins endpoint of the scheduler.
+type PluginDebugger interface {
+	// DebugState returns the state of the plugin in the session, it must be encodable as JSON.
+	// It is called before OnSessionClose, while the state of the session is still available.
+	DebugState(ssn *Session) interface{}
+}
+
+// SessionDebugState is the state of the plugins at the end of one session.
+type SessionDebugState struct {
+	Session   types.UID                  `json:"session"`
+	CloseTime time.Time                  `json:"closeTime"`
+	Plugins   map[string]json.RawMessage `json:"plugins"`
+}
+
+var (
+	// debugMutex guards lastDebugState, the state of the last closed session.
+	debugMutex     sync.RWMutex
+	lastDebugState *SessionDebugState
+)
+
+// pluginDebugEnabled returns whether the state of the plugins is kept for the debug endpoint.
+func pluginDebugEnabled() bool {
+	return options.ServerOpts != nil && options.ServerOpts.EnablePluginDebug
+}
+
+// recordPluginDebugState keeps the state of the plugins of the session. The state is encoded
+// right away, so that the plugins are free to release or reuse it once the session closes.
+func recordPluginDebugState(ssn *Session) {
+	state := &SessionDebugState{
+		Session:   ssn.UID,
+		CloseTime: time.Now(),
+		Plugins:   map[string]json.RawMessage{},
+	}
+	for name, plugin := range ssn.plugins {
+		debugger, ok := plugin.(PluginDebugger)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(debugger.DebugState(ssn))
+		if err != nil {
+			klog.Errorf("Failed to encode the debug state of plugin %s: %v", name, err)
+			continue
+		}
+		state.Plugins[name] = data
+	}
+
+	debugMutex.Lock()
+	defer debugMutex.Unlock()
+	lastDebugState = state
+}
+
+// PluginDebugHandler serves the state of the plugins in the last closed session as JSON.
+func PluginDebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		debugMutex.RLock()
+		state := lastDebugState
+		debugMutex.RUnlock()
+		if state == nil {
+			http.Error(w, "no session closed yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(state); err != nil {
+			klog.Errorf("Failed to write the plugin debug state: %v", err)
+		}
+	})
+}
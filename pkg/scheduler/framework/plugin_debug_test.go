@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type debugPlugin struct {
+	state map[string]int
+}
+
+func (dp *debugPlugin) Name() string                        { return "debug" }
+func (dp *debugPlugin) OnSessionOpen(ssn *Session)          {}
+func (dp *debugPlugin) OnSessionClose(ssn *Session)         { dp.state = nil }
+func (dp *debugPlugin) DebugState(ssn *Session) interface{} { return dp.state }
+
+type silentPlugin struct{}
+
+func (sp *silentPlugin) Name() string                { return "silent" }
+func (sp *silentPlugin) OnSessionOpen(ssn *Session)  {}
+func (sp *silentPlugin) OnSessionClose(ssn *Session) {}
+
+func TestPluginDebugHandler(t *testing.T) {
+	defer func() { lastDebugState = nil }()
+	handler := PluginDebugHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/plugins", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	plugin := &debugPlugin{state: map[string]int{"team-a": 3}}
+	ssn := &Session{
+		UID:     "session-1",
+		plugins: map[string]Plugin{"debug": plugin, "silent": &silentPlugin{}},
+	}
+	recordPluginDebugState(ssn)
+	plugin.OnSessionClose(ssn)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/plugins", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	state := &SessionDebugState{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), state))
+	assert.Equal(t, "session-1", string(state.Session))
+	assert.Equal(t, 1, len(state.Plugins))
+	assert.JSONEq(t, `{"team-a":3}`, string(state.Plugins["debug"]))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/plugins", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
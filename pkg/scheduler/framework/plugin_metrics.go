@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// PluginMetricsConfName is the name of the configuration enabling the per-plugin callback
+	// metrics. Timing every callback has a cost, so they are disabled by default, e.g.
+	//
+	//	configurations:
+	//	- name: pluginMetrics
+	//	  arguments:
+	//	    enabled: true
+	PluginMetricsConfName = "pluginMetrics"
+
+	pluginMetricsEnabledKey = "enabled"
+)
+
+// pluginMetricsEnabled returns whether the plugin callback metrics are enabled in the configurations.
+func pluginMetricsEnabled(arguments Arguments) bool {
+	enabled := false
+	arguments.GetBool(&enabled, pluginMetricsEnabledKey)
+	return enabled
+}
+
+// The instrument functions below wrap the callbacks registered by the plugins to record their
+// latency and decisions, they return the callback as is unless the metrics are enabled.
+
+func (ssn *Session) instrumentCompareFn(name, callback string, fn api.CompareFn) api.CompareFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(l, r interface{}) int {
+		start := time.Now()
+		defer func() { metrics.UpdatePluginCallbackDuration(name, callback, metrics.Duration(start)) }()
+		return fn(l, r)
+	}
+}
+
+func (ssn *Session) instrumentPredicateFn(name string, fn api.PredicateFn) api.PredicateFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(task *api.TaskInfo, node *api.NodeInfo) error {
+		start := time.Now()
+		err := fn(task, node)
+		metrics.UpdatePluginCallbackDuration(name, metrics.PredicateCallback, metrics.Duration(start))
+		metrics.RegisterPluginCallbackDecision(name, metrics.PredicateCallback, boolDecision(err == nil))
+		return err
+	}
+}
+
+func (ssn *Session) instrumentNodeOrderFn(name string, fn api.NodeOrderFn) api.NodeOrderFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		start := time.Now()
+		defer func() { metrics.UpdatePluginCallbackDuration(name, metrics.NodeOrderCallback, metrics.Duration(start)) }()
+		return fn(task, node)
+	}
+}
+
+func (ssn *Session) instrumentEvictableFn(name, callback string, fn api.EvictableFn) api.EvictableFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(evictor *api.TaskInfo, evictees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		start := time.Now()
+		victims, vote := fn(evictor, evictees)
+		metrics.UpdatePluginCallbackDuration(name, callback, metrics.Duration(start))
+		decision := voteDecision(vote)
+		if vote > 0 && len(victims) == 0 {
+			// permitting no victim vetoes the eviction
+			decision = metrics.DecisionReject
+		}
+		metrics.RegisterPluginCallbackDecision(name, callback, decision)
+		return victims, vote
+	}
+}
+
+func (ssn *Session) instrumentVoteFn(name, callback string, fn api.VoteFn) api.VoteFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(obj interface{}) int {
+		start := time.Now()
+		vote := fn(obj)
+		metrics.UpdatePluginCallbackDuration(name, callback, metrics.Duration(start))
+		metrics.RegisterPluginCallbackDecision(name, callback, voteDecision(vote))
+		return vote
+	}
+}
+
+func (ssn *Session) instrumentAllocatableFn(name string, fn api.AllocatableFn) api.AllocatableFn {
+	if !ssn.pluginMetrics {
+		return fn
+	}
+	return func(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
+		start := time.Now()
+		allocatable := fn(queue, candidate)
+		metrics.UpdatePluginCallbackDuration(name, metrics.AllocatableCallback, metrics.Duration(start))
+		metrics.RegisterPluginCallbackDecision(name, metrics.AllocatableCallback, boolDecision(allocatable))
+		return allocatable
+	}
+}
+
+func voteDecision(vote int) string {
+	switch {
+	case vote > 0:
+		return metrics.DecisionPermit
+	case vote < 0:
+		return metrics.DecisionReject
+	default:
+		return metrics.DecisionAbstain
+	}
+}
+
+func boolDecision(permit bool) string {
+	if permit {
+		return metrics.DecisionPermit
+	}
+	return metrics.DecisionReject
+}
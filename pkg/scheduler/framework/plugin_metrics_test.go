@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+// gatherPluginMetric returns the metric of the family with exactly the given labels, or nil.
+func gatherPluginMetric(t *testing.T, family string, labels map[string]string) *dto.Metric {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != family {
+			continue
+		}
+	metricLoop:
+		for _, m := range mf.GetMetric() {
+			if len(m.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metricLoop
+				}
+			}
+			return m
+		}
+	}
+	return nil
+}
+
+func TestPluginMetrics(t *testing.T) {
+	ssn := &Session{
+		pluginMetrics:     true,
+		jobEnqueueableFns: map[string]api.VoteFn{},
+		preemptableFns:    map[string]api.EvictableFn{},
+	}
+	ssn.AddJobEnqueueableFn("metricsplugin", func(interface{}) int { return -1 })
+	ssn.AddPreemptableFn("metricsplugin", func(*api.TaskInfo, []*api.TaskInfo) ([]*api.TaskInfo, int) { return nil, 0 })
+
+	ssn.jobEnqueueableFns["metricsplugin"](nil)
+	ssn.jobEnqueueableFns["metricsplugin"](nil)
+	ssn.preemptableFns["metricsplugin"](nil, nil)
+
+	latency := gatherPluginMetric(t, "volcano_plugin_callback_latency_microseconds",
+		map[string]string{"plugin": "metricsplugin", "callback": metrics.JobEnqueueableCallback})
+	if latency == nil || latency.GetHistogram().GetSampleCount() != 2 {
+		t.Errorf("expected 2 observed invocations of JobEnqueueable, got %v", latency)
+	}
+	rejected := gatherPluginMetric(t, "volcano_plugin_callback_decisions_total",
+		map[string]string{"plugin": "metricsplugin", "callback": metrics.JobEnqueueableCallback, "decision": metrics.DecisionReject})
+	if rejected == nil || rejected.GetCounter().GetValue() != 2 {
+		t.Errorf("expected 2 rejects of JobEnqueueable, got %v", rejected)
+	}
+	abstained := gatherPluginMetric(t, "volcano_plugin_callback_decisions_total",
+		map[string]string{"plugin": "metricsplugin", "callback": metrics.PreemptableCallback, "decision": metrics.DecisionAbstain})
+	if abstained == nil || abstained.GetCounter().GetValue() != 1 {
+		t.Errorf("expected 1 abstain of Preemptable, got %v", abstained)
+	}
+}
+
+func TestPluginMetricsDisabled(t *testing.T) {
+	ssn := &Session{jobEnqueueableFns: map[string]api.VoteFn{}}
+	ssn.AddJobEnqueueableFn("unmeteredplugin", func(interface{}) int { return 1 })
+	ssn.jobEnqueueableFns["unmeteredplugin"](nil)
+
+	if m := gatherPluginMetric(t, "volcano_plugin_callback_latency_microseconds",
+		map[string]string{"plugin": "unmeteredplugin", "callback": metrics.JobEnqueueableCallback}); m != nil {
+		t.Errorf("expected no metric unless enabled, got %v", m)
+	}
+}
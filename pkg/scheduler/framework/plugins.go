@@ -46,6 +46,33 @@ func RegisterPluginBuilder(name string, pc PluginBuilder) {
 	pluginBuilders[name] = pc
 }
 
+// ArgumentsValidator checks the arguments of a plugin, so that a configuration with
+// invalid arguments is rejected instead of replacing the configuration in use.
+type ArgumentsValidator = func(Arguments) error
+
+var argumentsValidators = map[string]ArgumentsValidator{}
+
+// RegisterArgumentsValidator register the arguments validator of the plugin
+func RegisterArgumentsValidator(name string, validator ArgumentsValidator) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	argumentsValidators[name] = validator
+}
+
+// ValidatePluginArguments validates the arguments of the plugin, plugins without
+// a registered validator accept any arguments
+func ValidatePluginArguments(name string, arguments Arguments) error {
+	pluginMutex.RLock()
+	validator, found := argumentsValidators[name]
+	pluginMutex.RUnlock()
+
+	if !found {
+		return nil
+	}
+	return validator(arguments)
+}
+
 // CleanupPluginBuilders cleans up all the plugin
 func CleanupPluginBuilders() {
 	pluginMutex.Lock()
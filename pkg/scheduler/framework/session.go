@@ -32,6 +32,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -89,6 +90,8 @@ type Session struct {
 	RevocableNodes map[string]*api.NodeInfo
 	Queues         map[api.QueueID]*api.QueueInfo
 	NamespaceInfo  map[api.NamespaceName]*api.NamespaceInfo
+	// PriorityClasses maps the name of each PriorityClass to its value.
+	PriorityClasses map[string]int32
 
 	// NodeMap is like Nodes except that it uses k8s NodeInfo api and should only
 	// be used in k8s compatible api scenarios such as in predicates and nodeorder plugins.
@@ -128,7 +131,11 @@ type Session struct {
 	hyperNodeOrderFns   map[string]api.HyperNodeOrderFn
 	preemptableFns      map[string]api.EvictableFn
 	reclaimableFns      map[string]api.EvictableFn
-	overusedFns         map[string]api.ValidateFn
+	// preemptVictimFilterFns and reclaimVictimFilterFns filter out the evictees before
+	// preemptableFns and reclaimableFns vote on them.
+	preemptVictimFilterFns map[string]api.VictimFilterFn
+	reclaimVictimFilterFns map[string]api.VictimFilterFn
+	overusedFns            map[string]api.ValidateFn
 	// preemptiveFns means whether current queue can reclaim from other queue,
 	// while reclaimableFns means whether current queue's resources can be reclaimed.
 	preemptiveFns                 map[string]api.ValidateWithCandidateFn
@@ -141,6 +148,8 @@ type Session struct {
 	targetJobFns                  map[string]api.TargetJobFn
 	reservedNodesFns              map[string]api.ReservedNodesFn
 	victimTasksFns                map[string][]api.VictimTasksFn
+	victimScoreFns                map[string]api.VictimScoreFn
+	jobMutateFns                  map[string]api.JobMutateFn
 	jobStarvingFns                map[string]api.ValidateFn
 	simulateRemoveTaskFns         map[string]api.SimulateRemoveTaskFn
 	simulateAddTaskFns            map[string]api.SimulateAddTaskFn
@@ -151,6 +160,7 @@ type Session struct {
 	subJobOrderFns                map[string]api.CompareFn
 	hyperNodeGradientForJobFns    map[string]api.HyperNodeGradientForJobFn
 	hyperNodeGradientForSubJobFns map[string]api.HyperNodeGradientForSubJobFn
+	sessionPostOpenFns            map[string]SessionPostOpenFn
 
 	// cycleStatesMap is used to temporarily store the scheduling status of each pod, its life cycle is same as Session.
 	// Because state needs to be passed between different extension points (not only used in PreFilter and Filter),
@@ -159,6 +169,21 @@ type Session struct {
 	// The key is task's UID, value is the CycleState.
 	cycleStatesMap sync.Map
 
+	// pluginData holds the facts published by plugins for the other plugins of the session,
+	// see SetPluginData. The key is the data key, value is a *pluginDatum.
+	pluginData sync.Map
+
+	// jobValidations holds the jobs rejected by a jobValidFn, reported in their PodGroup
+	// conditions when the session is closed. The key is job's UID, value is a *jobValidation.
+	jobValidations sync.Map
+
+	// victimAudit is nil unless the victim audit is enabled in the configurations.
+	victimAudit *victimAudit
+	// victimBudget is nil unless the evictions are capped in the configurations.
+	victimBudget *victimBudget
+	// pluginMetrics enables the latency and decision metrics of the plugin callbacks.
+	pluginMetrics bool
+
 	NodesInShard sets.Set[string]
 }
 
@@ -203,6 +228,8 @@ func openSession(cache cache.Cache) *Session {
 		hyperNodeOrderFns:             map[string]api.HyperNodeOrderFn{},
 		preemptableFns:                map[string]api.EvictableFn{},
 		reclaimableFns:                map[string]api.EvictableFn{},
+		preemptVictimFilterFns:        map[string]api.VictimFilterFn{},
+		reclaimVictimFilterFns:        map[string]api.VictimFilterFn{},
 		overusedFns:                   map[string]api.ValidateFn{},
 		preemptiveFns:                 map[string]api.ValidateWithCandidateFn{},
 		allocatableFns:                map[string]api.AllocatableFn{},
@@ -214,6 +241,8 @@ func openSession(cache cache.Cache) *Session {
 		targetJobFns:                  map[string]api.TargetJobFn{},
 		reservedNodesFns:              map[string]api.ReservedNodesFn{},
 		victimTasksFns:                map[string][]api.VictimTasksFn{},
+		victimScoreFns:                map[string]api.VictimScoreFn{},
+		jobMutateFns:                  map[string]api.JobMutateFn{},
 		jobStarvingFns:                map[string]api.ValidateFn{},
 		simulateRemoveTaskFns:         map[string]api.SimulateRemoveTaskFn{},
 		simulateAddTaskFns:            map[string]api.SimulateAddTaskFn{},
@@ -224,6 +253,7 @@ func openSession(cache cache.Cache) *Session {
 		subJobOrderFns:                map[string]api.CompareFn{},
 		hyperNodeGradientForJobFns:    map[string]api.HyperNodeGradientForJobFn{},
 		hyperNodeGradientForSubJobFns: map[string]api.HyperNodeGradientForSubJobFn{},
+		sessionPostOpenFns:            map[string]SessionPostOpenFn{},
 	}
 
 	snapshot := cache.Snapshot()
@@ -268,6 +298,7 @@ func openSession(cache cache.Cache) *Session {
 	ssn.RevocableNodes = snapshot.RevocableNodes
 	ssn.Queues = snapshot.Queues
 	ssn.NamespaceInfo = snapshot.NamespaceInfo
+	ssn.PriorityClasses = snapshot.PriorityClasses
 	// calculate all nodes' resource only once in each schedule cycle, other plugins can clone it when need
 	for _, n := range ssn.Nodes {
 		ssn.TotalResource.Add(n.Allocatable)
@@ -928,6 +959,7 @@ func (ssn *Session) Evict(reclaimee *api.TaskInfo, reason string) error {
 	if err := ssn.cache.Evict(reclaimee, reason); err != nil {
 		return err
 	}
+	ssn.victimBudget.evicted(reclaimee)
 
 	// Update status in session
 	job, found := ssn.Jobs[reclaimee.Job]
@@ -1037,6 +1069,11 @@ func (ssn *Session) RecordPodGroupEvent(podGroup *api.PodGroup, eventType, reaso
 	ssn.recorder.Eventf(pg, eventType, reason, msg)
 }
 
+// RecordEvent records an event on any object, e.g. a GroupQuota or a Namespace.
+func (ssn *Session) RecordEvent(object runtime.Object, eventType, reason, msg string) {
+	ssn.recorder.Event(object, eventType, reason, msg)
+}
+
 // SharedDRAManager returns the shared DRAManager from cache
 func (ssn *Session) SharedDRAManager() k8sframework.SharedDRAManager {
 	return ssn.cache.SharedDRAManager()
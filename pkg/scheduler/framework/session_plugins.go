@@ -29,12 +29,13 @@ import (
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/volcano/pkg/controllers/job/helpers"
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
 // AddJobOrderFn add job order function
 func (ssn *Session) AddJobOrderFn(name string, cf api.CompareFn) {
-	ssn.jobOrderFns[name] = cf
+	ssn.jobOrderFns[name] = ssn.instrumentCompareFn(name, metrics.JobOrderCallback, cf)
 }
 
 // AddQueueOrderFn add queue order function
@@ -54,17 +55,17 @@ func (ssn *Session) AddClusterOrderFn(name string, qf api.CompareFn) {
 
 // AddTaskOrderFn add task order function
 func (ssn *Session) AddTaskOrderFn(name string, cf api.CompareFn) {
-	ssn.taskOrderFns[name] = cf
+	ssn.taskOrderFns[name] = ssn.instrumentCompareFn(name, metrics.TaskOrderCallback, cf)
 }
 
 // AddPreemptableFn add preemptable function
 func (ssn *Session) AddPreemptableFn(name string, cf api.EvictableFn) {
-	ssn.preemptableFns[name] = cf
+	ssn.preemptableFns[name] = ssn.instrumentEvictableFn(name, metrics.PreemptableCallback, cf)
 }
 
 // AddReclaimableFn add Reclaimable function
 func (ssn *Session) AddReclaimableFn(name string, rf api.EvictableFn) {
-	ssn.reclaimableFns[name] = rf
+	ssn.reclaimableFns[name] = ssn.instrumentEvictableFn(name, metrics.ReclaimableCallback, rf)
 }
 
 // AddJobReadyFn add JobReady function
@@ -74,12 +75,12 @@ func (ssn *Session) AddJobReadyFn(name string, vf api.ValidateFn) {
 
 // AddJobPipelinedFn add pipelined function
 func (ssn *Session) AddJobPipelinedFn(name string, vf api.VoteFn) {
-	ssn.jobPipelinedFns[name] = vf
+	ssn.jobPipelinedFns[name] = ssn.instrumentVoteFn(name, metrics.JobPipelinedCallback, vf)
 }
 
 // AddPredicateFn add Predicate function
 func (ssn *Session) AddPredicateFn(name string, pf api.PredicateFn) {
-	ssn.predicateFns[name] = pf
+	ssn.predicateFns[name] = ssn.instrumentPredicateFn(name, pf)
 }
 
 // AddPrePredicateFn add PrePredicate function
@@ -94,7 +95,7 @@ func (ssn *Session) AddBestNodeFn(name string, pf api.BestNodeFn) {
 
 // AddNodeOrderFn add Node order function
 func (ssn *Session) AddNodeOrderFn(name string, pf api.NodeOrderFn) {
-	ssn.nodeOrderFns[name] = pf
+	ssn.nodeOrderFns[name] = ssn.instrumentNodeOrderFn(name, pf)
 }
 
 // AddHyperNodeOrderFn add hyperNode order function
@@ -129,7 +130,7 @@ func (ssn *Session) AddPreemptiveFn(name string, fn api.ValidateWithCandidateFn)
 
 // AddAllocatableFn add allocatable function
 func (ssn *Session) AddAllocatableFn(name string, fn api.AllocatableFn) {
-	ssn.allocatableFns[name] = fn
+	ssn.allocatableFns[name] = ssn.instrumentAllocatableFn(name, fn)
 }
 
 // AddJobValidFn add jobvalid function
@@ -139,7 +140,7 @@ func (ssn *Session) AddJobValidFn(name string, fn api.ValidateExFn) {
 
 // AddJobEnqueueableFn add jobenqueueable function
 func (ssn *Session) AddJobEnqueueableFn(name string, fn api.VoteFn) {
-	ssn.jobEnqueueableFns[name] = fn
+	ssn.jobEnqueueableFns[name] = ssn.instrumentVoteFn(name, metrics.JobEnqueueableCallback, fn)
 }
 
 // AddJobEnqueuedFn add jobEnqueued function
@@ -147,6 +148,12 @@ func (ssn *Session) AddJobEnqueuedFn(name string, fn api.JobEnqueuedFn) {
 	ssn.jobEnqueuedFns[name] = fn
 }
 
+// AddSessionPostOpenFn add sessionPostOpen function, called once every plugin is opened,
+// so that e.g. changes of job priorities are applied before any other plugin is called
+func (ssn *Session) AddSessionPostOpenFn(name string, fn SessionPostOpenFn) {
+	ssn.sessionPostOpenFns[name] = fn
+}
+
 // AddTargetJobFn add targetjob function
 func (ssn *Session) AddTargetJobFn(name string, fn api.TargetJobFn) {
 	ssn.targetJobFns[name] = fn
@@ -162,6 +169,26 @@ func (ssn *Session) AddVictimTasksFns(name string, fns []api.VictimTasksFn) {
 	ssn.victimTasksFns[name] = fns
 }
 
+// AddPreemptVictimFilterFn add victim filter function applied before preemptableFns
+func (ssn *Session) AddPreemptVictimFilterFn(name string, fn api.VictimFilterFn) {
+	ssn.preemptVictimFilterFns[name] = fn
+}
+
+// AddReclaimVictimFilterFn add victim filter function applied before reclaimableFns
+func (ssn *Session) AddReclaimVictimFilterFn(name string, fn api.VictimFilterFn) {
+	ssn.reclaimVictimFilterFns[name] = fn
+}
+
+// AddVictimScoreFn add victim score function
+func (ssn *Session) AddVictimScoreFn(name string, fn api.VictimScoreFn) {
+	ssn.victimScoreFns[name] = fn
+}
+
+// AddJobMutateFn add job mutate function
+func (ssn *Session) AddJobMutateFn(name string, fn api.JobMutateFn) {
+	ssn.jobMutateFns[name] = fn
+}
+
 // AddJobStarvingFns add jobStarvingFns function
 func (ssn *Session) AddJobStarvingFns(name string, fn api.ValidateFn) {
 	ssn.jobStarvingFns[name] = fn
@@ -212,7 +239,16 @@ func (ssn *Session) AddHyperNodeGradientForSubJobFn(name string, fn api.HyperNod
 func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) []*api.TaskInfo {
 	var victims []*api.TaskInfo
 
-	for _, tier := range ssn.Tiers {
+	defer func() {
+		ssn.victimAudit.recordDecision(VictimAuditReclaim, reclaimer, reclaimees, victims)
+	}()
+
+	evictees := ssn.filterVictims(VictimAuditReclaim, ssn.reclaimVictimFilterFns, reclaimer, reclaimees)
+	if len(evictees) == 0 {
+		return victims
+	}
+
+	for i, tier := range ssn.Tiers {
 		for _, plugin := range tier.Plugins {
 			if !isEnabled(plugin.EnabledReclaimable) {
 				continue
@@ -222,7 +258,8 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 				continue
 			}
 
-			candidates, abstain := rf(reclaimer, reclaimees)
+			candidates, abstain := rf(reclaimer, evictees)
+			ssn.victimAudit.record(VictimAuditReclaim, i, plugin.Name, reclaimer, evictees, candidates, abstain)
 			if abstain == 0 {
 				continue
 			}
@@ -234,22 +271,13 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 			if victims == nil {
 				victims = candidates
 			} else {
-				var intersection []*api.TaskInfo
-				// Get intersection of victims and candidates.
-				for _, v := range victims {
-					for _, c := range candidates {
-						if v.UID == c.UID {
-							intersection = append(intersection, v)
-						}
-					}
-				}
-
 				// Update victims to intersection
-				victims = intersection
+				victims = intersectTasks(victims, candidates)
 			}
 		}
 		// Plugins in this tier made decision if victims is not nil
 		if victims != nil {
+			victims = ssn.victimBudget.trim(VictimAuditReclaim, reclaimer, victims)
 			return victims
 		}
 	}
@@ -261,7 +289,16 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) []*api.TaskInfo {
 	var victims []*api.TaskInfo
 
-	for _, tier := range ssn.Tiers {
+	defer func() {
+		ssn.victimAudit.recordDecision(VictimAuditPreempt, preemptor, preemptees, victims)
+	}()
+
+	evictees := ssn.filterVictims(VictimAuditPreempt, ssn.preemptVictimFilterFns, preemptor, preemptees)
+	if len(evictees) == 0 {
+		return victims
+	}
+
+	for i, tier := range ssn.Tiers {
 		for _, plugin := range tier.Plugins {
 			if !isEnabled(plugin.EnabledPreemptable) {
 				continue
@@ -271,7 +308,8 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 			if !found {
 				continue
 			}
-			candidates, abstain := pf(preemptor, preemptees)
+			candidates, abstain := pf(preemptor, evictees)
+			ssn.victimAudit.record(VictimAuditPreempt, i, plugin.Name, preemptor, evictees, candidates, abstain)
 			if abstain == 0 {
 				continue
 			}
@@ -284,22 +322,13 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 			if victims == nil {
 				victims = candidates
 			} else {
-				var intersection []*api.TaskInfo
-				// Get intersection of victims and candidates.
-				for _, v := range victims {
-					for _, c := range candidates {
-						if v.UID == c.UID {
-							intersection = append(intersection, v)
-						}
-					}
-				}
-
 				// Update victims to intersection
-				victims = intersection
+				victims = intersectTasks(victims, candidates)
 			}
 		}
 		// Plugins in this tier made decision if victims is not nil
 		if victims != nil {
+			victims = ssn.victimBudget.trim(VictimAuditPreempt, preemptor, victims)
 			return victims
 		}
 	}
@@ -307,6 +336,43 @@ func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskI
 	return victims
 }
 
+// filterVictims returns the evictees left by the victim filter functions of the plugins
+// enabled for the kind of eviction.
+func (ssn *Session) filterVictims(kind string, fns map[string]api.VictimFilterFn, evictor *api.TaskInfo, evictees []*api.TaskInfo) []*api.TaskInfo {
+	for i, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			enabled := plugin.EnabledPreemptable
+			if kind == VictimAuditReclaim {
+				enabled = plugin.EnabledReclaimable
+			}
+			if !isEnabled(enabled) {
+				continue
+			}
+			fn, found := fns[plugin.Name]
+			if !found {
+				continue
+			}
+			filtered := fn(evictor, evictees)
+			ssn.victimAudit.record(kind, i, plugin.Name, evictor, evictees, filtered, -1)
+			evictees = filtered
+		}
+	}
+	return evictees
+}
+
+// intersectTasks returns the tasks of victims that are also candidates, in the order of victims.
+func intersectTasks(victims, candidates []*api.TaskInfo) []*api.TaskInfo {
+	var intersection []*api.TaskInfo
+	for _, v := range victims {
+		for _, c := range candidates {
+			if v.UID == c.UID {
+				intersection = append(intersection, v)
+			}
+		}
+	}
+	return intersection
+}
+
 // Overused invoke overused function of the plugins
 func (ssn *Session) Overused(queue *api.QueueInfo) bool {
 	for _, tier := range ssn.Tiers {
@@ -516,11 +582,13 @@ func (ssn *Session) JobValid(obj interface{}) *api.ValidateResult {
 			}
 
 			if vr := jrf(obj); vr != nil && !vr.Pass {
+				ssn.recordJobValidation(obj, plugin.Name, vr)
 				return vr
 			}
 		}
 	}
 
+	ssn.recordJobValidation(obj, "", nil)
 	return nil
 }
 
@@ -555,6 +623,19 @@ func (ssn *Session) JobEnqueueable(obj interface{}) bool {
 	return true
 }
 
+// SessionPostOpen invoke sessionPostOpenFns function of the plugins, in the order of the tiers
+func (ssn *Session) SessionPostOpen() {
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			fn, found := ssn.sessionPostOpenFns[plugin.Name]
+			if !found {
+				continue
+			}
+			fn(ssn)
+		}
+	}
+}
+
 // JobEnqueued invoke jobEnqueuedFns function of the plugins
 func (ssn *Session) JobEnqueued(obj interface{}) {
 	for _, tier := range ssn.Tiers {
@@ -616,6 +697,23 @@ func (ssn *Session) VictimTasks(tasks []*api.TaskInfo) map[*api.TaskInfo]bool {
 	return victimSet
 }
 
+// VictimScore returns the sum of the scores of the victim for the preemptor given by the
+// plugins. The score only orders the victims, unlike Preemptable and Reclaimable it never
+// excludes one.
+func (ssn *Session) VictimScore(preemptor, victim *api.TaskInfo) float64 {
+	score := 0.0
+	for _, tier := range ssn.Tiers {
+		for _, plugin := range tier.Plugins {
+			fn, found := ssn.victimScoreFns[plugin.Name]
+			if !found {
+				continue
+			}
+			score += fn(preemptor, victim)
+		}
+	}
+	return score
+}
+
 // ReservedNodes invoke ReservedNodes function of the plugins
 func (ssn *Session) ReservedNodes() {
 	for _, tier := range ssn.Tiers {
@@ -648,12 +746,15 @@ func (ssn *Session) SubJobOrderFn(l, r interface{}) bool {
 		}
 	}
 
-	// If no subJob order funcs, order subJob by MatchIndex and UID.
+	// If no subJob order funcs, order subJob by MatchIndex, CreationTimestamp and UID.
 	lv := l.(*api.SubJobInfo)
 	rv := r.(*api.SubJobInfo)
 	if lv.MatchIndex != rv.MatchIndex {
 		return lv.MatchIndex < rv.MatchIndex
 	}
+	if !lv.CreationTimestamp.Equal(&rv.CreationTimestamp) {
+		return lv.CreationTimestamp.Before(&rv.CreationTimestamp)
+	}
 	return lv.UID < rv.UID
 }
 
@@ -1088,12 +1189,22 @@ func (ssn *Session) HyperNodeGradientForSubJobFn(subJob *api.SubJobInfo, hyperNo
 }
 
 // BuildVictimsPriorityQueue returns a priority queue with victims sorted by:
+// if victims have different scores, sorted by the higher ssn.VictimScore
 // if victims has same job id, sorted by !ssn.TaskOrderFn
 // if victims has different job id, sorted by !ssn.JobOrderFn
 func (ssn *Session) BuildVictimsPriorityQueue(victims []*api.TaskInfo, preemptor *api.TaskInfo) *util.PriorityQueue {
+	scores := make(map[api.TaskID]float64, len(victims))
+	if len(ssn.victimScoreFns) > 0 {
+		for _, victim := range victims {
+			scores[victim.UID] = ssn.VictimScore(preemptor, victim)
+		}
+	}
 	victimsQueue := util.NewPriorityQueue(func(l, r interface{}) bool {
 		lv := l.(*api.TaskInfo)
 		rv := r.(*api.TaskInfo)
+		if ls, rs := scores[lv.UID], scores[rv.UID]; ls != rs {
+			return ls > rs
+		}
 		if lv.Job == rv.Job {
 			return !ssn.TaskOrderFn(l, r)
 		}
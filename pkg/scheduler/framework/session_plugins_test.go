@@ -26,6 +26,7 @@ import (
 	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
@@ -136,3 +137,174 @@ func TestFilterOutPreemptMayNotHelpNodes(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionPostOpen(t *testing.T) {
+	ssn := &Session{
+		Tiers: []conf.Tier{
+			{Plugins: []conf.PluginOption{{Name: "first"}, {Name: "without-fn"}}},
+			{Plugins: []conf.PluginOption{{Name: "second"}}},
+		},
+		sessionPostOpenFns: map[string]SessionPostOpenFn{},
+	}
+	var called []string
+	for _, name := range []string{"second", "first"} {
+		ssn.AddSessionPostOpenFn(name, func(s *Session) {
+			if s != ssn {
+				t.Errorf("expected the session to be passed to %s", name)
+			}
+			called = append(called, name)
+		})
+	}
+
+	ssn.SessionPostOpen()
+	assert.Equal(t, []string{"first", "second"}, called)
+}
+
+func TestBuildVictimsPriorityQueueScore(t *testing.T) {
+	task := func(name string) *api.TaskInfo {
+		pod := util.BuildPod("ns1", name, "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+		return api.NewTaskInfo(pod)
+	}
+	preemptor := task("preemptor")
+	a, b, c := task("a"), task("b"), task("c")
+	ssn := &Session{
+		Tiers: []conf.Tier{
+			{Plugins: []conf.PluginOption{{Name: "timeout"}}},
+			{Plugins: []conf.PluginOption{{Name: "range"}}},
+		},
+		victimScoreFns: map[string]api.VictimScoreFn{},
+	}
+	ssn.AddVictimScoreFn("timeout", func(p, victim *api.TaskInfo) float64 {
+		if p != preemptor {
+			t.Errorf("expected the preemptor to be passed to the score function")
+		}
+		if victim == a {
+			return 100
+		}
+		return 0
+	})
+	ssn.AddVictimScoreFn("range", func(_, victim *api.TaskInfo) float64 {
+		if victim == c {
+			return 10
+		}
+		return 0
+	})
+
+	assert.Equal(t, 100.0, ssn.VictimScore(preemptor, a))
+	queue := ssn.BuildVictimsPriorityQueue([]*api.TaskInfo{b, c, a}, preemptor)
+	var order []string
+	for !queue.Empty() {
+		order = append(order, queue.Pop().(*api.TaskInfo).Name)
+	}
+	assert.Equal(t, []string{"a", "c", "b"}, order)
+}
+
+func TestMutateJobs(t *testing.T) {
+	priority := func(p int32) *int32 { return &p }
+	job := func(name string) *api.JobInfo {
+		return &api.JobInfo{UID: api.JobID("ns1/" + name), Namespace: "ns1", Name: name, Priority: 1}
+	}
+	ssn := &Session{
+		Tiers: []conf.Tier{
+			{Plugins: []conf.PluginOption{{Name: "first"}}},
+			{Plugins: []conf.PluginOption{{Name: "second"}}},
+		},
+		Jobs: map[api.JobID]*api.JobInfo{
+			"ns1/a": job("a"),
+			"ns1/b": job("b"),
+			"ns1/c": job("c"),
+		},
+		jobMutateFns: map[string]api.JobMutateFn{},
+	}
+	ssn.AddJobMutateFn("second", func(job *api.JobInfo) *api.JobMutation {
+		// b is raised by both plugins, a and c by this one only
+		if job.Priority != 1 {
+			t.Errorf("expected the mutations to be computed on the jobs before any is applied")
+		}
+		switch job.Name {
+		case "a":
+			return &api.JobMutation{Priority: priority(20)}
+		case "b":
+			return &api.JobMutation{Priority: priority(30)}
+		}
+		return &api.JobMutation{Reason: "no priority"}
+	})
+	ssn.AddJobMutateFn("first", func(job *api.JobInfo) *api.JobMutation {
+		if job.Name == "b" {
+			return &api.JobMutation{Priority: priority(10)}
+		}
+		return nil
+	})
+
+	ssn.mutateJobs()
+	for name, expected := range map[string]int32{"a": 20, "b": 10, "c": 1} {
+		assert.Equal(t, expected, ssn.Jobs[api.JobID("ns1/"+name)].Priority, "priority of job %s", name)
+	}
+}
+
+func TestVictimFilterFn(t *testing.T) {
+	trueValue := true
+	task := func(name string) *api.TaskInfo {
+		return &api.TaskInfo{UID: api.TaskID(name), Namespace: "ns1", Name: name}
+	}
+	preemptor, a, b := task("preemptor"), task("a"), task("b")
+	protectA := func(_ *api.TaskInfo, evictees []*api.TaskInfo) []*api.TaskInfo {
+		var allowed []*api.TaskInfo
+		for _, evictee := range evictees {
+			if evictee.UID != a.UID {
+				allowed = append(allowed, evictee)
+			}
+		}
+		return allowed
+	}
+	permitAll := func(_ *api.TaskInfo, evictees []*api.TaskInfo) ([]*api.TaskInfo, int) { return evictees, 1 }
+
+	ssn := &Session{
+		Tiers: []conf.Tier{
+			{Plugins: []conf.PluginOption{{Name: "protecting", EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue}}},
+			{Plugins: []conf.PluginOption{{Name: "permitting", EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue}}},
+		},
+		preemptableFns:         map[string]api.EvictableFn{"permitting": permitAll},
+		reclaimableFns:         map[string]api.EvictableFn{"permitting": permitAll},
+		preemptVictimFilterFns: map[string]api.VictimFilterFn{"protecting": protectA},
+		reclaimVictimFilterFns: map[string]api.VictimFilterFn{"protecting": protectA},
+	}
+
+	for action, evict := range map[string]func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo{
+		"preempt": ssn.Preemptable,
+		"reclaim": ssn.Reclaimable,
+	} {
+		assert.Equal(t, []*api.TaskInfo{b}, evict(preemptor, []*api.TaskInfo{a, b}), "%s: the later tier may only evict the tasks left", action)
+		assert.Empty(t, evict(preemptor, []*api.TaskInfo{a}), "%s: filtering out every task vetoes the eviction", action)
+	}
+}
+
+func TestRejectEndsTier(t *testing.T) {
+	trueValue := true
+	task := func(name string) *api.TaskInfo {
+		return &api.TaskInfo{UID: api.TaskID(name), Namespace: "ns1", Name: name}
+	}
+	preemptor, a, b := task("preemptor"), task("a"), task("b")
+	// rejectAll votes like capacity while the queue hierarchy is not ready, or tdm for a
+	// preemptable preemptor.
+	rejectAll := func(_ *api.TaskInfo, _ []*api.TaskInfo) ([]*api.TaskInfo, int) { return nil, -1 }
+	permitAll := func(_ *api.TaskInfo, evictees []*api.TaskInfo) ([]*api.TaskInfo, int) { return evictees, 1 }
+
+	ssn := &Session{
+		Tiers: []conf.Tier{
+			{Plugins: []conf.PluginOption{{Name: "rejecting", EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue}}},
+			{Plugins: []conf.PluginOption{{Name: "permitting", EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue}}},
+		},
+		preemptableFns:         map[string]api.EvictableFn{"rejecting": rejectAll, "permitting": permitAll},
+		reclaimableFns:         map[string]api.EvictableFn{"rejecting": rejectAll, "permitting": permitAll},
+		preemptVictimFilterFns: map[string]api.VictimFilterFn{},
+		reclaimVictimFilterFns: map[string]api.VictimFilterFn{},
+	}
+
+	for action, evict := range map[string]func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo{
+		"preempt": ssn.Preemptable,
+		"reclaim": ssn.Reclaimable,
+	} {
+		assert.Equal(t, []*api.TaskInfo{a, b}, evict(preemptor, []*api.TaskInfo{a, b}), "%s: a reject only ends its tier, the later tier decides", action)
+	}
+}
@@ -91,6 +91,8 @@ func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) error {
 		}
 	}
 
+	s.ssn.victimBudget.evicted(reclaimee)
+
 	for _, eh := range s.ssn.eventHandlers {
 		if eh.DeallocateFunc != nil {
 			eh.DeallocateFunc(&Event{
@@ -142,6 +144,8 @@ func (s *Statement) unevict(reclaimee *api.TaskInfo) error {
 		}
 	}
 
+	s.ssn.victimBudget.unevicted(reclaimee)
+
 	for _, eh := range s.ssn.eventHandlers {
 		if eh.AllocateFunc != nil {
 			eh.AllocateFunc(&Event{
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"sync"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// VictimAuditConfName is the name of the configuration enabling the victim audit, e.g.
+	//
+	//	configurations:
+	//	- name: victimAudit
+	//	  arguments:
+	//	    enabled: true
+	//	    configMapNamespace: volcano-system
+	//	    configMapName: victim-audit
+	//
+	// The records are only dumped if configMapName is set, to the namespace of the
+	// scheduler unless configMapNamespace is set.
+	VictimAuditConfName = "victimAudit"
+
+	victimAuditEnabledKey    = "enabled"
+	victimAuditMaxRecordsKey = "maxRecords"
+	victimAuditNamespaceKey  = "configMapNamespace"
+	victimAuditNameKey       = "configMapName"
+
+	defaultVictimAuditMaxRecords = 1000
+
+	// VictimAuditDataKey is the ConfigMap data key holding the JSON encoded records of the last session.
+	VictimAuditDataKey = "records"
+
+	// VictimAuditPreempt and VictimAuditReclaim are the kinds of victim selections.
+	VictimAuditPreempt = "preempt"
+	VictimAuditReclaim = "reclaim"
+
+	// VictimAuditDecision is the plugin name of the record holding the final victims of a selection.
+	VictimAuditDecision = "<decision>"
+)
+
+// VictimAuditRecord is the contribution of one plugin to one victim selection, or the
+// decision of the selection when Plugin is VictimAuditDecision.
+type VictimAuditRecord struct {
+	Kind string `json:"kind"`
+	// Evictor is the namespace/name of the preemptor or reclaimer task.
+	Evictor string `json:"evictor"`
+	Plugin  string `json:"plugin"`
+	Tier    int    `json:"tier"`
+	// Vote is permit, abstain or reject.
+	Vote string `json:"vote"`
+	// Allowed are the candidate victims returned by the plugin.
+	Allowed []string `json:"allowed,omitempty"`
+	// Blocked are the candidate victims the plugin did not return. Blocking all of
+	// them vetoes the selection in the tier.
+	Blocked []string `json:"blocked,omitempty"`
+}
+
+// victimAudit records the contributions of the plugins to the victim selections of a session.
+type victimAudit struct {
+	maxRecords         int
+	configMapNamespace string
+	configMapName      string
+
+	mutex   sync.Mutex
+	records []VictimAuditRecord
+	dropped int
+}
+
+// newVictimAudit returns nil unless the victim audit is enabled in the configurations.
+func newVictimAudit(arguments Arguments) *victimAudit {
+	enabled := false
+	arguments.GetBool(&enabled, victimAuditEnabledKey)
+	if !enabled {
+		return nil
+	}
+	va := &victimAudit{maxRecords: defaultVictimAuditMaxRecords}
+	arguments.GetInt(&va.maxRecords, victimAuditMaxRecordsKey)
+	arguments.GetString(&va.configMapNamespace, victimAuditNamespaceKey)
+	if va.configMapNamespace == "" {
+		va.configMapNamespace = util.SchedulerNamespace()
+	}
+	arguments.GetString(&va.configMapName, victimAuditNameKey)
+	return va
+}
+
+// record adds the contribution of the plugin, a nil audit records nothing.
+func (va *victimAudit) record(kind string, tier int, plugin string, evictor *api.TaskInfo, evictees, allowed []*api.TaskInfo, vote int) {
+	if va == nil {
+		return
+	}
+	r := VictimAuditRecord{
+		Kind:    kind,
+		Evictor: taskKey(evictor),
+		Plugin:  plugin,
+		Tier:    tier,
+		Vote:    voteName(vote),
+	}
+	if vote != 0 {
+		allowedUIDs := make(map[api.TaskID]bool, len(allowed))
+		for _, task := range allowed {
+			allowedUIDs[task.UID] = true
+			r.Allowed = append(r.Allowed, taskKey(task))
+		}
+		for _, task := range evictees {
+			if !allowedUIDs[task.UID] {
+				r.Blocked = append(r.Blocked, taskKey(task))
+			}
+		}
+	}
+
+	va.mutex.Lock()
+	defer va.mutex.Unlock()
+	if len(va.records) >= va.maxRecords {
+		va.dropped++
+		return
+	}
+	va.records = append(va.records, r)
+}
+
+// recordDecision adds the final victims of the selection.
+func (va *victimAudit) recordDecision(kind string, evictor *api.TaskInfo, evictees, victims []*api.TaskInfo) {
+	va.record(kind, -1, VictimAuditDecision, evictor, evictees, victims, 1)
+}
+
+// flush logs the records and dumps them to the ConfigMap if one is configured.
+func (va *victimAudit) flush(client kubernetes.Interface) {
+	if va == nil {
+		return
+	}
+	va.mutex.Lock()
+	defer va.mutex.Unlock()
+
+	for _, r := range va.records {
+		klog.V(3).InfoS("Victim selection", "kind", r.Kind, "evictor", r.Evictor, "plugin", r.Plugin, "tier", r.Tier,
+			"vote", r.Vote, "allowed", r.Allowed, "blocked", r.Blocked)
+	}
+	if va.dropped > 0 {
+		klog.V(3).InfoS("Victim selection records dropped", "dropped", va.dropped, "maxRecords", va.maxRecords)
+	}
+
+	if va.configMapName == "" || client == nil || len(va.records) == 0 {
+		return
+	}
+	data, err := json.Marshal(va.records)
+	if err != nil {
+		klog.Errorf("Failed to encode victim audit records: %v", err)
+		return
+	}
+	if err := util.UpsertConfigMapData(client, va.configMapNamespace, va.configMapName, VictimAuditDataKey, string(data)); err != nil {
+		klog.Errorf("Failed to dump victim audit records to ConfigMap %s/%s: %v", va.configMapNamespace, va.configMapName, err)
+	}
+}
+
+func taskKey(task *api.TaskInfo) string {
+	return task.Namespace + "/" + task.Name
+}
+
+func voteName(vote int) string {
+	switch {
+	case vote > 0:
+		return "permit"
+	case vote < 0:
+		return "reject"
+	default:
+		return "abstain"
+	}
+}
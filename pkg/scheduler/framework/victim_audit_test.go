@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestVictimAudit(t *testing.T) {
+	trueValue := true
+	task := func(name string) *api.TaskInfo {
+		return &api.TaskInfo{UID: api.TaskID(name), Namespace: "ns1", Name: name}
+	}
+	preemptor, a, b := task("preemptor"), task("a"), task("b")
+
+	ssn := &Session{
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+			{Name: "abstaining", EnabledPreemptable: &trueValue},
+			{Name: "permitting", EnabledPreemptable: &trueValue},
+		}}},
+		preemptableFns: map[string]api.EvictableFn{
+			"abstaining": func(*api.TaskInfo, []*api.TaskInfo) ([]*api.TaskInfo, int) { return nil, 0 },
+			"permitting": func(*api.TaskInfo, []*api.TaskInfo) ([]*api.TaskInfo, int) { return []*api.TaskInfo{b}, 1 },
+		},
+		victimAudit: newVictimAudit(Arguments{
+			victimAuditEnabledKey: true,
+			victimAuditNameKey:    "victim-audit",
+		}),
+	}
+
+	victims := ssn.Preemptable(preemptor, []*api.TaskInfo{a, b})
+	assert.Equal(t, []*api.TaskInfo{b}, victims)
+
+	expected := []VictimAuditRecord{
+		{Kind: VictimAuditPreempt, Evictor: "ns1/preemptor", Plugin: "abstaining", Tier: 0, Vote: "abstain"},
+		{Kind: VictimAuditPreempt, Evictor: "ns1/preemptor", Plugin: "permitting", Tier: 0, Vote: "permit", Allowed: []string{"ns1/b"}, Blocked: []string{"ns1/a"}},
+		{Kind: VictimAuditPreempt, Evictor: "ns1/preemptor", Plugin: VictimAuditDecision, Tier: -1, Vote: "permit", Allowed: []string{"ns1/b"}, Blocked: []string{"ns1/a"}},
+	}
+	assert.Equal(t, expected, ssn.victimAudit.records)
+
+	// the records are dumped to the namespace of the scheduler by default
+	client := fake.NewSimpleClientset()
+	ssn.victimAudit.flush(client)
+	cm, err := client.CoreV1().ConfigMaps("volcano-system").Get(context.TODO(), "victim-audit", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the records to be dumped: %v", err)
+	}
+	var dumped []VictimAuditRecord
+	if err := json.Unmarshal([]byte(cm.Data[VictimAuditDataKey]), &dumped); err != nil {
+		t.Fatalf("failed to decode the dumped records: %v", err)
+	}
+	assert.Equal(t, expected, dumped)
+}
+
+func TestVictimAuditDisabled(t *testing.T) {
+	if va := newVictimAudit(nil); va != nil {
+		t.Errorf("expected no audit unless enabled")
+	}
+	// a nil audit records nothing
+	var va *victimAudit
+	va.record(VictimAuditReclaim, 0, "plugin", &api.TaskInfo{}, nil, nil, 1)
+	va.flush(nil)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// VictimBudgetConfName is the name of the configuration capping the evictions of a
+	// session, whichever plugins selected the victims, e.g.
+	//
+	//	configurations:
+	//	- name: victimBudget
+	//	  arguments:
+	//	    maxVictims: 20
+	//	    maxResources:
+	//	      cpu: "64"
+	//	      nvidia.com/gpu: "8"
+	VictimBudgetConfName = "victimBudget"
+
+	victimBudgetMaxVictimsKey   = "maxVictims"
+	victimBudgetMaxResourcesKey = "maxResources"
+)
+
+// victimBudget is the number of tasks and the resources that may still be evicted in the
+// session. The victims of a preemption or reclaim decision are admitted lowest priority
+// first until the budget is spent, the others are kept.
+type victimBudget struct {
+	// maxVictims is the number of evictions allowed, 0 means unlimited.
+	maxVictims int
+	// maxResources is the volume of the evictions allowed in the resources of names.
+	maxResources *api.Resource
+	names        []v1.ResourceName
+
+	victims   int
+	resources *api.Resource
+}
+
+// newVictimBudget returns nil unless a limit is set in the configurations.
+func newVictimBudget(arguments Arguments) *victimBudget {
+	vb := &victimBudget{resources: api.EmptyResource()}
+	arguments.GetInt(&vb.maxVictims, victimBudgetMaxVictimsKey)
+	if vb.maxVictims < 0 {
+		klog.Errorf("Invalid %s %d of %s, the number of victims is not limited", victimBudgetMaxVictimsKey, vb.maxVictims, VictimBudgetConfName)
+		vb.maxVictims = 0
+	}
+
+	maxResources, err := GetStrict[map[string]string](arguments, victimBudgetMaxResourcesKey)
+	if err != nil {
+		klog.Errorf("Invalid %s of %s, the evicted resources are not limited: %v", victimBudgetMaxResourcesKey, VictimBudgetConfName, err)
+	}
+	list := v1.ResourceList{}
+	for name, value := range maxResources {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			klog.Errorf("Invalid %s %s of %s, skipping: %v", victimBudgetMaxResourcesKey, name, VictimBudgetConfName, err)
+			continue
+		}
+		list[v1.ResourceName(name)] = quantity
+		vb.names = append(vb.names, v1.ResourceName(name))
+	}
+	vb.maxResources = api.NewResource(list)
+
+	if vb.maxVictims == 0 && len(vb.names) == 0 {
+		return nil
+	}
+	return vb
+}
+
+// trim returns the victims fitting in the remaining budget, lowest priority first. A nil
+// budget keeps every victim.
+func (vb *victimBudget) trim(kind string, evictor *api.TaskInfo, victims []*api.TaskInfo) []*api.TaskInfo {
+	if vb == nil || len(victims) == 0 {
+		return victims
+	}
+
+	sorted := make([]*api.TaskInfo, len(victims))
+	copy(sorted, victims)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	count := vb.victims
+	used := vb.resources.Clone()
+	var admitted []*api.TaskInfo
+	for _, victim := range sorted {
+		if vb.maxVictims > 0 && count >= vb.maxVictims {
+			break
+		}
+		if !vb.fits(used, victim.Resreq) {
+			continue
+		}
+		count++
+		used.Add(victim.Resreq)
+		admitted = append(admitted, victim)
+	}
+
+	if len(admitted) < len(victims) {
+		klog.V(3).Infof("Victim budget admits %d of the %d victims of %s <%s/%s>, %d tasks evicted in the session",
+			len(admitted), len(victims), kind, evictor.Namespace, evictor.Name, vb.victims)
+	}
+	return admitted
+}
+
+// fits returns whether evicting resreq on top of used stays within maxResources.
+func (vb *victimBudget) fits(used, resreq *api.Resource) bool {
+	for _, name := range vb.names {
+		if used.Get(name)+resreq.Get(name) > vb.maxResources.Get(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// evicted charges the eviction of the task to the budget.
+func (vb *victimBudget) evicted(task *api.TaskInfo) {
+	if vb == nil {
+		return
+	}
+	vb.victims++
+	vb.resources.Add(task.Resreq)
+}
+
+// unevicted refunds the eviction of the task, e.g. when its statement is discarded.
+func (vb *victimBudget) unevicted(task *api.TaskInfo) {
+	if vb == nil {
+		return
+	}
+	vb.victims--
+	vb.resources.Sub(task.Resreq)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestVictimBudget(t *testing.T) {
+	trueValue := true
+	task := func(name string, priority int32, cpu string) *api.TaskInfo {
+		return &api.TaskInfo{UID: api.TaskID(name), Namespace: "ns1", Name: name, Priority: priority,
+			Resreq: api.NewResource(api.BuildResourceList(cpu, "1Gi"))}
+	}
+	preemptor := task("preemptor", 100, "1")
+	high, low, mid := task("high", 10, "1"), task("low", 1, "1"), task("mid", 5, "4")
+	newSession := func(arguments Arguments) *Session {
+		return &Session{
+			Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+				{Name: "permitting", EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue},
+			}}},
+			preemptableFns: map[string]api.EvictableFn{
+				"permitting": func(_ *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) { return preemptees, 1 },
+			},
+			reclaimableFns: map[string]api.EvictableFn{
+				"permitting": func(_ *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) { return reclaimees, 1 },
+			},
+			victimBudget: newVictimBudget(arguments),
+		}
+	}
+
+	t.Run("victim count", func(t *testing.T) {
+		ssn := newSession(Arguments{victimBudgetMaxVictimsKey: 2})
+		victims := ssn.Preemptable(preemptor, []*api.TaskInfo{high, low, mid})
+		assert.Equal(t, []*api.TaskInfo{low, mid}, victims, "expected the lowest priority victims to be admitted first")
+
+		ssn.victimBudget.evicted(low)
+		assert.Equal(t, []*api.TaskInfo{mid}, ssn.Reclaimable(preemptor, []*api.TaskInfo{high, mid}))
+
+		ssn.victimBudget.evicted(mid)
+		assert.Empty(t, ssn.Preemptable(preemptor, []*api.TaskInfo{high}), "expected no victim once the budget is spent")
+
+		ssn.victimBudget.unevicted(mid)
+		assert.Equal(t, []*api.TaskInfo{high}, ssn.Preemptable(preemptor, []*api.TaskInfo{high}), "expected a discarded eviction to be refunded")
+	})
+
+	t.Run("resource volume", func(t *testing.T) {
+		ssn := newSession(Arguments{victimBudgetMaxResourcesKey: map[string]interface{}{"cpu": "3"}})
+		victims := ssn.Preemptable(preemptor, []*api.TaskInfo{high, low, mid})
+		assert.Equal(t, []*api.TaskInfo{low, high}, victims, "expected the victims over the remaining volume to be kept")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, newVictimBudget(nil))
+		ssn := newSession(nil)
+		assert.Equal(t, []*api.TaskInfo{high, low, mid}, ssn.Preemptable(preemptor, []*api.TaskInfo{high, low, mid}))
+	})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// GroupQuotaDeprioritized is the decision label for jobs ordered behind under-quota groups
+	GroupQuotaDeprioritized = "deprioritized"
+	// GroupQuotaRejected is the decision label for jobs rejected by the groupquota plugin
+	GroupQuotaRejected = "rejected"
+)
+
+var (
+	groupQuotaUsage = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "groupquota_group_usage",
+			Help:      "Resource usage of one group accounted by the groupquota plugin",
+		}, []string{"group", "resource"},
+	)
+
+	groupQuotaQuota = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "groupquota_group_quota",
+			Help:      "Resource quota of one group configured in the groupquota plugin",
+		}, []string{"group", "resource"},
+	)
+
+	groupQuotaShare = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "groupquota_group_share",
+			Help:      "Usage-to-deserved ratio of one group, the highest over its limited resources",
+		}, []string{"group"},
+	)
+
+	groupQuotaJobDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "groupquota_group_jobs_total",
+			Help:      "Number of jobs deprioritized or rejected by the groupquota plugin for one group",
+		}, []string{"group", "decision"},
+	)
+)
+
+// UpdateGroupQuotaUsage records resource usage for one group
+func UpdateGroupQuotaUsage(group string, usage map[v1.ResourceName]float64) {
+	for name, value := range usage {
+		groupQuotaUsage.WithLabelValues(group, string(name)).Set(value)
+	}
+}
+
+// UpdateGroupQuotaQuota records resource quota for one group
+func UpdateGroupQuotaQuota(group string, quota map[v1.ResourceName]float64) {
+	for name, value := range quota {
+		groupQuotaQuota.WithLabelValues(group, string(name)).Set(value)
+	}
+}
+
+// UpdateGroupQuotaShare records the usage-to-deserved ratio of one group
+func UpdateGroupQuotaShare(group string, share float64) {
+	groupQuotaShare.WithLabelValues(group).Set(share)
+}
+
+// RegisterGroupQuotaJobDecision records that a job of the group was deprioritized or rejected
+func RegisterGroupQuotaJobDecision(group, decision string) {
+	groupQuotaJobDecisions.WithLabelValues(group, decision).Inc()
+}
+
+// ResetGroupQuotaGauges removes the usage, quota and share series of all groups,
+// so that groups which disappeared do not keep reporting stale values.
+func ResetGroupQuotaGauges() {
+	groupQuotaUsage.Reset()
+	groupQuotaQuota.Reset()
+	groupQuotaShare.Reset()
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestGroupQuotaMetrics(t *testing.T) {
+	UpdateGroupQuotaUsage("team-a", map[v1.ResourceName]float64{v1.ResourceCPU: 1.5})
+	UpdateGroupQuotaQuota("team-a", map[v1.ResourceName]float64{v1.ResourceCPU: 4})
+	RegisterGroupQuotaJobDecision("team-a", GroupQuotaDeprioritized)
+	RegisterGroupQuotaJobDecision("team-a", GroupQuotaDeprioritized)
+
+	assert.Equal(t, 1.5, testutil.ToFloat64(groupQuotaUsage.WithLabelValues("team-a", "cpu")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(groupQuotaQuota.WithLabelValues("team-a", "cpu")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(groupQuotaJobDecisions.WithLabelValues("team-a", GroupQuotaDeprioritized)))
+
+	ResetGroupQuotaGauges()
+	assert.Equal(t, 0, testutil.CollectAndCount(groupQuotaUsage))
+	assert.Equal(t, 0, testutil.CollectAndCount(groupQuotaQuota))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+// MaxJobEffectivePrioritySeries bounds the number of jobs reported by the per-job
+// effective priority gauge; the jobs with the highest priority are kept.
+const MaxJobEffectivePrioritySeries = 500
+
+// jobEffectivePriorityBandBounds are the upper bounds, included, of the priority bands; the
+// jobs above the last bound are in the +Inf band.
+var jobEffectivePriorityBandBounds = []int32{0, 100, 1000, 10000, 100000, 1000000, 1000000000}
+
+var (
+	jobEffectivePriority = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_effective_priority",
+			Help:      "Priority of one job at session close, after all plugins ran",
+		}, []string{"queue", "namespace", "job"},
+	)
+
+	jobEffectivePriorityBands = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "job_effective_priority_band",
+			Help:      "Number of jobs at session close, by priority band",
+		}, []string{"band"},
+	)
+)
+
+// JobPriority is the effective priority of one job.
+type JobPriority struct {
+	Queue     string
+	Namespace string
+	Job       string
+	Priority  int32
+}
+
+// UpdateJobEffectivePriorities replaces the per-job effective priority series with the
+// given jobs, keeping at most MaxJobEffectivePrioritySeries of them, and replaces the
+// number of jobs of every priority band with the count of all of them.
+func UpdateJobEffectivePriorities(jobs []JobPriority) {
+	jobEffectivePriority.Reset()
+	jobEffectivePriorityBands.Reset()
+	bands := make([]int, len(jobEffectivePriorityBandBounds)+1)
+	for _, job := range jobs {
+		bands[sort.Search(len(jobEffectivePriorityBandBounds), func(i int) bool {
+			return job.Priority <= jobEffectivePriorityBandBounds[i]
+		})]++
+	}
+	for i, count := range bands {
+		jobEffectivePriorityBands.WithLabelValues(priorityBandLabel(i)).Set(float64(count))
+	}
+
+	sampled := make([]JobPriority, len(jobs))
+	copy(sampled, jobs)
+	sort.Slice(sampled, func(i, j int) bool {
+		if sampled[i].Priority != sampled[j].Priority {
+			return sampled[i].Priority > sampled[j].Priority
+		}
+		if sampled[i].Namespace != sampled[j].Namespace {
+			return sampled[i].Namespace < sampled[j].Namespace
+		}
+		return sampled[i].Job < sampled[j].Job
+	})
+	if len(sampled) > MaxJobEffectivePrioritySeries {
+		sampled = sampled[:MaxJobEffectivePrioritySeries]
+	}
+	for _, job := range sampled {
+		jobEffectivePriority.WithLabelValues(job.Queue, job.Namespace, job.Job).Set(float64(job.Priority))
+	}
+}
+
+// priorityBandLabel returns the label of the i-th priority band, i.e. its upper bound.
+func priorityBandLabel(i int) string {
+	if i == len(jobEffectivePriorityBandBounds) {
+		return "+Inf"
+	}
+	return strconv.Itoa(int(jobEffectivePriorityBandBounds[i]))
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateJobEffectivePriorities(t *testing.T) {
+	UpdateJobEffectivePriorities([]JobPriority{
+		{Queue: "q1", Namespace: "ns1", Job: "job1", Priority: 100},
+		{Queue: "q1", Namespace: "ns1", Job: "job2", Priority: 1000},
+	})
+	assert.Equal(t, 100.0, testutil.ToFloat64(jobEffectivePriority.WithLabelValues("q1", "ns1", "job1")))
+	assert.Equal(t, 1000.0, testutil.ToFloat64(jobEffectivePriority.WithLabelValues("q1", "ns1", "job2")))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("100")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("1000")))
+
+	UpdateJobEffectivePriorities([]JobPriority{{Queue: "q1", Namespace: "ns1", Job: "job2", Priority: 2000}})
+	assert.Equal(t, 1, testutil.CollectAndCount(jobEffectivePriority), "expected series of gone jobs to be removed")
+	assert.Equal(t, 0.0, testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("100")), "expected the bands to count the jobs of the last session only")
+	assert.Equal(t, 0.0, testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("1000")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("10000")))
+	assert.Equal(t, len(jobEffectivePriorityBandBounds)+1, testutil.CollectAndCount(jobEffectivePriorityBands))
+
+	var jobs []JobPriority
+	for i := 0; i <= MaxJobEffectivePrioritySeries; i++ {
+		jobs = append(jobs, JobPriority{Queue: "q1", Namespace: "ns1", Job: fmt.Sprintf("job-%d", i), Priority: int32(i)})
+	}
+	UpdateJobEffectivePriorities(jobs)
+	assert.Equal(t, MaxJobEffectivePrioritySeries, testutil.CollectAndCount(jobEffectivePriority))
+	assert.Equal(t, float64(len(jobs)-101), testutil.ToFloat64(jobEffectivePriorityBands.WithLabelValues("1000")), "expected the bands to count every job, not only the sampled ones")
+	assert.Equal(t, float64(MaxJobEffectivePrioritySeries), testutil.ToFloat64(jobEffectivePriority.WithLabelValues("q1", "ns1", fmt.Sprintf("job-%d", MaxJobEffectivePrioritySeries))))
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+const (
+	// JobOrderCallback and the following are the callback labels of the plugin callback metrics
+	JobOrderCallback       = "JobOrder"
+	TaskOrderCallback      = "TaskOrder"
+	PredicateCallback      = "Predicate"
+	NodeOrderCallback      = "NodeOrder"
+	PreemptableCallback    = "Preemptable"
+	ReclaimableCallback    = "Reclaimable"
+	JobEnqueueableCallback = "JobEnqueueable"
+	JobPipelinedCallback   = "JobPipelined"
+	AllocatableCallback    = "Allocatable"
+
+	// DecisionPermit and the following are the decision labels of the plugin callback metrics
+	DecisionPermit  = "permit"
+	DecisionAbstain = "abstain"
+	DecisionReject  = "reject"
+)
+
+var (
+	pluginCallbackLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "plugin_callback_latency_microseconds",
+			Help:      "Latency of one invocation of a plugin callback in microseconds",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+		}, []string{"plugin", "callback"},
+	)
+
+	pluginCallbackDecisions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "plugin_callback_decisions_total",
+			Help:      "Number of permit, abstain and reject decisions of a plugin callback",
+		}, []string{"plugin", "callback", "decision"},
+	)
+)
+
+// UpdatePluginCallbackDuration records the latency of one invocation of a plugin callback
+func UpdatePluginCallbackDuration(pluginName, callback string, duration time.Duration) {
+	pluginCallbackLatency.WithLabelValues(pluginName, callback).Observe(DurationInMicroseconds(duration))
+}
+
+// RegisterPluginCallbackDecision records the decision of one invocation of a plugin callback
+func RegisterPluginCallbackDecision(pluginName, callback, decision string) {
+	pluginCallbackDecisions.WithLabelValues(pluginName, callback, decision).Inc()
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+var (
+	sloBreaches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "slo_breach_total",
+			Help:      "Number of jobs which waited longer than the time-to-schedule target of their band",
+		}, []string{"band", "queue"},
+	)
+
+	sloTimeToSchedule = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "slo_time_to_schedule_seconds",
+			Help:      "Time from the creation of a job until it is scheduled, by band and queue",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 16),
+		}, []string{"band", "queue"},
+	)
+)
+
+// RegisterSLOBreach records that a job of the band and queue breached its time-to-schedule target
+func RegisterSLOBreach(band, queue string) {
+	sloBreaches.WithLabelValues(band, queue).Inc()
+}
+
+// UpdateSLOTimeToSchedule records the time-to-schedule of a job of the band and queue
+func UpdateSLOTimeToSchedule(band, queue string, seconds float64) {
+	sloTimeToSchedule.WithLabelValues(band, queue).Observe(seconds)
+}
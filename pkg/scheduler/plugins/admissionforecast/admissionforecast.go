@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admissionforecast forecasts, before a job is enqueued, whether its gang can be
+// placed on the idle resources of the nodes plus the resources reclaimable from queues
+// above their guarantee, and records the forecast in a PodGroup condition.
+package admissionforecast
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "admissionforecast"
+
+	// AdmissionForecastType is the PodGroup condition holding the forecast of the last session.
+	AdmissionForecastType scheduling.PodGroupConditionType = "AdmissionForecast"
+	// ForecastFitReason is the reason of a forecast that the gang can be placed.
+	ForecastFitReason = "GangFits"
+	// ForecastNoFitReason is the reason of a forecast that the gang cannot be placed.
+	ForecastNoFitReason = "GangDoesNotFit"
+
+	// rejectNoFitKey rejects the enqueue of jobs forecast not to fit, true by default.
+	rejectNoFitKey = "rejectNoFit"
+)
+
+type admissionForecastPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	rejectNoFit bool
+}
+
+// New return admissionforecast plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &admissionForecastPlugin{pluginArguments: arguments}
+}
+
+func (ap *admissionForecastPlugin) Name() string {
+	return PluginName
+}
+
+func (ap *admissionForecastPlugin) OnSessionOpen(ssn *framework.Session) {
+	ap.rejectNoFit = true
+	ap.pluginArguments.GetBool(&ap.rejectNoFit, rejectNoFitKey)
+
+	overGuarantee := reclaimableOverGuarantee(ssn)
+
+	jobEnqueueableFn := func(obj interface{}) int {
+		job := obj.(*api.JobInfo)
+		if !job.IsPending() {
+			return util.Abstain
+		}
+
+		reclaimable := api.EmptyResource()
+		for queue, resource := range overGuarantee {
+			if queue != job.Queue {
+				reclaimable.Add(resource)
+			}
+		}
+
+		fits, msg := forecast(ssn, job, reclaimable)
+		ap.recordForecast(ssn, job, fits, msg)
+		if fits || !ap.rejectNoFit {
+			return util.Abstain
+		}
+		klog.V(3).Infof("admissionforecast: reject enqueue of job <%s/%s>: %s", job.Namespace, job.Name, msg)
+		return util.Reject
+	}
+	ssn.AddJobEnqueueableFn(ap.Name(), jobEnqueueableFn)
+}
+
+func (ap *admissionForecastPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// recordForecast sets the forecast condition of the job, keeping its transition time if
+// the forecast did not change.
+func (ap *admissionForecastPlugin) recordForecast(ssn *framework.Session, job *api.JobInfo, fits bool, msg string) {
+	cond := &scheduling.PodGroupCondition{
+		Type:               AdmissionForecastType,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		TransitionID:       string(ssn.UID),
+		Reason:             ForecastFitReason,
+		Message:            msg,
+	}
+	if !fits {
+		cond.Status = v1.ConditionFalse
+		cond.Reason = ForecastNoFitReason
+	}
+	for _, c := range job.PodGroup.Status.Conditions {
+		if c.Type == AdmissionForecastType && c.Status == cond.Status {
+			cond.LastTransitionTime = c.LastTransitionTime
+		}
+	}
+	if err := ssn.UpdatePodGroupCondition(job, cond); err != nil {
+		klog.Errorf("admissionforecast: failed to update condition of job <%s/%s>: %v", job.Namespace, job.Name, err)
+	}
+}
+
+// forecast places the smallest pending tasks completing the gang of the job, largest first,
+// on the idle resources of the nodes. The tasks left must fit in the reclaimable resources,
+// and each on the allocatable resources of a node.
+func forecast(ssn *framework.Session, job *api.JobInfo, reclaimable *api.Resource) (bool, string) {
+	needed := int(job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum())
+	if needed <= 0 {
+		return true, "gang is already placed"
+	}
+
+	var pending []*api.TaskInfo
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		pending = append(pending, task)
+	}
+	if len(pending) < needed {
+		return false, fmt.Sprintf("gang needs %d tasks, only %d pending", needed, len(pending))
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].Resreq.MilliCPU != pending[j].Resreq.MilliCPU {
+			return pending[i].Resreq.MilliCPU < pending[j].Resreq.MilliCPU
+		}
+		return pending[i].Resreq.Memory < pending[j].Resreq.Memory
+	})
+	gang := pending[:needed]
+
+	var nodes []*api.NodeInfo
+	idle := make(map[string]*api.Resource, len(ssn.Nodes))
+	for _, node := range ssn.Nodes {
+		nodes = append(nodes, node)
+		idle[node.Name] = node.FutureIdle()
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	unplaced := api.EmptyResource()
+	for i := len(gang) - 1; i >= 0; i-- {
+		task := gang[i]
+		placed := false
+		fitsAnyNode := false
+		for _, node := range nodes {
+			if task.Resreq.LessEqual(idle[node.Name], api.Zero) {
+				idle[node.Name].Sub(task.Resreq)
+				placed = true
+				break
+			}
+			fitsAnyNode = fitsAnyNode || task.Resreq.LessEqual(node.Allocatable, api.Zero)
+		}
+		if placed {
+			continue
+		}
+		if !fitsAnyNode {
+			return false, fmt.Sprintf("task %s requesting <%v> fits on no node", task.Name, task.Resreq)
+		}
+		unplaced.Add(task.Resreq)
+	}
+
+	if ok, missing := unplaced.LessEqualWithResourcesName(reclaimable, api.Zero); !ok {
+		return false, fmt.Sprintf("missing %v: gang needs <%v> beyond the idle resources, only <%v> reclaimable",
+			missing, unplaced, reclaimable)
+	}
+	if unplaced.IsEmpty() {
+		return true, "gang fits in the idle resources"
+	}
+	return true, fmt.Sprintf("gang fits in the idle resources after reclaiming <%v>", unplaced)
+}
+
+// reclaimableOverGuarantee returns the resources allocated to each reclaimable queue above its guarantee.
+func reclaimableOverGuarantee(ssn *framework.Session) map[api.QueueID]*api.Resource {
+	allocated := make(map[api.QueueID]*api.Resource)
+	for _, job := range ssn.Jobs {
+		queue, found := ssn.Queues[job.Queue]
+		if !found || !queue.Reclaimable() || job.Allocated.IsEmpty() {
+			continue
+		}
+		if _, found := allocated[job.Queue]; !found {
+			allocated[job.Queue] = api.EmptyResource()
+		}
+		allocated[job.Queue].Add(job.Allocated)
+	}
+
+	overGuarantee := make(map[api.QueueID]*api.Resource, len(allocated))
+	for queueID, resource := range allocated {
+		guarantee := api.EmptyResource()
+		if queue := ssn.Queues[queueID]; queue.Queue.Spec.Guarantee.Resource != nil {
+			guarantee = api.NewResource(queue.Queue.Spec.Guarantee.Resource)
+		}
+		over := resource.Clone()
+		over.MilliCPU = max(resource.MilliCPU-guarantee.MilliCPU, 0)
+		over.Memory = max(resource.Memory-guarantee.Memory, 0)
+		for name, value := range resource.ScalarResources {
+			over.SetScalar(name, max(value-guarantee.Get(name), 0))
+		}
+		overGuarantee[queueID] = over
+	}
+	return overGuarantee
+}
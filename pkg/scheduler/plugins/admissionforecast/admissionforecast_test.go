@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissionforecast
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestAdmissionForecast(t *testing.T) {
+	// q2 holds 3 CPU with a guarantee of 1 CPU, so 2 CPU are reclaimable, and 1 CPU is idle
+	q2 := util.BuildQueue("q2", 1, nil)
+	q2.Spec.Guarantee.Resource = api.BuildResourceList("1", "0")
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroup("pg-running", "ns1", "q2", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroup("pg-idle", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending),
+		util.BuildPodGroup("pg-reclaim", "ns1", "q1", 2, nil, vcapisv1.PodGroupPending),
+		util.BuildPodGroup("pg-too-large", "ns1", "q1", 3, nil, vcapisv1.PodGroupPending),
+		util.BuildPodGroup("pg-too-big-task", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "running", "node1", v1.PodRunning, api.BuildResourceList("3", "1Gi"), "pg-running", nil, nil),
+		util.BuildPod("ns1", "idle", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-idle", nil, nil),
+		util.BuildPod("ns1", "reclaim1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-reclaim", nil, nil),
+		util.BuildPod("ns1", "reclaim2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-reclaim", nil, nil),
+		util.BuildPod("ns1", "too-large1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-too-large", nil, nil),
+		util.BuildPod("ns1", "too-large2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-too-large", nil, nil),
+		util.BuildPod("ns1", "too-large3", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-too-large", nil, nil),
+		util.BuildPod("ns1", "too-big-task", "", v1.PodPending, api.BuildResourceList("5", "1Gi"), "pg-too-big-task", nil, nil),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "admission forecast",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil), q2},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobEnqueued: &trueValue,
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	tests := []struct {
+		job        api.JobID
+		enqueuable bool
+		status     v1.ConditionStatus
+	}{
+		{job: "ns1/pg-idle", enqueuable: true, status: v1.ConditionTrue},
+		{job: "ns1/pg-reclaim", enqueuable: true, status: v1.ConditionTrue},
+		{job: "ns1/pg-too-large", enqueuable: false, status: v1.ConditionFalse},
+		{job: "ns1/pg-too-big-task", enqueuable: false, status: v1.ConditionFalse},
+	}
+	for _, test := range tests {
+		job := ssn.Jobs[test.job]
+		if got := ssn.JobEnqueueable(job); got != test.enqueuable {
+			t.Errorf("job %s: expected enqueueable %v, got %v", test.job, test.enqueuable, got)
+		}
+		found := false
+		for _, c := range job.PodGroup.Status.Conditions {
+			if c.Type == AdmissionForecastType {
+				found = true
+				if c.Status != test.status {
+					t.Errorf("job %s: expected forecast %v, got %v: %s", test.job, test.status, c.Status, c.Message)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("job %s: expected a forecast condition", test.job)
+		}
+	}
+}
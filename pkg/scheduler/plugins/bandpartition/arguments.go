@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandpartition
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+// bandsKey is the list of priority bands, in order of precedence.
+const bandsKey = "bands"
+
+// bandConfig is the configuration of one priority band, e.g.
+//
+//	bands:
+//	- name: critical
+//	  share: 0.6
+//	  priorities:
+//	    expressions:
+//	    - operator: GreaterThan
+//	      values: [999]
+type bandConfig struct {
+	// Name identifies the band in logs.
+	Name string `json:"name"`
+	// Share is the fraction of the cluster capacity partitioned to the band, in (0, 1].
+	Share float64 `json:"share"`
+	// Priorities selects the job priorities of the band.
+	Priorities interface{} `json:"priorities"`
+}
+
+// ValidateArguments rejects the bands which would leave the cluster unpartitioned.
+func ValidateArguments(arguments framework.Arguments) error {
+	_, err := parseBands(arguments[bandsKey])
+	return err
+}
+
+// parseBands decodes and validates the bands, every error is reported. The shares of
+// all bands must not exceed the cluster capacity.
+func parseBands(raw interface{}) ([]*band, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var configs []bandConfig
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &configs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode bands: %v", err)
+	}
+
+	var errs []error
+	var bands []*band
+	names := map[string]bool{}
+	total := 0.0
+	for i, config := range configs {
+		b, err := config.parse()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %v", bandsKey, i, err))
+			continue
+		}
+		if names[b.name] {
+			errs = append(errs, fmt.Errorf("%s[%d]: duplicate band %s", bandsKey, i, b.name))
+			continue
+		}
+		names[b.name] = true
+		total += b.share
+		bands = append(bands, b)
+	}
+	if total > 1 {
+		errs = append(errs, fmt.Errorf("%s: shares sum up to %v, more than the cluster capacity", bandsKey, total))
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return bands, nil
+}
+
+func (c bandConfig) parse() (*band, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if c.Share <= 0 || c.Share > 1 {
+		return nil, fmt.Errorf("share %v of band %s is not in (0, 1]", c.Share, c.Name)
+	}
+	if c.Priorities == nil {
+		return nil, fmt.Errorf("priorities of band %s are required", c.Name)
+	}
+	selector, err := priority.ParseSelector(c.Priorities)
+	if err != nil {
+		return nil, fmt.Errorf("priorities of band %s: %v", c.Name, err)
+	}
+	return &band{name: c.Name, share: c.Share, priorities: selector}, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bandpartition partitions the cluster capacity among priority bands, so that each
+// band is guaranteed its share instead of being starved by the bands above it. A job belongs
+// to the first band selecting its priority; jobs of no band are not partitioned.
+package bandpartition
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+// PluginName indicates name of volcano scheduler plugin
+const PluginName = "bandpartition"
+
+// band is a priority band and its partition of the cluster.
+type band struct {
+	name       string
+	share      float64
+	priorities *priority.PrioritySelector
+
+	deserved  *api.Resource
+	allocated *api.Resource
+}
+
+type bandPartitionPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	// bands are in order of precedence.
+	bands   []*band
+	jobBand map[api.JobID]*band
+}
+
+// New return bandpartition plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &bandPartitionPlugin{pluginArguments: arguments}
+}
+
+func (bp *bandPartitionPlugin) Name() string {
+	return PluginName
+}
+
+func (bp *bandPartitionPlugin) OnSessionOpen(ssn *framework.Session) {
+	bands, err := parseBands(bp.pluginArguments[bandsKey])
+	if err != nil {
+		klog.Errorf("bandpartition plugin: invalid %s, the cluster is not partitioned: %v", bandsKey, err)
+		return
+	}
+	bp.bands = bands
+	for _, b := range bp.bands {
+		b.priorities = b.priorities.Resolve(ssn.PriorityClasses)
+		b.deserved = ssn.TotalResource.Clone().Multi(b.share)
+		b.allocated = api.EmptyResource()
+	}
+
+	bp.jobBand = make(map[api.JobID]*band, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		b := bp.bandOf(job)
+		if b == nil {
+			continue
+		}
+		bp.jobBand[job.UID] = b
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				b.allocated.Add(task.Resreq)
+			}
+		}
+	}
+	for _, b := range bp.bands {
+		klog.V(4).Infof("Band <%s>: share <%v>, deserved <%v>, allocated <%v>", b.name, b.share, b.deserved, b.allocated)
+	}
+
+	ssn.AddAllocatableFn(bp.Name(), func(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
+		b, found := bp.jobBand[candidate.Job]
+		if !found {
+			return true
+		}
+		futureUsed := b.allocated.Clone().Add(candidate.Resreq)
+		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(b.deserved, candidate.Resreq)
+		if !allocatable {
+			klog.V(3).Infof("Band <%s>: deserved <%v>, allocated <%v>; Candidate <%v>: resource request <%v>",
+				b.name, b.deserved, b.allocated, candidate.Name, candidate.Resreq)
+		}
+		return allocatable
+	})
+
+	// Tasks are only taken from the bands over their partition, and only as long as they stay over it.
+	victimsFn := func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		reclaimerBand, found := bp.jobBand[reclaimer.Job]
+		if !found {
+			return nil, util.Abstain
+		}
+
+		var victims []*api.TaskInfo
+		allocations := map[*band]*api.Resource{}
+		for _, reclaimee := range reclaimees {
+			b, found := bp.jobBand[reclaimee.Job]
+			if !found || b == reclaimerBand {
+				continue
+			}
+			if _, found := allocations[b]; !found {
+				allocations[b] = b.allocated.Clone()
+			}
+			allocated := allocations[b]
+			if !allocated.LessEqual(b.deserved, api.Zero) {
+				allocated.Sub(reclaimee.Resreq)
+				victims = append(victims, reclaimee)
+			}
+		}
+		if len(victims) == 0 {
+			return nil, util.Abstain
+		}
+		klog.V(4).Infof("Victims from bandpartition plugin are %+v", victims)
+		return victims, util.Permit
+	}
+	ssn.AddReclaimableFn(bp.Name(), victimsFn)
+	ssn.AddPreemptableFn(bp.Name(), victimsFn)
+
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			if b, found := bp.jobBand[event.Task.Job]; found {
+				b.allocated.Add(event.Task.Resreq)
+			}
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			if b, found := bp.jobBand[event.Task.Job]; found {
+				b.allocated.Sub(event.Task.Resreq)
+			}
+		},
+	})
+}
+
+// bandOf returns the first band selecting the priority of the job, nil if there is none.
+func (bp *bandPartitionPlugin) bandOf(job *api.JobInfo) *band {
+	for _, b := range bp.bands {
+		if b.priorities.Matches(job.Priority) {
+			return b
+		}
+	}
+	return nil
+}
+
+func (bp *bandPartitionPlugin) OnSessionClose(ssn *framework.Session) {
+	bp.bands = nil
+	bp.jobBand = nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bandpartition
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func bandsArgument() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name":  "high",
+			"share": 0.6,
+			"priorities": map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}},
+				},
+			},
+		},
+		map[string]interface{}{
+			"name":  "low",
+			"share": "0.4",
+			"priorities": map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "LessThan", "values": []interface{}{1000}},
+				},
+			},
+		},
+	}
+}
+
+func TestBandPartition(t *testing.T) {
+	req := api.BuildResourceList("2", "2Gi")
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "low-1", "node1", v1.PodRunning, req, "pg-low-running", nil, nil),
+		util.BuildPod("ns1", "low-2", "node1", v1.PodRunning, req, "pg-low-running", nil, nil),
+		util.BuildPod("ns1", "low-3", "node1", v1.PodRunning, req, "pg-low-running", nil, nil),
+		util.BuildPod("ns1", "high-1", "node1", v1.PodRunning, req, "pg-high-running", nil, nil),
+		util.BuildPod("ns1", "low-pending", "", v1.PodPending, req, "pg-low-pending", nil, nil),
+		util.BuildPod("ns1", "high-pending", "", v1.PodPending, req, "pg-high-pending", nil, nil),
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithPrio("pg-low-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low"),
+		util.BuildPodGroupWithPrio("pg-high-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "high"),
+		util.BuildPodGroupWithPrio("pg-low-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, "low"),
+		util.BuildPodGroupWithPrio("pg-high-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, "high"),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "bandpartition",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("10", "10Gi", []api.ScalarResource{{Name: "pods", Value: "100"}}...), nil),
+		},
+		Queues:   []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		PriClass: []*schedulingv1.PriorityClass{util.BuildPriorityClass("low", 10), util.BuildPriorityClass("high", 1000)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledAllocatable: &trueValue,
+			EnabledReclaimable: &trueValue,
+			Arguments:          framework.Arguments{bandsKey: bandsArgument()},
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	taskOf := func(jobID api.JobID, name string) *api.TaskInfo {
+		for _, task := range ssn.Jobs[jobID].Tasks {
+			if task.Name == name {
+				return task
+			}
+		}
+		t.Fatalf("task %s not found", name)
+		return nil
+	}
+	queue := ssn.Queues["q1"]
+	lowPending, highPending := taskOf("ns1/pg-low-pending", "low-pending"), taskOf("ns1/pg-high-pending", "high-pending")
+
+	// The low band uses 6 of its 4 CPUs, the high band 2 of its 6 CPUs.
+	if ssn.Allocatable(queue, lowPending) {
+		t.Errorf("expected the over-partition low band not to be allocatable")
+	}
+	if !ssn.Allocatable(queue, highPending) {
+		t.Errorf("expected the high band to be allocatable within its partition")
+	}
+
+	var reclaimees []*api.TaskInfo
+	for _, name := range []string{"low-1", "low-2", "low-3"} {
+		reclaimees = append(reclaimees, taskOf("ns1/pg-low-running", name))
+	}
+	reclaimees = append(reclaimees, taskOf("ns1/pg-high-running", "high-1"))
+	if victims := ssn.Reclaimable(highPending, reclaimees); len(victims) != 1 || victims[0].Name == "high-1" {
+		t.Errorf("expected one task of the low band to be reclaimed back to its partition, got %v", victims)
+	}
+	if victims := ssn.Reclaimable(lowPending, reclaimees); len(victims) != 0 {
+		t.Errorf("expected no victims in the high band within its partition, got %v", victims)
+	}
+}
+
+func TestParseBands(t *testing.T) {
+	bands, err := parseBands(bandsArgument())
+	if err != nil || len(bands) != 2 || bands[1].share != 0.4 {
+		t.Fatalf("unexpected bands %v, error %v", bands, err)
+	}
+
+	over := bandsArgument()
+	over[1].(map[string]interface{})["share"] = 0.5
+	if _, err := parseBands(over); err == nil {
+		t.Errorf("expected an error for shares over the cluster capacity")
+	}
+
+	duplicate := bandsArgument()
+	duplicate[1].(map[string]interface{})["name"] = "high"
+	if _, err := parseBands(duplicate); err == nil {
+		t.Errorf("expected an error for duplicate bands")
+	}
+
+	if _, err := parseBands([]interface{}{map[string]interface{}{"name": "any", "share": 1}}); err == nil {
+		t.Errorf("expected an error for a band without priorities")
+	}
+}
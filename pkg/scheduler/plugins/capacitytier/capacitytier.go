@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capacitytier places the jobs of low priority bands on spot nodes and keeps the
+// other bands on on-demand nodes. Nodes are tiered by a label, e.g.
+//
+//	arguments:
+//	  tierLabel: node.volcano.sh/capacity-tier
+//	  spotValue: spot
+//	  spot.weight: 1
+//	  spotPriorities:
+//	    expressions:
+//	    - operator: LessThan
+//	      values: [1000]
+//
+// Jobs selected by spotPriorities prefer spot nodes. When spot capacity shrinks, their tasks
+// spill over to on-demand nodes, where the jobs of the other bands may preempt them.
+package capacitytier
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+	k8sFramework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "capacitytier"
+
+	defaultTierLabel = "node.volcano.sh/capacity-tier"
+	defaultSpotValue = "spot"
+
+	// tierLabelKey is the node label holding the capacity tier of the node.
+	tierLabelKey = "tierLabel"
+	// spotValueKey is the value of the tier label on spot nodes, other nodes are on-demand.
+	spotValueKey = "spotValue"
+	// spotPrioritiesKey selects the job priorities allowed on spot nodes.
+	spotPrioritiesKey = "spotPriorities"
+	// spotWeightKey is the weight of the spot node score.
+	spotWeightKey = "spot.weight"
+)
+
+type capacityTierPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	tierLabel      string
+	spotValue      string
+	spotWeight     int
+	spotPriorities *priority.PrioritySelector
+}
+
+// New return capacitytier plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &capacityTierPlugin{
+		pluginArguments: arguments,
+		tierLabel:       defaultTierLabel,
+		spotValue:       defaultSpotValue,
+		spotWeight:      1,
+	}
+}
+
+func (cp *capacityTierPlugin) Name() string {
+	return PluginName
+}
+
+func (cp *capacityTierPlugin) parseArguments() {
+	if v, ok := cp.pluginArguments[tierLabelKey].(string); ok && v != "" {
+		cp.tierLabel = v
+	}
+	if v, ok := cp.pluginArguments[spotValueKey].(string); ok && v != "" {
+		cp.spotValue = v
+	}
+	cp.pluginArguments.GetInt(&cp.spotWeight, spotWeightKey)
+	if selector, err := priority.ParseSelector(cp.pluginArguments[spotPrioritiesKey]); err != nil {
+		klog.Errorf("capacitytier plugin: invalid %s, no priority may use spot nodes: %v", spotPrioritiesKey, err)
+	} else {
+		cp.spotPriorities = selector
+	}
+}
+
+func (cp *capacityTierPlugin) OnSessionOpen(ssn *framework.Session) {
+	cp.parseArguments()
+	cp.spotPriorities = cp.spotPriorities.Resolve(ssn.PriorityClasses)
+
+	spotBand := func(task *api.TaskInfo) bool {
+		job, found := ssn.Jobs[task.Job]
+		return found && cp.spotPriorities.Matches(job.Priority)
+	}
+	spotNode := func(nodeName string) bool {
+		node, found := ssn.Nodes[nodeName]
+		return found && node.Node != nil && node.Node.Labels[cp.tierLabel] == cp.spotValue
+	}
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if !spotNode(node.Name) || spotBand(task) {
+			return nil
+		}
+		return api.NewFitError(task, node, fmt.Sprintf("priority of the job is not allowed on %s nodes", cp.spotValue))
+	}
+	ssn.AddPredicateFn(cp.Name(), predicateFn)
+
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		if !spotNode(node.Name) || !spotBand(task) {
+			return 0, nil
+		}
+		score := float64(k8sFramework.MaxNodeScore * int64(cp.spotWeight))
+		klog.V(4).Infof("capacitytier score for Task %s/%s on spot node %s is: %v", task.Namespace, task.Name, node.Name, score)
+		return score, nil
+	}
+	ssn.AddNodeOrderFn(cp.Name(), nodeOrderFn)
+
+	// The tasks of spot bands running on on-demand nodes only borrow that capacity.
+	victimsFn := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		if spotBand(preemptor) {
+			return nil, util.Abstain
+		}
+		var victims []*api.TaskInfo
+		for _, preemptee := range preemptees {
+			if spotBand(preemptee) && !spotNode(preemptee.NodeName) {
+				victims = append(victims, preemptee)
+			}
+		}
+		if len(victims) == 0 {
+			return nil, util.Abstain
+		}
+		klog.V(4).Infof("capacitytier: %d tasks of spot bands on on-demand nodes are victims of <%s/%s>",
+			len(victims), preemptor.Namespace, preemptor.Name)
+		return victims, util.Permit
+	}
+	ssn.AddPreemptableFn(cp.Name(), victimsFn)
+	ssn.AddReclaimableFn(cp.Name(), victimsFn)
+}
+
+func (cp *capacityTierPlugin) OnSessionClose(ssn *framework.Session) {}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacitytier
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestCapacityTier(t *testing.T) {
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "low", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-low", nil, nil),
+		util.BuildPod("ns1", "high", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-high", nil, nil),
+		util.BuildPod("ns1", "low-on-demand", "on-demand", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-low-on-demand", nil, nil),
+		util.BuildPod("ns1", "low-spot", "spot", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-low-spot", nil, nil),
+		util.BuildPod("ns1", "high-on-demand", "on-demand", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-high-on-demand", nil, nil),
+	}
+	var podGroups []*vcapisv1.PodGroup
+	for _, pod := range pods {
+		podGroups = append(podGroups, util.BuildPodGroup("pg-"+pod.Name, "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning))
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "capacitytier",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("spot", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...),
+				map[string]string{defaultTierLabel: defaultSpotValue}),
+			util.BuildNode("on-demand", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledPredicate:   &trueValue,
+			EnabledNodeOrder:   &trueValue,
+			EnabledPreemptable: &trueValue,
+			Arguments: framework.Arguments{
+				spotPrioritiesKey: map[string]interface{}{
+					"expressions": []interface{}{
+						map[string]interface{}{"operator": "LessThan", "values": []interface{}{1000}},
+					},
+				},
+			},
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	for _, name := range []string{"high", "high-on-demand"} {
+		ssn.Jobs[api.JobID("ns1/pg-"+name)].Priority = 2000
+	}
+	taskOf := func(name string) *api.TaskInfo {
+		for _, task := range ssn.Jobs[api.JobID("ns1/pg-"+name)].Tasks {
+			return task
+		}
+		t.Fatalf("task %s not found", name)
+		return nil
+	}
+	low, high := taskOf("low"), taskOf("high")
+
+	if err := ssn.PredicateFn(high, ssn.Nodes["spot"]); err == nil {
+		t.Errorf("expected the high band to be kept off spot nodes")
+	}
+	for _, task := range []*api.TaskInfo{low, high} {
+		if err := ssn.PredicateFn(task, ssn.Nodes["on-demand"]); err != nil {
+			t.Errorf("expected task %s to fit on-demand nodes, got %v", task.Name, err)
+		}
+	}
+	if err := ssn.PredicateFn(low, ssn.Nodes["spot"]); err != nil {
+		t.Errorf("expected the low band to fit spot nodes, got %v", err)
+	}
+
+	spotScore, _ := ssn.NodeOrderFn(low, ssn.Nodes["spot"])
+	onDemandScore, _ := ssn.NodeOrderFn(low, ssn.Nodes["on-demand"])
+	if spotScore <= onDemandScore {
+		t.Errorf("expected the low band to score spot nodes higher, got %v and %v", spotScore, onDemandScore)
+	}
+
+	preemptees := []*api.TaskInfo{taskOf("low-on-demand"), taskOf("low-spot"), taskOf("high-on-demand")}
+	var names []string
+	for _, victim := range ssn.Preemptable(high, preemptees) {
+		names = append(names, victim.Name)
+	}
+	if expected := []string{"low-on-demand"}; !equality.Semantic.DeepEqual(names, expected) {
+		t.Errorf("expected victims %v, got %v", expected, names)
+	}
+	if victims := ssn.Preemptable(low, preemptees); len(victims) != 0 {
+		t.Errorf("expected the low band to abstain, got %d victims", len(victims))
+	}
+}
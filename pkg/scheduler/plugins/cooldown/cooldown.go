@@ -0,0 +1,340 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cooldown prevents preemption ping-pong between equal contenders: the tasks of
+// a job evicted recently, or too often, are not eligible as victims of preempt and reclaim.
+// Optionally, the tasks started recently are not eligible as victims of preempt either.
+//
+// The eviction history is persisted in a ConfigMap, so that a scheduler restart or a leader
+// failover does not forget the cooldowns.
+package cooldown
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "cooldown"
+
+	// cooldownKey is the period after an eviction during which the job is protected.
+	cooldownKey = "cooldown"
+	// maxEvictionsPerHourKey protects jobs evicted this many times in the last hour, 0 means unlimited.
+	maxEvictionsPerHourKey = "maxEvictionsPerHour"
+	// minRunTimeBeforePreemptionKey protects the tasks started more recently from preemption,
+	// so that they do not lose their warm-up, 0 disables the protection.
+	minRunTimeBeforePreemptionKey = "minRunTimeBeforePreemption"
+	// minRunTimeOverridePrioritiesKey selects the preemptor job priorities that may preempt
+	// tasks protected by minRunTimeBeforePreemption.
+	minRunTimeOverridePrioritiesKey = "minRunTimeOverridePriorities"
+	// configMapNamespaceKey and configMapNameKey locate the ConfigMap persisting the eviction
+	// history, in the namespace of the scheduler by default.
+	configMapNamespaceKey = "configMapNamespace"
+	configMapNameKey      = "configMapName"
+
+	defaultCooldown     = 5 * time.Minute
+	evictionRateWindow  = time.Hour
+	defaultConfigMap    = "cooldown-eviction-history"
+	historyStateDataKey = "evictions"
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+// evictionHistory is the time of the sessions in which each job had tasks evicted, oldest first.
+type evictionHistory map[api.JobID][]time.Time
+
+var (
+	// historyMutex guards histories, which outlive the plugin instance of one session.
+	historyMutex sync.Mutex
+	// histories holds the eviction history persisted in each ConfigMap, by namespace/name.
+	histories = map[string]evictionHistory{}
+)
+
+type cooldownPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	cooldown                   time.Duration
+	maxEvictionsPerHour        int
+	minRunTimeBeforePreemption time.Duration
+	// minRunTimeOverride is nil unless minRunTimeOverridePriorities is configured.
+	minRunTimeOverride *priority.PrioritySelector
+	configMapNamespace string
+	configMapName      string
+
+	// history is the eviction history of the ConfigMap of the plugin, guarded by historyMutex.
+	history evictionHistory
+	// protectedJobs are the jobs whose tasks may not be evicted in the current session.
+	protectedJobs map[api.JobID]bool
+	// evictedTasks are the tasks evicted in the current session, with their job.
+	evictedTasks map[api.TaskID]api.JobID
+}
+
+// New return cooldown plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &cooldownPlugin{pluginArguments: arguments}
+}
+
+func (cp *cooldownPlugin) Name() string {
+	return PluginName
+}
+
+func (cp *cooldownPlugin) OnSessionOpen(ssn *framework.Session) {
+	cp.parseArguments()
+	cp.minRunTimeOverride = cp.minRunTimeOverride.Resolve(ssn.PriorityClasses)
+	cp.history = loadHistory(ssn.KubeClient(), cp.configMapNamespace, cp.configMapName)
+	cp.protectedJobs = cp.loadProtectedJobs(now())
+	cp.evictedTasks = make(map[api.TaskID]api.JobID)
+
+	// Evicted tasks are Releasing when deallocated, and Running again if the eviction
+	// is discarded before the statement is committed.
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			if event.Task.Status == api.Running {
+				delete(cp.evictedTasks, event.Task.UID)
+			}
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			if event.Task.Status == api.Releasing {
+				cp.evictedTasks[event.Task.UID] = event.Task.Job
+			}
+		},
+	})
+
+	// The plugin only filters out the protected tasks, for the plugins of all tiers, and
+	// leaves the choice of the victims to the other plugins.
+	filterFn := func(evictor *api.TaskInfo, evictees []*api.TaskInfo) []*api.TaskInfo {
+		var victims []*api.TaskInfo
+		for _, evictee := range evictees {
+			if cp.protectedJobs[evictee.Job] {
+				klog.V(4).Infof("cooldown: task <%s/%s> of job %s evicted recently is not a victim of <%s/%s>",
+					evictee.Namespace, evictee.Name, evictee.Job, evictor.Namespace, evictor.Name)
+				continue
+			}
+			victims = append(victims, evictee)
+		}
+		return victims
+	}
+	ssn.AddReclaimVictimFilterFn(cp.Name(), filterFn)
+
+	if cp.minRunTimeBeforePreemption <= 0 {
+		ssn.AddPreemptVictimFilterFn(cp.Name(), filterFn)
+		return
+	}
+	ssn.AddPreemptVictimFilterFn(cp.Name(), func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) []*api.TaskInfo {
+		victims := filterFn(preemptor, preemptees)
+		preemptorPriority := preemptor.Priority
+		if job, found := ssn.Jobs[preemptor.Job]; found {
+			preemptorPriority = job.Priority
+		}
+		if cp.minRunTimeOverride.Matches(preemptorPriority) {
+			return victims
+		}
+
+		current := now()
+		var started []*api.TaskInfo
+		for _, victim := range victims {
+			if cp.startedRecently(victim, current) {
+				klog.V(4).Infof("cooldown: task <%s/%s> started less than %v ago is not a victim of <%s/%s>",
+					victim.Namespace, victim.Name, cp.minRunTimeBeforePreemption, preemptor.Namespace, preemptor.Name)
+				continue
+			}
+			started = append(started, victim)
+		}
+		return started
+	})
+}
+
+func (cp *cooldownPlugin) OnSessionClose(ssn *framework.Session) {
+	if cp.recordEvictions(now()) {
+		cp.persistHistory(ssn.KubeClient())
+	}
+	cp.protectedJobs = nil
+	cp.evictedTasks = nil
+}
+
+func (cp *cooldownPlugin) parseArguments() {
+	cp.cooldown = defaultCooldown
+	if v, ok := cp.pluginArguments[cooldownKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			klog.Errorf("cooldown plugin: invalid %s %q, using default %v", cooldownKey, v, defaultCooldown)
+		} else {
+			cp.cooldown = d
+		}
+	}
+	cp.pluginArguments.GetInt(&cp.maxEvictionsPerHour, maxEvictionsPerHourKey)
+
+	cp.minRunTimeBeforePreemption = 0
+	if v, ok := cp.pluginArguments[minRunTimeBeforePreemptionKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			klog.Errorf("cooldown plugin: invalid %s %q, started tasks are not protected", minRunTimeBeforePreemptionKey, v)
+		} else {
+			cp.minRunTimeBeforePreemption = d
+		}
+	}
+	selector, err := priority.ParseSelector(cp.pluginArguments[minRunTimeOverridePrioritiesKey])
+	if err != nil {
+		klog.Errorf("cooldown plugin: invalid %s, no preemptor overrides the minimum run time: %v", minRunTimeOverridePrioritiesKey, err)
+	}
+	cp.minRunTimeOverride = selector
+
+	cp.configMapNamespace = ""
+	cp.pluginArguments.GetString(&cp.configMapNamespace, configMapNamespaceKey)
+	if cp.configMapNamespace == "" {
+		cp.configMapNamespace = util.SchedulerNamespace()
+	}
+	cp.configMapName = defaultConfigMap
+	cp.pluginArguments.GetString(&cp.configMapName, configMapNameKey)
+}
+
+// startedRecently returns whether the task started less than minRunTimeBeforePreemption
+// ago. A task without start time, e.g. still binding, has not run yet and is protected.
+func (cp *cooldownPlugin) startedRecently(task *api.TaskInfo, current time.Time) bool {
+	if task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return true
+	}
+	return current.Sub(task.Pod.Status.StartTime.Time) < cp.minRunTimeBeforePreemption
+}
+
+// loadProtectedJobs returns the jobs evicted within the cooldown, or at least
+// maxEvictionsPerHour times in the last hour.
+func (cp *cooldownPlugin) loadProtectedJobs(current time.Time) map[api.JobID]bool {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	protected := make(map[api.JobID]bool)
+	for job, evictions := range cp.history {
+		if len(evictions) == 0 {
+			continue
+		}
+		if current.Sub(evictions[len(evictions)-1]) < cp.cooldown {
+			protected[job] = true
+			continue
+		}
+		if cp.maxEvictionsPerHour <= 0 {
+			continue
+		}
+		recent := 0
+		for _, evicted := range evictions {
+			if current.Sub(evicted) < evictionRateWindow {
+				recent++
+			}
+		}
+		if recent >= cp.maxEvictionsPerHour {
+			protected[job] = true
+		}
+	}
+	return protected
+}
+
+// recordEvictions adds the jobs evicted in the session to the history, and forgets the
+// evictions older than both the cooldown and the eviction rate window. It returns whether
+// the history changed.
+func (cp *cooldownPlugin) recordEvictions(current time.Time) bool {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	changed := false
+	recorded := make(map[api.JobID]bool)
+	for _, job := range cp.evictedTasks {
+		if !recorded[job] {
+			recorded[job] = true
+			cp.history[job] = append(cp.history[job], current)
+			changed = true
+		}
+	}
+
+	retention := max(cp.cooldown, evictionRateWindow)
+	for job, evictions := range cp.history {
+		idx := 0
+		for idx < len(evictions) && current.Sub(evictions[idx]) >= retention {
+			idx++
+		}
+		if idx == 0 {
+			continue
+		}
+		changed = true
+		if idx == len(evictions) {
+			delete(cp.history, job)
+		} else {
+			cp.history[job] = evictions[idx:]
+		}
+	}
+	return changed
+}
+
+// loadHistory returns the eviction history of the ConfigMap, read from the ConfigMap the first
+// time, e.g. after a restart or a failover.
+func loadHistory(client kubernetes.Interface, namespace, name string) evictionHistory {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	key := namespace + "/" + name
+	if history, found := histories[key]; found {
+		return history
+	}
+	history := evictionHistory{}
+	histories[key] = history
+	if client == nil {
+		return history
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("cooldown: failed to get ConfigMap %s/%s: %v", namespace, name, err)
+		}
+		return history
+	}
+	if err := json.Unmarshal([]byte(cm.Data[historyStateDataKey]), &history); err != nil {
+		klog.Errorf("cooldown: failed to decode eviction history from ConfigMap %s/%s: %v", namespace, name, err)
+	}
+	return history
+}
+
+// persistHistory writes the eviction history to the ConfigMap of the plugin.
+func (cp *cooldownPlugin) persistHistory(client kubernetes.Interface) {
+	if client == nil {
+		return
+	}
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	data, err := json.Marshal(cp.history)
+	if err != nil {
+		klog.Errorf("cooldown: failed to encode eviction history: %v", err)
+		return
+	}
+	if err := util.UpsertConfigMapData(client, cp.configMapNamespace, cp.configMapName, historyStateDataKey, string(data)); err != nil {
+		klog.Errorf("cooldown: failed to persist eviction history to ConfigMap %s/%s: %v", cp.configMapNamespace, cp.configMapName, err)
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cooldown
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/preempt"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
+	tutil "volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+// evictAllPlugin permits the eviction of every evictee, as a plugin of a later tier that
+// would evict the tasks protected by the cooldown plugin.
+type evictAllPlugin struct{}
+
+const evictAllPluginName = "evict-all"
+
+func (ep *evictAllPlugin) Name() string { return evictAllPluginName }
+
+func (ep *evictAllPlugin) OnSessionOpen(ssn *framework.Session) {
+	evictAll := func(evictor *api.TaskInfo, evictees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return evictees, tutil.Permit
+	}
+	ssn.AddPreemptableFn(ep.Name(), evictAll)
+	ssn.AddReclaimableFn(ep.Name(), evictAll)
+}
+
+func (ep *evictAllPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// openTestSession opens a session with the cooldown plugin in the first tier and a plugin
+// evicting every evictee in the second one, with two running jobs a and b and one pending job c.
+func openTestSession(name string, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name: name,
+		Plugins: map[string]framework.PluginBuilder{
+			PluginName:         New,
+			evictAllPluginName: func(framework.Arguments) framework.Plugin { return &evictAllPlugin{} },
+		},
+		PodGroups: []*vcapisv1.PodGroup{
+			util.BuildPodGroup("pg-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+			util.BuildPodGroup("pg-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+			util.BuildPodGroup("pg-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("ns1", "a", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a", nil, nil),
+			util.BuildPod("ns1", "b", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b", nil, nil),
+			util.BuildPod("ns1", "c", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c", nil, nil),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledPreemptable: &trueValue,
+			EnabledReclaimable: &trueValue,
+			Arguments:          arguments,
+		}},
+	}, {
+		Plugins: []conf.PluginOption{{
+			Name:               evictAllPluginName,
+			EnabledPreemptable: &trueValue,
+			EnabledReclaimable: &trueValue,
+		}},
+	}}
+	return tc.RegisterSession(tiers, nil), tc
+}
+
+func taskOf(ssn *framework.Session, job api.JobID) *api.TaskInfo {
+	for _, task := range ssn.Jobs[job].Tasks {
+		return task
+	}
+	return nil
+}
+
+// victimNames returns the victims of c among the tasks of the given jobs.
+func victimNames(ssn *framework.Session, evict func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo, jobs ...api.JobID) []string {
+	evictor := taskOf(ssn, "ns1/pg-c")
+	evictees := make([]*api.TaskInfo, 0, len(jobs))
+	for _, job := range jobs {
+		evictees = append(evictees, taskOf(ssn, job))
+	}
+	var names []string
+	for _, victim := range evict(evictor, evictees) {
+		names = append(names, victim.Name)
+	}
+	return names
+}
+
+func TestCooldown(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	current := start
+	now = func() time.Time { return current }
+	defer func() {
+		now = time.Now
+		histories = map[string]evictionHistory{}
+	}()
+	arguments := framework.Arguments{cooldownKey: "10m"}
+
+	// a is evicted, the eviction of b is discarded
+	ssn, tc := openTestSession("evict", arguments)
+	stmt := framework.NewStatement(ssn)
+	if err := stmt.Evict(taskOf(ssn, "ns1/pg-a"), "test"); err != nil {
+		t.Fatalf("failed to evict: %v", err)
+	}
+	stmt.Commit()
+	stmt = framework.NewStatement(ssn)
+	if err := stmt.Evict(taskOf(ssn, "ns1/pg-b"), "test"); err != nil {
+		t.Fatalf("failed to evict: %v", err)
+	}
+	stmt.Discard()
+	tc.Close()
+
+	current = start.Add(5 * time.Minute)
+	ssn, tc = openTestSession("within cooldown", arguments)
+	for action, evict := range map[string]func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo{
+		"preempt": ssn.Preemptable,
+		"reclaim": ssn.Reclaimable,
+	} {
+		if names, expected := victimNames(ssn, evict, "ns1/pg-a", "ns1/pg-b"), []string{"b"}; !equality.Semantic.DeepEqual(names, expected) {
+			t.Errorf("expected %s victims %v within the cooldown, got %v", action, expected, names)
+		}
+		// the plugin of the second tier may not evict a either when it is the only evictee
+		if names := victimNames(ssn, evict, "ns1/pg-a"); len(names) != 0 {
+			t.Errorf("expected no %s victims within the cooldown, got %v", action, names)
+		}
+	}
+	tc.Close()
+
+	current = start.Add(10 * time.Minute)
+	ssn, tc = openTestSession("after cooldown", arguments)
+	if names, expected := victimNames(ssn, ssn.Preemptable, "ns1/pg-a", "ns1/pg-b"), []string{"a", "b"}; !equality.Semantic.DeepEqual(names, expected) {
+		t.Errorf("expected victims %v after the cooldown, got %v", expected, names)
+	}
+	tc.Close()
+}
+
+func TestMaxEvictionsPerHour(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	history := evictionHistory{
+		"ns1/pg-a": {start, start.Add(20 * time.Minute), start.Add(40 * time.Minute)},
+		"ns1/pg-b": {start.Add(-2 * time.Hour), start.Add(20 * time.Minute), start.Add(40 * time.Minute)},
+	}
+
+	cp := &cooldownPlugin{cooldown: time.Minute, maxEvictionsPerHour: 3, history: history}
+	protected := cp.loadProtectedJobs(start.Add(50 * time.Minute))
+	if !protected["ns1/pg-a"] || protected["ns1/pg-b"] {
+		t.Errorf("expected only the job evicted 3 times in the last hour to be protected, got %v", protected)
+	}
+
+	cp.recordEvictions(start.Add(90 * time.Minute))
+	if got := len(history["ns1/pg-a"]); got != 1 {
+		t.Errorf("expected the evictions older than an hour to be forgotten, got %d left", got)
+	}
+}
+
+func TestHistorySurvivesRestart(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() { histories = map[string]evictionHistory{} }()
+	histories = map[string]evictionHistory{}
+	client := fake.NewSimpleClientset()
+
+	open := func() *cooldownPlugin {
+		cp := &cooldownPlugin{pluginArguments: framework.Arguments{cooldownKey: "10m", configMapNameKey: "history"}}
+		cp.parseArguments()
+		cp.history = loadHistory(client, cp.configMapNamespace, cp.configMapName)
+		return cp
+	}
+	cp := open()
+	cp.evictedTasks = map[api.TaskID]api.JobID{"a": "ns1/pg-a"}
+	if !cp.recordEvictions(start) {
+		t.Fatalf("expected the eviction to change the history")
+	}
+	cp.persistHistory(client)
+
+	// the scheduler restarts, forgetting the history in memory
+	histories = map[string]evictionHistory{}
+	if protected := open().loadProtectedJobs(start.Add(5 * time.Minute)); !protected["ns1/pg-a"] {
+		t.Errorf("expected the job evicted before the restart to be protected, got %v", protected)
+	}
+}
+
+func TestMinRunTimeBeforePreemption(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	current := start
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		name              string
+		elapsed           time.Duration
+		preemptorPriority int32
+		expectVictims     []string
+	}{
+		{name: "a started recently", elapsed: 5 * time.Minute, expectVictims: []string{"b"}},
+		{name: "a ran long enough", elapsed: 10 * time.Minute, expectVictims: []string{"a", "b"}},
+		{name: "preemptor in the override band", elapsed: 5 * time.Minute, preemptorPriority: 1000, expectVictims: []string{"a", "b"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current = start.Add(test.elapsed)
+			ssn, tc := openTestSession(test.name, framework.Arguments{
+				minRunTimeBeforePreemptionKey: "10m",
+				minRunTimeOverridePrioritiesKey: map[string]interface{}{
+					"expressions": []interface{}{map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}}},
+				},
+			})
+			defer tc.Close()
+
+			// b started long ago, a at the start of the test.
+			taskOf(ssn, "ns1/pg-a").Pod.Status.StartTime = &metav1.Time{Time: start}
+			taskOf(ssn, "ns1/pg-b").Pod.Status.StartTime = &metav1.Time{Time: start.Add(-time.Hour)}
+			ssn.Jobs["ns1/pg-c"].Priority = test.preemptorPriority
+
+			if names := victimNames(ssn, ssn.Preemptable, "ns1/pg-a", "ns1/pg-b"); !equality.Semantic.DeepEqual(names, test.expectVictims) {
+				t.Errorf("expected victims %v, got %v", test.expectVictims, names)
+			}
+		})
+	}
+}
+
+// TestPreemptAcrossTiers checks that the priority plugin of a later tier does not preempt
+// the tasks protected by the cooldown plugin.
+func TestPreemptAcrossTiers(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start.Add(5 * time.Minute) }
+	defer func() {
+		now = time.Now
+		histories = map[string]evictionHistory{}
+	}()
+
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledPreemptable: &trueValue,
+			Arguments:          framework.Arguments{cooldownKey: "10m"},
+		}},
+	}, {
+		Plugins: []conf.PluginOption{{
+			Name:               priority.PluginName,
+			EnabledTaskOrder:   &trueValue,
+			EnabledJobOrder:    &trueValue,
+			EnabledPreemptable: &trueValue,
+			EnabledJobStarving: &trueValue,
+		}},
+	}}
+	preemptable := map[string]string{vcapisv1.PodPreemptable: "true"}
+
+	tests := []struct {
+		name          string
+		evicted       []api.JobID
+		expectEvicted []string
+	}{
+		{name: "no job evicted recently", expectEvicted: []string{"ns1/b"}},
+		{name: "b evicted recently", evicted: []api.JobID{"ns1/pg-b"}, expectEvicted: []string{"ns1/a"}},
+		{name: "a and b evicted recently", evicted: []api.JobID{"ns1/pg-a", "ns1/pg-b"}, expectEvicted: []string{}},
+	}
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := evictionHistory{}
+			for _, job := range test.evicted {
+				history[job] = []time.Time{start}
+			}
+			histories = map[string]evictionHistory{"volcano-system/" + defaultConfigMap: history}
+			tc := uthelper.TestCommonStruct{
+				Name:    test.name,
+				Plugins: map[string]framework.PluginBuilder{PluginName: New, priority.PluginName: priority.New},
+				PriClass: []*schedulingv1.PriorityClass{
+					util.BuildPriorityClass("low-priority", 100),
+					util.BuildPriorityClass("high-priority", 1000),
+				},
+				PodGroups: []*vcapisv1.PodGroup{
+					util.BuildPodGroupWithPrio("pg-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low-priority"),
+					util.BuildPodGroupWithPrio("pg-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low-priority"),
+					util.BuildPodGroupWithPrio("pg-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, "high-priority"),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "a", "node1", v1.PodRunning, api.BuildResourceList("3", "3G"), "pg-a", preemptable, nil),
+					util.BuildPod("ns1", "b", "node1", v1.PodRunning, api.BuildResourceList("3", "3G"), "pg-b", preemptable, nil),
+					util.BuildPod("ns1", "c", "", v1.PodPending, api.BuildResourceList("3", "3G"), "pg-c", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("node1", api.BuildResourceList("6", "6G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues:         []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+				ExpectEvicted:  test.expectEvicted,
+				ExpectEvictNum: len(test.expectEvicted),
+			}
+			tc.RegisterSession(tiers, nil)
+			defer tc.Close()
+			tc.Run([]framework.Action{allocate.New(), preempt.New()})
+			if err := tc.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadline implements earliest-deadline-first scheduling: jobs declaring a completion
+// deadline are ordered by their slack, the time left before they must start to meet it.
+//
+// The expected run time of a job is its max run time, e.g.
+//
+//	tiers:
+//	- plugins:
+//	  - name: deadline
+//	    arguments:
+//	      maxRunTime:
+//	        default: 1h
+//	      preemptDeadlineless: true
+//	      preemptionSlack: 30m
+//
+// with the deadline and expected run time of a job given in its annotations:
+//
+//	metadata:
+//	  annotations:
+//	    volcano.sh/deadline: 2026-10-16T08:00:00Z
+//	    volcano.sh/max-run-time: 2h
+package deadline
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "deadline"
+
+	// DeadlineAnnotation is the PodGroup annotation holding the completion deadline of a job,
+	// either an RFC3339 time or a duration relative to the creation of the job.
+	DeadlineAnnotation = "volcano.sh/deadline"
+
+	// maxRunTimeKey configures the expected run time of jobs without max run time annotation.
+	maxRunTimeKey = "maxRunTime"
+	// preemptDeadlinelessKey lets jobs at risk of missing their deadline preempt tasks of jobs without deadline.
+	preemptDeadlinelessKey = "preemptDeadlineless"
+	// preemptionSlackKey is the slack below which a job is at risk of missing its deadline.
+	preemptionSlackKey = "preemptionSlack"
+
+	defaultPreemptionSlack = 30 * time.Minute
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+var (
+	// atRiskMutex guards atRiskJobs, which outlives the plugin instance of one session.
+	atRiskMutex sync.Mutex
+	// atRiskJobs are the pending jobs which could not meet their deadline in the last session,
+	// so that the event is only recorded when a job becomes at risk.
+	atRiskJobs = map[api.JobID]bool{}
+)
+
+type deadlinePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	maxRunTime          *runtime.Limits
+	preemptDeadlineless bool
+	preemptionSlack     time.Duration
+
+	// slacks is the slack of each job with a deadline in the current session, negative
+	// once the job cannot meet its deadline anymore.
+	slacks map[api.JobID]time.Duration
+}
+
+// New return deadline plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &deadlinePlugin{pluginArguments: arguments}
+}
+
+func (dp *deadlinePlugin) Name() string {
+	return PluginName
+}
+
+func (dp *deadlinePlugin) OnSessionOpen(ssn *framework.Session) {
+	klog.V(4).Infof("Enter deadline plugin ...")
+	defer klog.V(4).Infof("Leaving deadline plugin.")
+
+	dp.parseArguments()
+	dp.maxRunTime = dp.maxRunTime.WithNamespaces(ssn.InformerFactory())
+	dp.slacks = make(map[api.JobID]time.Duration)
+
+	current := now()
+	atRiskMutex.Lock()
+	wasAtRisk := atRiskJobs
+	atRiskJobs = make(map[api.JobID]bool)
+	for _, job := range ssn.Jobs {
+		deadline, found := getDeadline(job)
+		if !found {
+			continue
+		}
+		expected, _ := dp.maxRunTime.MaxRunTime(nil, job)
+		slack := deadline.Sub(current) - expected
+		dp.slacks[job.UID] = slack
+
+		if slack < 0 && len(job.TaskStatusIndex[api.Pending]) > 0 {
+			atRiskJobs[job.UID] = true
+			msg := fmt.Sprintf("job will miss its deadline %s by %v", deadline.Format(time.RFC3339), -slack)
+			klog.V(3).Infof("deadline: job <%s/%s> %s", job.Namespace, job.Name, msg)
+			if !wasAtRisk[job.UID] {
+				ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeWarning, string(scheduling.PodGroupUnschedulableType), msg)
+			}
+		}
+	}
+	atRiskMutex.Unlock()
+
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+
+		lSlack, lFound := dp.slacks[lv.UID]
+		rSlack, rFound := dp.slacks[rv.UID]
+		if !lFound {
+			if !rFound {
+				return 0
+			}
+			return 1
+		}
+		if !rFound {
+			return -1
+		}
+		if lSlack < rSlack {
+			return -1
+		}
+		if lSlack > rSlack {
+			return 1
+		}
+		return 0
+	}
+	ssn.AddJobOrderFn(dp.Name(), jobOrderFn)
+
+	if dp.preemptDeadlineless {
+		preemptableFn := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			slack, found := dp.slacks[preemptor.Job]
+			if !found || slack > dp.preemptionSlack {
+				return nil, util.Abstain
+			}
+
+			var victims []*api.TaskInfo
+			for _, preemptee := range preemptees {
+				if _, found := dp.slacks[preemptee.Job]; !found {
+					victims = append(victims, preemptee)
+				}
+			}
+			if len(victims) == 0 {
+				return nil, util.Abstain
+			}
+
+			klog.V(4).Infof("deadline: victims without deadline for preemptor <%s/%s> with slack %v: %d",
+				preemptor.Namespace, preemptor.Name, slack, len(victims))
+			return victims, util.Permit
+		}
+		ssn.AddPreemptableFn(dp.Name(), preemptableFn)
+	}
+}
+
+func (dp *deadlinePlugin) OnSessionClose(ssn *framework.Session) {
+	dp.slacks = nil
+}
+
+func (dp *deadlinePlugin) parseArguments() {
+	dp.preemptionSlack = defaultPreemptionSlack
+
+	if limits, err := runtime.Parse(dp.pluginArguments[maxRunTimeKey]); err != nil {
+		klog.Errorf("deadline plugin: invalid %s, only the max run time annotations are used: %v", maxRunTimeKey, err)
+	} else {
+		dp.maxRunTime = limits
+	}
+	dp.pluginArguments.GetBool(&dp.preemptDeadlineless, preemptDeadlinelessKey)
+	if v, ok := dp.pluginArguments[preemptionSlackKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			klog.Errorf("deadline plugin: invalid %s %q, using default %v", preemptionSlackKey, v, defaultPreemptionSlack)
+		} else {
+			dp.preemptionSlack = d
+		}
+	}
+}
+
+// getDeadline returns the deadline of the job, and false if it has none or it is malformed.
+func getDeadline(job *api.JobInfo) (time.Time, bool) {
+	if job.PodGroup == nil {
+		return time.Time{}, false
+	}
+	value, found := job.PodGroup.Annotations[DeadlineAnnotation]
+	if !found {
+		return time.Time{}, false
+	}
+	if deadline, err := time.Parse(time.RFC3339, value); err == nil {
+		return deadline, true
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return job.CreationTimestamp.Add(d), true
+	}
+	klog.Errorf("Invalid %s annotation %q on job <%s/%s>, ignoring it", DeadlineAnnotation, value, job.Namespace, job.Name)
+	return time.Time{}, false
+}
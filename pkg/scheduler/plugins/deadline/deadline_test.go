@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadline
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestDeadline(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() {
+		now = time.Now
+		atRiskJobs = map[api.JobID]bool{}
+	}()
+
+	anno := func(deadline time.Duration, maxRunTime string) map[string]string {
+		annotations := map[string]string{DeadlineAnnotation: start.Add(deadline).Format(time.RFC3339)}
+		if maxRunTime != "" {
+			annotations[runtime.MaxRunTimeAnnotation] = maxRunTime
+		}
+		return annotations
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		// slack of 3h - 2h = 1h
+		util.BuildPodGroupWithAnno("pg-urgent", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, anno(3*time.Hour, "2h")),
+		// slack of 2h - 30m = 1h30m
+		util.BuildPodGroupWithAnno("pg-tight", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, anno(2*time.Hour, "30m")),
+		// slack of 1h - 2h = -1h, the job cannot meet its deadline
+		util.BuildPodGroupWithAnno("pg-late", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, anno(time.Hour, "")),
+		util.BuildPodGroupWithAnno("pg-none", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, nil),
+		util.BuildPodGroupWithAnno("pg-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, nil),
+		util.BuildPodGroupWithAnno("pg-running-deadline", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, anno(10*time.Hour, "")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "urgent", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-urgent", nil, nil),
+		util.BuildPod("ns1", "tight", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-tight", nil, nil),
+		util.BuildPod("ns1", "late", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-late", nil, nil),
+		util.BuildPod("ns1", "none", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-none", nil, nil),
+		util.BuildPod("ns1", "running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-running", nil, nil),
+		util.BuildPod("ns1", "running-deadline", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-running-deadline", nil, nil),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "deadline",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobOrder:    &trueValue,
+			EnabledPreemptable: &trueValue,
+			Arguments: framework.Arguments{
+				maxRunTimeKey:          map[string]interface{}{"default": "2h"},
+				preemptDeadlinelessKey: true,
+			},
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	order := []api.JobID{"ns1/pg-late", "ns1/pg-urgent", "ns1/pg-tight", "ns1/pg-none"}
+	for i := 0; i < len(order)-1; i++ {
+		l, r := ssn.Jobs[order[i]], ssn.Jobs[order[i+1]]
+		if !ssn.JobOrderFn(l, r) || ssn.JobOrderFn(r, l) {
+			t.Errorf("expected %s to be ordered before %s", order[i], order[i+1])
+		}
+	}
+
+	var preemptees []*api.TaskInfo
+	for _, jobID := range []api.JobID{"ns1/pg-running", "ns1/pg-running-deadline"} {
+		for _, task := range ssn.Jobs[jobID].Tasks {
+			preemptees = append(preemptees, task)
+		}
+	}
+	victimsOf := func(jobID api.JobID) []string {
+		var names []string
+		for _, preemptor := range ssn.Jobs[jobID].Tasks {
+			for _, victim := range ssn.Preemptable(preemptor, preemptees) {
+				names = append(names, victim.Name)
+			}
+		}
+		return names
+	}
+	if names, expected := victimsOf("ns1/pg-late"), []string{"running"}; !equality.Semantic.DeepEqual(names, expected) {
+		t.Errorf("expected victims %v for the job at risk, got %v", expected, names)
+	}
+	if names := victimsOf("ns1/pg-urgent"); len(names) != 0 {
+		t.Errorf("expected no victims for the job with enough slack, got %v", names)
+	}
+}
+
+func TestAtRiskEvent(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	atRiskJobs = map[api.JobID]bool{}
+	defer func() {
+		now = time.Now
+		atRiskJobs = map[api.JobID]bool{}
+	}()
+	// the job must start by start + 1h to meet its deadline
+	anno := map[string]string{
+		DeadlineAnnotation:           start.Add(2 * time.Hour).Format(time.RFC3339),
+		runtime.MaxRunTimeAnnotation: "1h",
+	}
+
+	atRiskEvents := func(elapsed time.Duration) int {
+		now = func() time.Time { return start.Add(elapsed) }
+		recorder := record.NewFakeRecorder(100)
+		tc := &uthelper.TestCommonStruct{
+			Name:      "at risk event",
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: []*vcapisv1.PodGroup{util.BuildPodGroupWithAnno("pg-late", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, anno)},
+			Pods:      []*v1.Pod{util.BuildPod("ns1", "late", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-late", nil, nil)},
+			Queues:    []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			Recorder:  recorder,
+		}
+		tc.RegisterSession([]conf.Tier{{Plugins: []conf.PluginOption{{Name: PluginName}}}}, nil)
+		tc.Close()
+		close(recorder.Events)
+
+		count := 0
+		for event := range recorder.Events {
+			if strings.Contains(event, "will miss its deadline") {
+				count++
+			}
+		}
+		return count
+	}
+
+	for i, step := range []struct {
+		elapsed time.Duration
+		events  int
+	}{
+		{elapsed: 90 * time.Minute, events: 1},
+		{elapsed: 100 * time.Minute, events: 0},
+		{elapsed: 30 * time.Minute, events: 0},
+		{elapsed: 110 * time.Minute, events: 1},
+	} {
+		if got := atRiskEvents(step.elapsed); got != step.events {
+			t.Errorf("step %d: expected %d events after %v, got %d", i, step.events, step.elapsed, got)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dimensions
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+const (
+	// dimensionsKey is the list of dimensions, in order of precedence.
+	dimensionsKey = "dimensions"
+	// conflictResolutionKey decides how dimensions disagreeing on the order of two jobs are reconciled.
+	conflictResolutionKey = "conflictResolution"
+	// exemptPrioritiesKey selects the job priorities never deprioritized or blocked by the quotas.
+	exemptPrioritiesKey = "exemptPriorities"
+
+	// ConflictResolutionPrecedence lets the first dimension, in configuration order, in which
+	// two jobs differ decide their order.
+	ConflictResolutionPrecedence = "precedence"
+	// ConflictResolutionWeighted orders jobs by the weighted sum of their standing in every dimension.
+	ConflictResolutionWeighted = "weighted"
+)
+
+// dimensionConfig is the configuration of one dimension, e.g.
+//
+//	dimensions:
+//	- name: team
+//	  labelKey: example.com/team
+//	  weight: 2
+//	  quota:
+//	    cpu: "64"
+//	  valueQuotas:
+//	    research:
+//	      cpu: "128"
+//	  enforce: true
+type dimensionConfig struct {
+	// Name identifies the dimension in logs and events, it defaults to LabelKey.
+	Name string `json:"name"`
+	// LabelKey is the PodGroup label, or annotation, holding the value of a job in the dimension.
+	LabelKey string `json:"labelKey"`
+	// Weight is the importance of the dimension in weighted conflict resolution, 1 by default.
+	Weight float64 `json:"weight"`
+	// Quota is the resource limit applied to each value of the dimension.
+	Quota map[string]string `json:"quota"`
+	// ValueQuotas overrides Quota for some values of the dimension.
+	ValueQuotas map[string]map[string]string `json:"valueQuotas"`
+	// Enforce rejects the enqueue of jobs whose value is over quota, instead of only
+	// ordering them behind the other jobs.
+	Enforce bool `json:"enforce"`
+}
+
+// quota is a resource limit, only the resources in names are limited.
+type quota struct {
+	limit *api.Resource
+	names []v1.ResourceName
+}
+
+// dimension is the parsed form of a dimensionConfig.
+type dimension struct {
+	name     string
+	labelKey string
+	weight   float64
+	quota    *quota
+	// valueQuotas overrides quota for some values.
+	valueQuotas map[string]*quota
+	enforce     bool
+}
+
+// quotaOf returns the quota of the value.
+func (d *dimension) quotaOf(value string) *quota {
+	if quota, found := d.valueQuotas[value]; found {
+		return quota
+	}
+	return d.quota
+}
+
+// pluginArguments is the parsed form of the dimensions plugin arguments.
+type pluginArguments struct {
+	// dimensions are in order of precedence.
+	dimensions         []*dimension
+	conflictResolution string
+	// exemptPriorities is nil unless exemptPriorities is configured.
+	exemptPriorities *priority.PrioritySelector
+}
+
+func parseArguments(arguments framework.Arguments) *pluginArguments {
+	args := &pluginArguments{
+		conflictResolution: ConflictResolutionPrecedence,
+	}
+
+	if dimensions, err := parseDimensions(arguments[dimensionsKey]); err != nil {
+		klog.Errorf("dimensions plugin: invalid %s, no dimension is enforced: %v", dimensionsKey, err)
+	} else {
+		args.dimensions = dimensions
+	}
+
+	if arg, ok := arguments[conflictResolutionKey]; ok {
+		switch val, _ := arg.(string); val {
+		case ConflictResolutionPrecedence, ConflictResolutionWeighted:
+			args.conflictResolution = val
+		default:
+			klog.Errorf("dimensions plugin: invalid %s %v, using default %s", conflictResolutionKey, arg, args.conflictResolution)
+		}
+	}
+
+	if selector, err := priority.ParseSelector(arguments[exemptPrioritiesKey]); err != nil {
+		klog.Errorf("dimensions plugin: invalid %s, no priority is exempt: %v", exemptPrioritiesKey, err)
+	} else {
+		args.exemptPriorities = selector
+	}
+
+	return args
+}
+
+// ValidateArguments reports the arguments that parseArguments would ignore or fall back
+// on, so that a configuration with such arguments is rejected when it is reloaded.
+func ValidateArguments(arguments framework.Arguments) error {
+	var errs []error
+	if _, err := parseDimensions(arguments[dimensionsKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", dimensionsKey, err))
+	}
+	if arg, ok := arguments[conflictResolutionKey]; ok {
+		switch val, _ := arg.(string); val {
+		case ConflictResolutionPrecedence, ConflictResolutionWeighted:
+		default:
+			errs = append(errs, fmt.Errorf("invalid %s %v", conflictResolutionKey, arg))
+		}
+	}
+	if _, err := priority.ParseSelector(arguments[exemptPrioritiesKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", exemptPrioritiesKey, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// parseDimensions decodes and validates the dimensions, every error is reported.
+func parseDimensions(raw interface{}) ([]*dimension, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var configs []dimensionConfig
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &configs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode dimensions: %v", err)
+	}
+
+	var errs []error
+	var dimensions []*dimension
+	names := map[string]bool{}
+	for i, config := range configs {
+		d, err := config.parse()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %v", dimensionsKey, i, err))
+			continue
+		}
+		if names[d.name] {
+			errs = append(errs, fmt.Errorf("%s[%d]: duplicate dimension %s", dimensionsKey, i, d.name))
+			continue
+		}
+		names[d.name] = true
+		dimensions = append(dimensions, d)
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return dimensions, nil
+}
+
+func (c dimensionConfig) parse() (*dimension, error) {
+	if c.LabelKey == "" {
+		return nil, fmt.Errorf("labelKey is required")
+	}
+	if c.Weight < 0 {
+		return nil, fmt.Errorf("negative weight %v", c.Weight)
+	}
+
+	d := &dimension{
+		name:        c.Name,
+		labelKey:    c.LabelKey,
+		weight:      c.Weight,
+		valueQuotas: make(map[string]*quota, len(c.ValueQuotas)),
+		enforce:     c.Enforce,
+	}
+	if d.name == "" {
+		d.name = c.LabelKey
+	}
+	if d.weight == 0 {
+		d.weight = 1
+	}
+
+	q, err := parseQuota(c.Quota)
+	if err != nil {
+		return nil, fmt.Errorf("quota: %v", err)
+	}
+	d.quota = q
+	configured := len(q.names) > 0
+	for value, raw := range c.ValueQuotas {
+		q, err := parseQuota(raw)
+		if err != nil {
+			return nil, fmt.Errorf("valueQuotas[%s]: %v", value, err)
+		}
+		d.valueQuotas[value] = q
+		configured = configured || len(q.names) > 0
+	}
+	if !configured {
+		return nil, fmt.Errorf("no quota configured")
+	}
+	return d, nil
+}
+
+// parseQuota parses a map of resource name to quantity string.
+func parseQuota(raw map[string]string) (*quota, error) {
+	list := v1.ResourceList{}
+	q := &quota{}
+	for name, value := range raw {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q for %s: %v", value, name, err)
+		}
+		list[v1.ResourceName(name)] = quantity
+		q.names = append(q.names, v1.ResourceName(name))
+	}
+	q.limit = api.NewResource(list)
+	return q, nil
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dimensions generalizes the groupquota plugin to several label dimensions,
+// e.g. team, project and hardware type, each with its own quota. A job is over quota
+// in a dimension when the usage of its value in that dimension reached the quota.
+package dimensions
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+// PluginName indicates name of volcano scheduler plugin.
+const PluginName = "dimensions"
+
+type dimensionsPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	args *pluginArguments
+
+	// usage is the resource usage of each value of each dimension, indexed like args.dimensions.
+	usage []map[string]*api.Resource
+	// overQuota contains the over-quota values of each dimension.
+	overQuota []map[string]bool
+	// shares is the usage-to-quota ratio of each value of each dimension.
+	shares []map[string]float64
+}
+
+// New return dimensions plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &dimensionsPlugin{pluginArguments: arguments}
+}
+
+func (dp *dimensionsPlugin) Name() string {
+	return PluginName
+}
+
+func (dp *dimensionsPlugin) OnSessionOpen(ssn *framework.Session) {
+	dp.args = parseArguments(dp.pluginArguments)
+	dp.args.exemptPriorities = dp.args.exemptPriorities.Resolve(ssn.PriorityClasses)
+	if len(dp.args.dimensions) == 0 {
+		klog.Warningf("dimensions plugin: no dimension configured")
+		return
+	}
+
+	dp.usage = make([]map[string]*api.Resource, len(dp.args.dimensions))
+	dp.overQuota = make([]map[string]bool, len(dp.args.dimensions))
+	dp.shares = make([]map[string]float64, len(dp.args.dimensions))
+	for i := range dp.args.dimensions {
+		dp.usage[i] = make(map[string]*api.Resource)
+		dp.overQuota[i] = make(map[string]bool)
+		dp.shares[i] = make(map[string]float64)
+	}
+
+	for _, job := range ssn.Jobs {
+		if job.Allocated.IsEmpty() {
+			continue
+		}
+		for i, d := range dp.args.dimensions {
+			value := getJobValue(job, d.labelKey)
+			if value == "" {
+				continue
+			}
+			usage, found := dp.usage[i][value]
+			if !found {
+				usage = api.EmptyResource()
+				dp.usage[i][value] = usage
+			}
+			usage.Add(job.Allocated)
+		}
+	}
+
+	for i, d := range dp.args.dimensions {
+		for value, usage := range dp.usage[i] {
+			q := d.quotaOf(value)
+			dp.shares[i][value] = calculateShare(usage, q)
+			if isOverQuota(usage, q) {
+				dp.overQuota[i][value] = true
+				klog.V(4).Infof("dimensions: %s %s is over quota, usage <%v>, quota <%v>", d.name, value, usage, q.limit)
+			}
+		}
+	}
+
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		if dp.args.conflictResolution == ConflictResolutionWeighted {
+			return dp.compareWeighted(lv, rv)
+		}
+		return dp.comparePrecedence(lv, rv)
+	}
+	ssn.AddJobOrderFn(dp.Name(), jobOrderFn)
+
+	enforce := false
+	for _, d := range dp.args.dimensions {
+		enforce = enforce || d.enforce
+	}
+	if enforce {
+		jobEnqueueableFn := func(obj interface{}) int {
+			job := obj.(*api.JobInfo)
+			for i, d := range dp.args.dimensions {
+				if !d.enforce || !dp.isJobOverQuota(i, job) {
+					continue
+				}
+				msg := fmt.Sprintf("%s %s is over quota", d.name, getJobValue(job, d.labelKey))
+				klog.V(3).Infof("dimensions: reject enqueue of job <%s/%s>: %s", job.Namespace, job.Name, msg)
+				ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, string(scheduling.PodGroupUnschedulableType), msg)
+				return util.Reject
+			}
+			return util.Abstain
+		}
+		ssn.AddJobEnqueueableFn(dp.Name(), jobEnqueueableFn)
+	}
+}
+
+func (dp *dimensionsPlugin) OnSessionClose(ssn *framework.Session) {
+	dp.usage = nil
+	dp.overQuota = nil
+	dp.shares = nil
+}
+
+// comparePrecedence orders jobs over quota in a dimension behind the other jobs, the
+// dimensions being compared in order of precedence. Among jobs with the same standing,
+// the first dimension in which they have different shares decides. Exempt jobs and jobs
+// without a value in a dimension have a share of 0 in it, so that the order is total.
+func (dp *dimensionsPlugin) comparePrecedence(l, r *api.JobInfo) int {
+	for i := range dp.args.dimensions {
+		lOver, rOver := dp.isJobOverQuota(i, l), dp.isJobOverQuota(i, r)
+		if lOver && !rOver {
+			return 1
+		}
+		if !lOver && rOver {
+			return -1
+		}
+	}
+
+	for i := range dp.args.dimensions {
+		lShare, rShare := dp.shareOf(i, l), dp.shareOf(i, r)
+		if lShare < rShare {
+			return -1
+		}
+		if lShare > rShare {
+			return 1
+		}
+	}
+	return 0
+}
+
+// compareWeighted orders jobs by the total weight of the dimensions they are over quota
+// in, then by the weighted sum of the shares of their values.
+func (dp *dimensionsPlugin) compareWeighted(l, r *api.JobInfo) int {
+	var lOver, rOver float64
+	for i, d := range dp.args.dimensions {
+		if dp.isJobOverQuota(i, l) {
+			lOver += d.weight
+		}
+		if dp.isJobOverQuota(i, r) {
+			rOver += d.weight
+		}
+	}
+	if lOver < rOver {
+		return -1
+	}
+	if lOver > rOver {
+		return 1
+	}
+
+	lShare, rShare := dp.weightedShare(l), dp.weightedShare(r)
+	if lShare < rShare {
+		return -1
+	}
+	if lShare > rShare {
+		return 1
+	}
+	return 0
+}
+
+// weightedShare returns the sum of the shares of the job, weighted by dimension.
+func (dp *dimensionsPlugin) weightedShare(job *api.JobInfo) float64 {
+	share := 0.0
+	for i, d := range dp.args.dimensions {
+		share += d.weight * dp.shareOf(i, job)
+	}
+	return share
+}
+
+// shareOf returns the share of the value of the job in the i-th dimension, 0 if the job is
+// exempt or has no value in the dimension.
+func (dp *dimensionsPlugin) shareOf(i int, job *api.JobInfo) float64 {
+	value := getJobValue(job, dp.args.dimensions[i].labelKey)
+	if value == "" || dp.isJobExempt(job) {
+		return 0
+	}
+	return dp.shares[i][value]
+}
+
+// isJobExempt returns whether the job is exempt from the quotas.
+func (dp *dimensionsPlugin) isJobExempt(job *api.JobInfo) bool {
+	return dp.args.exemptPriorities.Matches(job.Priority)
+}
+
+// isJobOverQuota returns whether the value of the job in the i-th dimension is over quota
+// and the job is not exempt.
+func (dp *dimensionsPlugin) isJobOverQuota(i int, job *api.JobInfo) bool {
+	value := getJobValue(job, dp.args.dimensions[i].labelKey)
+	return value != "" && dp.overQuota[i][value] && !dp.isJobExempt(job)
+}
+
+// getJobValue returns the value of the job in a dimension from the labels of its PodGroup,
+// falling back to its annotations.
+func getJobValue(job *api.JobInfo, key string) string {
+	if job.PodGroup == nil {
+		return ""
+	}
+	if value, found := job.PodGroup.Labels[key]; found {
+		return value
+	}
+	return job.PodGroup.Annotations[key]
+}
+
+// isOverQuota returns true if the usage of any limited resource reaches its limit.
+// A limit of zero forbids any usage of that resource.
+func isOverQuota(usage *api.Resource, q *quota) bool {
+	for _, name := range q.names {
+		used, limit := usage.Get(name), q.limit.Get(name)
+		if limit <= 0 {
+			if used > 0 {
+				return true
+			}
+			continue
+		}
+		if used >= limit {
+			return true
+		}
+	}
+	return false
+}
+
+// calculateShare returns the highest usage-to-quota ratio among the limited resources.
+func calculateShare(usage *api.Resource, q *quota) float64 {
+	share := 0.0
+	for _, name := range q.names {
+		limit := q.limit.Get(name)
+		if limit <= 0 {
+			continue
+		}
+		if ratio := usage.Get(name) / limit; ratio > share {
+			share = ratio
+		}
+	}
+	return share
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dimensions
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	teamKey     = "example.com/team"
+	hardwareKey = "example.com/hardware-type"
+)
+
+func init() {
+	options.Default()
+}
+
+func dimensionAnno(team, hardware string) map[string]string {
+	return map[string]string{teamKey: team, hardwareKey: hardware}
+}
+
+// openTestSession opens a session with only the dimensions plugin enabled on a single 8 CPU node.
+func openTestSession(name string, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, dimensionAnno("team-a", "cpu")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, dimensionAnno("team-b", "gpu")),
+		util.BuildPodGroupWithAnno("pg-c-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, dimensionAnno("team-c", "gpu")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dimensionAnno("team-a", "cpu")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dimensionAnno("team-b", "gpu")),
+		util.BuildPodGroupWithAnno("pg-c-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dimensionAnno("team-c", "cpu")),
+		util.BuildPodGroupWithAnno("pg-zero-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dimensionAnno("team-d", "cpu")),
+		util.BuildPodGroupWithAnno("pg-team-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, map[string]string{teamKey: "team-b"}),
+		util.BuildPodGroupWithAnno("pg-unlabeled-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, nil),
+		util.BuildPodGroupWithAnno("pg-vip-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dimensionAnno("team-a", "gpu")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "b-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "c-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-c-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+		util.BuildPod("ns1", "c-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c-pending", nil, nil),
+		util.BuildPod("ns1", "zero-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-zero-pending", nil, nil),
+		util.BuildPod("ns1", "team-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-team-pending", nil, nil),
+		util.BuildPod("ns1", "unlabeled-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-unlabeled-pending", nil, nil),
+		util.BuildPod("ns1", "vip-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-vip-pending", nil, nil),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      name,
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobOrder:    &trueValue,
+			EnabledJobEnqueued: &trueValue,
+			Arguments:          arguments,
+		}},
+	}}
+	return tc.RegisterSession(tiers, nil), tc
+}
+
+// team usage is team-a: 2, team-b: 1, team-c: 2 CPU, so team-a is over its quota of 2 CPU.
+// hardware usage is cpu: 2, gpu: 3 CPU, so gpu is over its quota of 3 CPU.
+func teamDimension(weight int) map[string]interface{} {
+	return map[string]interface{}{"name": "team", "labelKey": teamKey, "weight": weight, "quota": map[string]interface{}{"cpu": "2"}}
+}
+
+func hardwareDimension(weight int) map[string]interface{} {
+	return map[string]interface{}{"name": "hardware", "labelKey": hardwareKey, "weight": weight, "quota": map[string]interface{}{"cpu": "3"}}
+}
+
+func TestJobOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		// expectAFirst tells whether a-pending, over quota in team, is ordered before
+		// b-pending, over quota in hardware.
+		expectAFirst bool
+	}{
+		{
+			name:         "team has precedence",
+			arguments:    framework.Arguments{dimensionsKey: []interface{}{teamDimension(1), hardwareDimension(1)}},
+			expectAFirst: false,
+		},
+		{
+			name:         "hardware has precedence",
+			arguments:    framework.Arguments{dimensionsKey: []interface{}{hardwareDimension(1), teamDimension(1)}},
+			expectAFirst: true,
+		},
+		{
+			name: "hardware weighs more",
+			arguments: framework.Arguments{
+				dimensionsKey:         []interface{}{teamDimension(1), hardwareDimension(3)},
+				conflictResolutionKey: ConflictResolutionWeighted,
+			},
+			expectAFirst: true,
+		},
+		{
+			name: "team weighs more",
+			arguments: framework.Arguments{
+				dimensionsKey:         []interface{}{teamDimension(3), hardwareDimension(1)},
+				conflictResolutionKey: ConflictResolutionWeighted,
+			},
+			expectAFirst: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSession(test.name, test.arguments)
+			defer tc.Close()
+
+			a, b := ssn.Jobs["ns1/pg-a-pending"], ssn.Jobs["ns1/pg-b-pending"]
+			if got := ssn.JobOrderFn(a, b); got != test.expectAFirst {
+				t.Errorf("expected a-pending first %v, got %v", test.expectAFirst, got)
+			}
+			if got := ssn.JobOrderFn(b, a); got == test.expectAFirst {
+				t.Errorf("expected b-pending first %v, got %v", !test.expectAFirst, got)
+			}
+		})
+	}
+}
+
+// TestJobOrderIsTotal checks that the order stays total with exempt jobs and jobs without
+// a value in some dimensions.
+func TestJobOrderIsTotal(t *testing.T) {
+	exempt := map[string]interface{}{
+		"expressions": []interface{}{
+			map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}},
+		},
+	}
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+	}{
+		{
+			name: "precedence",
+			arguments: framework.Arguments{
+				dimensionsKey:       []interface{}{teamDimension(1), hardwareDimension(1)},
+				exemptPrioritiesKey: exempt,
+			},
+		},
+		{
+			name: "weighted",
+			arguments: framework.Arguments{
+				dimensionsKey:         []interface{}{teamDimension(1), hardwareDimension(3)},
+				conflictResolutionKey: ConflictResolutionWeighted,
+				exemptPrioritiesKey:   exempt,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSession(test.name, test.arguments)
+			defer tc.Close()
+			ssn.Jobs["ns1/pg-vip-pending"].Priority = 1000
+
+			jobs := make([]*api.JobInfo, 0, len(ssn.Jobs))
+			for _, job := range ssn.Jobs {
+				jobs = append(jobs, job)
+			}
+			for _, a := range jobs {
+				if ssn.JobOrderFn(a, a) {
+					t.Errorf("%s is before itself", a.Name)
+				}
+				for _, b := range jobs {
+					if a == b {
+						continue
+					}
+					if ssn.JobOrderFn(a, b) == ssn.JobOrderFn(b, a) {
+						t.Errorf("%s and %s are not ordered", a.Name, b.Name)
+					}
+					for _, c := range jobs {
+						if c != a && c != b && ssn.JobOrderFn(a, b) && ssn.JobOrderFn(b, c) && !ssn.JobOrderFn(a, c) {
+							t.Errorf("%s < %s < %s but not %s < %s", a.Name, b.Name, c.Name, a.Name, c.Name)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEnforceAndExempt(t *testing.T) {
+	team := teamDimension(1)
+	team["enforce"] = true
+	ssn, tc := openTestSession("enforce", framework.Arguments{
+		dimensionsKey: []interface{}{team, hardwareDimension(1)},
+		exemptPrioritiesKey: map[string]interface{}{
+			"expressions": []interface{}{
+				map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}},
+			},
+		},
+	})
+	defer tc.Close()
+
+	a, b := ssn.Jobs["ns1/pg-a-pending"], ssn.Jobs["ns1/pg-b-pending"]
+	if ssn.JobEnqueueable(a) {
+		t.Errorf("expected job of over-quota team-a to be rejected")
+	}
+	if !ssn.JobEnqueueable(b) {
+		t.Errorf("expected job over quota in a dimension not enforced to be enqueueable")
+	}
+
+	a.Priority = 1000
+	if !ssn.JobEnqueueable(a) {
+		t.Errorf("expected exempt job to be enqueueable")
+	}
+	if !ssn.JobOrderFn(a, b) {
+		t.Errorf("expected exempt job to be ordered before the over-quota job")
+	}
+}
+
+func TestParseDimensions(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     interface{}
+		wantErr bool
+	}{
+		{
+			name: "value quota override",
+			raw: []interface{}{map[string]interface{}{
+				"labelKey":    teamKey,
+				"valueQuotas": map[string]interface{}{"research": map[string]interface{}{"cpu": 16}},
+			}},
+		},
+		{
+			name:    "missing label key",
+			raw:     []interface{}{map[string]interface{}{"quota": map[string]interface{}{"cpu": "2"}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing quota",
+			raw:     []interface{}{map[string]interface{}{"labelKey": teamKey}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid quantity",
+			raw:     []interface{}{map[string]interface{}{"labelKey": teamKey, "quota": map[string]interface{}{"cpu": "two"}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate dimension",
+			raw:     []interface{}{teamDimension(1), teamDimension(2)},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			raw:     []interface{}{map[string]interface{}{"labelKey": teamKey, "quota": map[string]interface{}{"cpu": "2"}, "enforced": true}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dimensions, err := parseDimensions(test.raw)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !test.wantErr && dimensions[0].quotaOf("research").limit.MilliCPU != 16000 {
+				t.Errorf("expected the value quota of research to be used, got %v", dimensions[0].quotaOf("research").limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/capacity"
+	"volcano.sh/volcano/pkg/scheduler/plugins/tdm"
+	tutil "volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// evictAllPlugin permits the eviction of every evictee, as a plugin of a later tier.
+type evictAllPlugin struct{}
+
+const evictAllPluginName = "evict-all"
+
+func (ep *evictAllPlugin) Name() string { return evictAllPluginName }
+
+func (ep *evictAllPlugin) OnSessionOpen(ssn *framework.Session) {
+	evictAll := func(evictor *api.TaskInfo, evictees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+		return evictees, tutil.Permit
+	}
+	ssn.AddPreemptableFn(ep.Name(), evictAll)
+	ssn.AddReclaimableFn(ep.Name(), evictAll)
+}
+
+func (ep *evictAllPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// TestRejectEndsTier checks that a plugin rejecting with no candidates only ends its own
+// tier, leaving the decision to the plugins of the later tiers.
+func TestRejectEndsTier(t *testing.T) {
+	trueValue := true
+	tests := []struct {
+		name   string
+		plugin conf.PluginOption
+		// queues are the queues of the jobs, q1 unless set.
+		queues map[string]string
+		// preemptable makes the evictor a preemptable task.
+		preemptable bool
+		reclaim     bool
+	}{
+		{
+			name:        "tdm rejects a preemptable preemptor",
+			plugin:      conf.PluginOption{Name: tdm.PluginName, EnabledPreemptable: &trueValue},
+			preemptable: true,
+		},
+		{
+			name:    "capacity rejects while the queue hierarchy is not ready",
+			plugin:  conf.PluginOption{Name: capacity.PluginName, EnabledReclaimable: &trueValue, EnabledHierarchy: &trueValue},
+			queues:  map[string]string{"pg-c": "root"},
+			reclaim: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var podGroups []*vcapisv1.PodGroup
+			for _, name := range []string{"pg-a", "pg-b", "pg-c"} {
+				queue, phase := "q1", vcapisv1.PodGroupRunning
+				if q, found := test.queues[name]; found {
+					queue = q
+				}
+				if name == "pg-c" {
+					phase = vcapisv1.PodGroupInqueue
+				}
+				podGroups = append(podGroups, util.BuildPodGroup(name, "ns1", queue, 1, nil, phase))
+			}
+			root := util.BuildQueue("root", 1, nil)
+			q1 := util.BuildQueue("q1", 1, nil)
+			q1.Spec.Parent = "root"
+
+			tc := &uthelper.TestCommonStruct{
+				Name: test.name,
+				Plugins: map[string]framework.PluginBuilder{
+					tdm.PluginName:      tdm.New,
+					capacity.PluginName: capacity.New,
+					evictAllPluginName:  func(framework.Arguments) framework.Plugin { return &evictAllPlugin{} },
+				},
+				PodGroups: podGroups,
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "a", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a", nil, nil),
+					util.BuildPod("ns1", "b", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b", nil, nil),
+					util.BuildPod("ns1", "c", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*vcapisv1.Queue{root, q1},
+			}
+			tiers := []conf.Tier{
+				{Plugins: []conf.PluginOption{test.plugin}},
+				{Plugins: []conf.PluginOption{{Name: evictAllPluginName, EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue}}},
+			}
+			ssn := tc.RegisterSession(tiers, nil)
+			defer tc.Close()
+
+			taskOf := func(job api.JobID) *api.TaskInfo {
+				for _, task := range ssn.Jobs[job].Tasks {
+					return task
+				}
+				return nil
+			}
+			evictor := taskOf("ns1/pg-c").Clone()
+			evictor.Preemptable = test.preemptable
+			evictees := []*api.TaskInfo{taskOf("ns1/pg-a"), taskOf("ns1/pg-b")}
+
+			evict := ssn.Preemptable
+			if test.reclaim {
+				evict = ssn.Reclaimable
+			}
+			var names []string
+			for _, victim := range evict(evictor, evictees) {
+				names = append(names, victim.Name)
+			}
+			sort.Strings(names)
+			if expected := []string{"a", "b"}; !reflect.DeepEqual(names, expected) {
+				t.Errorf("expected the later tier to evict %v, got %v", expected, names)
+			}
+		})
+	}
+}
@@ -22,15 +22,25 @@ package plugins
 
 import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/admissionforecast"
+	"volcano.sh/volcano/pkg/scheduler/plugins/bandpartition"
 	"volcano.sh/volcano/pkg/scheduler/plugins/binpack"
 	"volcano.sh/volcano/pkg/scheduler/plugins/capacity"
+	"volcano.sh/volcano/pkg/scheduler/plugins/capacitytier"
 	"volcano.sh/volcano/pkg/scheduler/plugins/cdp"
 	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/cooldown"
+	"volcano.sh/volcano/pkg/scheduler/plugins/deadline"
 	"volcano.sh/volcano/pkg/scheduler/plugins/deviceshare"
+	"volcano.sh/volcano/pkg/scheduler/plugins/dimensions"
 	"volcano.sh/volcano/pkg/scheduler/plugins/drf"
 	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+	"volcano.sh/volcano/pkg/scheduler/plugins/flavor"
 	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
 	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupspread"
+	"volcano.sh/volcano/pkg/scheduler/plugins/jobdeps"
+	"volcano.sh/volcano/pkg/scheduler/plugins/maintenance"
 	networktopologyaware "volcano.sh/volcano/pkg/scheduler/plugins/network-topology-aware"
 	"volcano.sh/volcano/pkg/scheduler/plugins/nodegroup"
 	"volcano.sh/volcano/pkg/scheduler/plugins/nodeorder"
@@ -43,10 +53,13 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/rescheduling"
 	resourcestrategyfit "volcano.sh/volcano/pkg/scheduler/plugins/resource-strategy-fit"
 	"volcano.sh/volcano/pkg/scheduler/plugins/resourcequota"
+	runtimebackfill "volcano.sh/volcano/pkg/scheduler/plugins/runtime-backfill"
 	"volcano.sh/volcano/pkg/scheduler/plugins/sla"
+	"volcano.sh/volcano/pkg/scheduler/plugins/slo"
 	tasktopology "volcano.sh/volcano/pkg/scheduler/plugins/task-topology"
 	"volcano.sh/volcano/pkg/scheduler/plugins/tdm"
 	"volcano.sh/volcano/pkg/scheduler/plugins/usage"
+	"volcano.sh/volcano/pkg/scheduler/plugins/usergroupfairness"
 )
 
 func init() {
@@ -54,6 +67,7 @@ func init() {
 	framework.RegisterPluginBuilder(drf.PluginName, drf.New)
 	framework.RegisterPluginBuilder(gang.PluginName, gang.New)
 	framework.RegisterPluginBuilder(groupquota.PluginName, groupquota.New)
+	framework.RegisterPluginBuilder(dimensions.PluginName, dimensions.New)
 	framework.RegisterPluginBuilder(deviceshare.PluginName, deviceshare.New)
 	framework.RegisterPluginBuilder(predicates.PluginName, predicates.New)
 	framework.RegisterPluginBuilder(priority.PluginName, priority.New)
@@ -64,6 +78,18 @@ func init() {
 	framework.RegisterPluginBuilder(tdm.PluginName, tdm.New)
 	framework.RegisterPluginBuilder(overcommit.PluginName, overcommit.New)
 	framework.RegisterPluginBuilder(sla.PluginName, sla.New)
+	framework.RegisterPluginBuilder(slo.PluginName, slo.New)
+	framework.RegisterPluginBuilder(deadline.PluginName, deadline.New)
+	framework.RegisterPluginBuilder(cooldown.PluginName, cooldown.New)
+	framework.RegisterPluginBuilder(runtimebackfill.PluginName, runtimebackfill.New)
+	framework.RegisterPluginBuilder(usergroupfairness.PluginName, usergroupfairness.New)
+	framework.RegisterPluginBuilder(admissionforecast.PluginName, admissionforecast.New)
+	framework.RegisterPluginBuilder(maintenance.PluginName, maintenance.New)
+	framework.RegisterPluginBuilder(capacitytier.PluginName, capacitytier.New)
+	framework.RegisterPluginBuilder(bandpartition.PluginName, bandpartition.New)
+	framework.RegisterPluginBuilder(groupspread.PluginName, groupspread.New)
+	framework.RegisterPluginBuilder(jobdeps.PluginName, jobdeps.New)
+	framework.RegisterPluginBuilder(flavor.PluginName, flavor.New)
 	framework.RegisterPluginBuilder(tasktopology.PluginName, tasktopology.New)
 	framework.RegisterPluginBuilder(numaaware.PluginName, numaaware.New)
 	framework.RegisterPluginBuilder(cdp.PluginName, cdp.New)
@@ -82,4 +108,13 @@ func init() {
 
 	// Plugins for ResourceQuota
 	framework.RegisterPluginBuilder(resourcequota.PluginName, resourcequota.New)
+
+	// Validators of plugin arguments, run when the configuration is (re)loaded
+	framework.RegisterArgumentsValidator(groupquota.PluginName, groupquota.ValidateArguments)
+	framework.RegisterArgumentsValidator(priority.PluginName, priority.ValidateArguments)
+	framework.RegisterArgumentsValidator(dimensions.PluginName, dimensions.ValidateArguments)
+	framework.RegisterArgumentsValidator(bandpartition.PluginName, bandpartition.ValidateArguments)
+	framework.RegisterArgumentsValidator(flavor.PluginName, flavor.ValidateArguments)
+	framework.RegisterArgumentsValidator(usergroupfairness.PluginName, usergroupfairness.ValidateArguments)
+	framework.RegisterArgumentsValidator(slo.PluginName, slo.ValidateArguments)
 }
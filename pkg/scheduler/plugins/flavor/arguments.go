@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavor
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// flavorsKey is the list of flavors.
+	flavorsKey = "flavors"
+	// flavorKeyKey is the PodGroup annotation holding the flavor requested by a job.
+	flavorKeyKey = "flavorKey"
+	// groupKeyKey is the PodGroup annotation holding the group of a job, as in groupquota.
+	groupKeyKey = "groupKey"
+)
+
+// flavorConfig is the configuration of one flavor, e.g.
+//
+//	flavors:
+//	- name: a100-large
+//	  nodeSelector:
+//	    example.com/gpu: a100
+//	  preferences:
+//	  - labels:
+//	      example.com/gpu-memory: 80g
+//	    weight: 2
+//	  quota:
+//	    nvidia.com/gpu: "16"
+//	  groupQuotas:
+//	    research:
+//	      nvidia.com/gpu: "32"
+type flavorConfig struct {
+	// Name is the value of the flavor annotation of the jobs requesting the flavor.
+	Name string `json:"name"`
+	// NodeSelector selects the nodes providing the flavor.
+	NodeSelector map[string]string `json:"nodeSelector"`
+	// Preferences score higher the nodes having their labels.
+	Preferences []preferenceConfig `json:"preferences"`
+	// Quota is the resource limit of the flavor applied to each group.
+	Quota map[string]string `json:"quota"`
+	// GroupQuotas overrides Quota for some groups.
+	GroupQuotas map[string]map[string]string `json:"groupQuotas"`
+}
+
+type preferenceConfig struct {
+	Labels map[string]string `json:"labels"`
+	// Weight is 1 by default.
+	Weight int `json:"weight"`
+}
+
+// quota is a resource limit, only the resources in names are limited.
+type quota struct {
+	limit *api.Resource
+	names []v1.ResourceName
+}
+
+type preference struct {
+	selector labels.Selector
+	weight   int
+}
+
+// ValidateArguments rejects the flavors which would leave every flavor unknown.
+func ValidateArguments(arguments framework.Arguments) error {
+	_, err := parseFlavors(arguments[flavorsKey])
+	return err
+}
+
+// parseFlavors decodes and validates the flavors, every error is reported.
+func parseFlavors(raw interface{}) (map[string]*flavor, error) {
+	flavors := map[string]*flavor{}
+	if raw == nil {
+		return flavors, nil
+	}
+
+	var configs []flavorConfig
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &configs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode flavors: %v", err)
+	}
+
+	var errs []error
+	for i, config := range configs {
+		f, err := config.parse()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %v", flavorsKey, i, err))
+			continue
+		}
+		if _, found := flavors[f.name]; found {
+			errs = append(errs, fmt.Errorf("%s[%d]: duplicate flavor %s", flavorsKey, i, f.name))
+			continue
+		}
+		flavors[f.name] = f
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return flavors, nil
+}
+
+func (c flavorConfig) parse() (*flavor, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(c.NodeSelector) == 0 {
+		return nil, fmt.Errorf("nodeSelector of flavor %s is required", c.Name)
+	}
+
+	f := &flavor{
+		name:         c.Name,
+		nodeSelector: labels.SelectorFromSet(c.NodeSelector),
+		groupQuotas:  make(map[string]*quota, len(c.GroupQuotas)),
+	}
+	for i, p := range c.Preferences {
+		if len(p.Labels) == 0 {
+			return nil, fmt.Errorf("preferences[%d] has no labels", i)
+		}
+		if p.Weight < 0 {
+			return nil, fmt.Errorf("preferences[%d] has negative weight %d", i, p.Weight)
+		}
+		weight := p.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		f.preferences = append(f.preferences, preference{selector: labels.SelectorFromSet(p.Labels), weight: weight})
+		f.totalWeight += weight
+	}
+
+	q, err := parseQuota(c.Quota)
+	if err != nil {
+		return nil, fmt.Errorf("quota: %v", err)
+	}
+	f.quota = q
+	for group, raw := range c.GroupQuotas {
+		q, err := parseQuota(raw)
+		if err != nil {
+			return nil, fmt.Errorf("groupQuotas[%s]: %v", group, err)
+		}
+		f.groupQuotas[group] = q
+	}
+	return f, nil
+}
+
+// parseQuota parses a map of resource name to quantity string.
+func parseQuota(raw map[string]string) (*quota, error) {
+	list := v1.ResourceList{}
+	q := &quota{}
+	for name, value := range raw {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q for %s: %v", value, name, err)
+		}
+		list[v1.ResourceName(name)] = quantity
+		q.names = append(q.names, v1.ResourceName(name))
+	}
+	q.limit = api.NewResource(list)
+	return q, nil
+}
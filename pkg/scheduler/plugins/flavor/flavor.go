@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package flavor lets jobs request an abstract hardware flavor instead of node affinities.
+// Each flavor maps to a node selector, node scoring preferences and a quota per group, the
+// group of a job being found in the groupquota annotation.
+package flavor
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	k8sFramework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "flavor"
+
+	// FlavorAnnotation is the default PodGroup annotation holding the flavor requested by a job.
+	FlavorAnnotation = "volcano.sh/flavor"
+	// defaultGroupKey is the default group annotation of the groupquota plugin.
+	defaultGroupKey = "example.com/group"
+
+	// FlavorQuotaExceededReason is the reason of the event recorded for jobs over their flavor quota.
+	FlavorQuotaExceededReason = "FlavorQuotaExceeded"
+	// UnknownFlavorReason is the reason of the event recorded for jobs requesting an unknown flavor.
+	UnknownFlavorReason = "UnknownFlavor"
+)
+
+// flavor is a hardware class and the usage of each group of it.
+type flavor struct {
+	name         string
+	nodeSelector labels.Selector
+	preferences  []preference
+	totalWeight  int
+	quota        *quota
+	groupQuotas  map[string]*quota
+
+	usage map[string]*api.Resource
+}
+
+type flavorPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	flavorKey string
+	groupKey  string
+	flavors   map[string]*flavor
+}
+
+// New return flavor plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &flavorPlugin{
+		pluginArguments: arguments,
+		flavorKey:       FlavorAnnotation,
+		groupKey:        defaultGroupKey,
+	}
+}
+
+func (fp *flavorPlugin) Name() string {
+	return PluginName
+}
+
+func (fp *flavorPlugin) OnSessionOpen(ssn *framework.Session) {
+	if v, ok := fp.pluginArguments[flavorKeyKey].(string); ok && v != "" {
+		fp.flavorKey = v
+	}
+	if v, ok := fp.pluginArguments[groupKeyKey].(string); ok && v != "" {
+		fp.groupKey = v
+	}
+	flavors, err := parseFlavors(fp.pluginArguments[flavorsKey])
+	if err != nil {
+		klog.Errorf("flavor plugin: invalid %s, no flavor is known: %v", flavorsKey, err)
+		flavors = map[string]*flavor{}
+	}
+	fp.flavors = flavors
+
+	for _, job := range ssn.Jobs {
+		f, requested := fp.flavorOf(job)
+		if !requested || f == nil {
+			continue
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				f.usageOf(fp.groupOf(job)).Add(task.Resreq)
+			}
+		}
+	}
+
+	ssn.AddJobEnqueueableFn(fp.Name(), func(obj interface{}) int {
+		job := obj.(*api.JobInfo)
+		f, requested := fp.flavorOf(job)
+		if !requested {
+			return util.Abstain
+		}
+		if f == nil {
+			msg := fmt.Sprintf("flavor %s is not configured", job.PodGroup.Annotations[fp.flavorKey])
+			ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeWarning, UnknownFlavorReason, msg)
+			return util.Reject
+		}
+		group := fp.groupOf(job)
+		if q := f.quotaOf(group); isOverQuota(f.usageOf(group), q) {
+			msg := fmt.Sprintf("group %q is over its quota of flavor %s", group, f.name)
+			klog.V(3).Infof("flavor: job <%s/%s> is not enqueued, %s", job.Namespace, job.Name, msg)
+			ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, FlavorQuotaExceededReason, msg)
+			return util.Reject
+		}
+		return util.Abstain
+	})
+
+	ssn.AddAllocatableFn(fp.Name(), func(queue *api.QueueInfo, candidate *api.TaskInfo) bool {
+		job, found := ssn.Jobs[candidate.Job]
+		if !found {
+			return true
+		}
+		f, requested := fp.flavorOf(job)
+		if !requested {
+			return true
+		}
+		if f == nil {
+			return false
+		}
+		group := fp.groupOf(job)
+		futureUsed := f.usageOf(group).Clone().Add(candidate.Resreq)
+		q := f.quotaOf(group)
+		for _, name := range q.names {
+			if futureUsed.Get(name) > q.limit.Get(name) {
+				klog.V(3).Infof("Flavor <%s> of group <%s>: quota <%v>, allocated <%v>; Candidate <%v>: resource request <%v>",
+					f.name, group, q.limit, f.usageOf(group), candidate.Name, candidate.Resreq)
+				return false
+			}
+		}
+		return true
+	})
+
+	ssn.AddPredicateFn(fp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+		job, found := ssn.Jobs[task.Job]
+		if !found {
+			return nil
+		}
+		f, requested := fp.flavorOf(job)
+		if !requested {
+			return nil
+		}
+		if f == nil {
+			return api.NewFitError(task, node, fmt.Sprintf("flavor %s is not configured", job.PodGroup.Annotations[fp.flavorKey]))
+		}
+		if node.Node == nil || !f.nodeSelector.Matches(labels.Set(node.Node.Labels)) {
+			return api.NewFitError(task, node, fmt.Sprintf("node does not provide flavor %s", f.name))
+		}
+		return nil
+	})
+
+	ssn.AddNodeOrderFn(fp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		job, found := ssn.Jobs[task.Job]
+		if !found || node.Node == nil {
+			return 0, nil
+		}
+		f, _ := fp.flavorOf(job)
+		if f == nil || f.totalWeight == 0 {
+			return 0, nil
+		}
+		matched := 0
+		for _, p := range f.preferences {
+			if p.selector.Matches(labels.Set(node.Node.Labels)) {
+				matched += p.weight
+			}
+		}
+		score := float64(k8sFramework.MaxNodeScore) * float64(matched) / float64(f.totalWeight)
+		klog.V(5).Infof("flavor score for Task %s/%s on node %s is: %v", task.Namespace, task.Name, node.Name, score)
+		return score, nil
+	})
+
+	handle := func(event *framework.Event, allocate bool) {
+		job, found := ssn.Jobs[event.Task.Job]
+		if !found {
+			return
+		}
+		f, _ := fp.flavorOf(job)
+		if f == nil {
+			return
+		}
+		if allocate {
+			f.usageOf(fp.groupOf(job)).Add(event.Task.Resreq)
+		} else {
+			f.usageOf(fp.groupOf(job)).Sub(event.Task.Resreq)
+		}
+	}
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			handle(event, true)
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			handle(event, false)
+		},
+	})
+}
+
+func (fp *flavorPlugin) OnSessionClose(ssn *framework.Session) {
+	fp.flavors = nil
+}
+
+// flavorOf returns the flavor requested by the job, nil if it is not configured, and whether
+// the job requests a flavor at all.
+func (fp *flavorPlugin) flavorOf(job *api.JobInfo) (*flavor, bool) {
+	if job.PodGroup == nil {
+		return nil, false
+	}
+	name, found := job.PodGroup.Annotations[fp.flavorKey]
+	if !found {
+		return nil, false
+	}
+	return fp.flavors[name], true
+}
+
+func (fp *flavorPlugin) groupOf(job *api.JobInfo) string {
+	return job.PodGroup.Annotations[fp.groupKey]
+}
+
+func (f *flavor) usageOf(group string) *api.Resource {
+	if f.usage == nil {
+		f.usage = make(map[string]*api.Resource)
+	}
+	usage, found := f.usage[group]
+	if !found {
+		usage = api.EmptyResource()
+		f.usage[group] = usage
+	}
+	return usage
+}
+
+func (f *flavor) quotaOf(group string) *quota {
+	if q, found := f.groupQuotas[group]; found {
+		return q
+	}
+	return f.quota
+}
+
+// isOverQuota returns true once any limited resource reaches its limit.
+func isOverQuota(usage *api.Resource, q *quota) bool {
+	for _, name := range q.names {
+		if usage.Get(name) >= q.limit.Get(name) {
+			return true
+		}
+	}
+	return false
+}
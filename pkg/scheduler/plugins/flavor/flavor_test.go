@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavor
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestFlavor(t *testing.T) {
+	anno := func(flavor, group string) map[string]string {
+		return map[string]string{FlavorAnnotation: flavor, defaultGroupKey: group}
+	}
+	buildNode := func(name, gpu, memory string) *v1.Node {
+		return util.BuildNode(name, api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...),
+			map[string]string{"example.com/gpu": gpu, "example.com/gpu-memory": memory})
+	}
+	tc := &uthelper.TestCommonStruct{
+		Name:    "flavor",
+		Plugins: map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: []*vcapisv1.PodGroup{
+			util.BuildPodGroupWithAnno("pg-team-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, anno("a100-large", "team")),
+			util.BuildPodGroupWithAnno("pg-team-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, anno("a100-large", "team")),
+			util.BuildPodGroupWithAnno("pg-research-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, anno("a100-large", "research")),
+			util.BuildPodGroupWithAnno("pg-unknown", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, anno("h100", "team")),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("ns1", "team-running", "a100-80", v1.PodRunning, api.BuildResourceList("4", "1Gi"), "pg-team-running", nil, nil),
+			util.BuildPod("ns1", "team-pending", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-team-pending", nil, nil),
+			util.BuildPod("ns1", "research-pending", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-research-pending", nil, nil),
+			util.BuildPod("ns1", "unknown", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-unknown", nil, nil),
+		},
+		Nodes:  []*v1.Node{buildNode("a100-80", "a100", "80g"), buildNode("a100-40", "a100", "40g"), buildNode("v100", "v100", "32g")},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	trueValue := true
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobEnqueued: &trueValue,
+			EnabledAllocatable: &trueValue,
+			EnabledPredicate:   &trueValue,
+			EnabledNodeOrder:   &trueValue,
+			Arguments: framework.Arguments{
+				flavorsKey: []interface{}{
+					map[string]interface{}{
+						"name":         "a100-large",
+						"nodeSelector": map[string]interface{}{"example.com/gpu": "a100"},
+						"preferences": []interface{}{
+							map[string]interface{}{"labels": map[string]interface{}{"example.com/gpu-memory": "80g"}, "weight": 2},
+						},
+						"quota":       map[string]interface{}{"cpu": "4"},
+						"groupQuotas": map[string]interface{}{"research": map[string]interface{}{"cpu": "8"}},
+					},
+				},
+			},
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	taskOf := func(name string) *api.TaskInfo {
+		for _, task := range ssn.Jobs[api.JobID("ns1/pg-"+name)].Tasks {
+			return task
+		}
+		t.Fatalf("task %s not found", name)
+		return nil
+	}
+	queue := ssn.Queues["q1"]
+
+	for name, expected := range map[string]bool{"team-pending": false, "research-pending": true, "unknown": false} {
+		if got := ssn.JobEnqueueable(ssn.Jobs[api.JobID("ns1/pg-"+name)]); got != expected {
+			t.Errorf("expected job %s enqueueable %v, got %v", name, expected, got)
+		}
+		if got := ssn.Allocatable(queue, taskOf(name)); got != expected {
+			t.Errorf("expected task %s allocatable %v, got %v", name, expected, got)
+		}
+	}
+
+	research := taskOf("research-pending")
+	if err := ssn.PredicateFn(research, ssn.Nodes["v100"]); err == nil {
+		t.Errorf("expected nodes without the flavor to be filtered out")
+	}
+	if err := ssn.PredicateFn(research, ssn.Nodes["a100-40"]); err != nil {
+		t.Errorf("expected nodes of the flavor to fit, got %v", err)
+	}
+
+	preferred, _ := ssn.NodeOrderFn(research, ssn.Nodes["a100-80"])
+	other, _ := ssn.NodeOrderFn(research, ssn.Nodes["a100-40"])
+	if preferred <= other {
+		t.Errorf("expected the preferred node to score higher, got %v and %v", preferred, other)
+	}
+}
+
+func TestParseFlavors(t *testing.T) {
+	for name, raw := range map[string]interface{}{
+		"no nodeSelector": []interface{}{map[string]interface{}{"name": "a"}},
+		"bad quantity":    []interface{}{map[string]interface{}{"name": "a", "nodeSelector": map[string]interface{}{"k": "v"}, "quota": map[string]interface{}{"cpu": "x"}}},
+		"unknown field":   []interface{}{map[string]interface{}{"name": "a", "nodeSelector": map[string]interface{}{"k": "v"}, "selector": "k=v"}},
+		"duplicate": []interface{}{
+			map[string]interface{}{"name": "a", "nodeSelector": map[string]interface{}{"k": "v"}},
+			map[string]interface{}{"name": "a", "nodeSelector": map[string]interface{}{"k": "w"}},
+		},
+	} {
+		if _, err := parseFlavors(raw); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
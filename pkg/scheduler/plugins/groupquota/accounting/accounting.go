@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accounting holds the group quota accounting shared by the groupquota scheduler
+// plugin, the groupquota admission webhook and the groupquota controller.
+package accounting
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
+)
+
+const (
+	// SchedulingGateName is the scheduling gate held by the pods of a group over its hard
+	// quota, until the group is back under its limits.
+	SchedulingGateName = "volcano.sh/groupquota"
+	// GatedLabelKey marks the pods holding SchedulingGateName, so that they can be listed.
+	GatedLabelKey = "volcano.sh/groupquota-gated"
+	// GatedGroupAnnotationKey is the group of a pod holding SchedulingGateName.
+	GatedGroupAnnotationKey = "volcano.sh/groupquota-gated-group"
+)
+
+// PodGroup returns the group of the pod, found in its annotations or labels under key.
+func PodGroup(pod *v1.Pod, key string) string {
+	if pod == nil {
+		return ""
+	}
+	if group, found := pod.Annotations[key]; found {
+		return group
+	}
+	return pod.Labels[key]
+}
+
+// IsOverQuota returns true if the usage of any limited resource reaches its limit.
+// A limit of zero forbids any usage of that resource.
+func IsOverQuota(usage, quota *api.Resource, names []v1.ResourceName) bool {
+	for _, name := range names {
+		used, limit := usage.Get(name), quota.Get(name)
+		if limit <= 0 {
+			if used > 0 {
+				return true
+			}
+			continue
+		}
+		if used >= limit {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupQuotaOf returns the GroupQuota object of the group, or nil if there is none. When
+// several objects select the same group, the one with the smallest name wins.
+func GroupQuotaOf(gqs []*schedulingv1beta1.GroupQuota, group string) *schedulingv1beta1.GroupQuota {
+	var found *schedulingv1beta1.GroupQuota
+	for _, gq := range gqs {
+		if gq.Spec.Group == group && (found == nil || gq.Name < found.Name) {
+			found = gq
+		}
+	}
+	return found
+}
+
+// IsOverHardQuota returns whether the group of the GroupQuota object may not borrow and
+// its usage last reported by the scheduler reaches its limits.
+func IsOverHardQuota(gq *schedulingv1beta1.GroupQuota) bool {
+	if gq == nil || gq.Spec.BorrowingPolicy != schedulingv1beta1.BorrowingPolicyNever {
+		return false
+	}
+	usage := resourceconv.FromResourceList(gq.Status.Usage)
+	limits := resourceconv.FromResourceList(gq.Spec.Limits)
+	return IsOverQuota(usage, limits, resourceconv.Names(gq.Spec.Limits))
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/decisionlog"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/workloadselector"
+)
+
+const (
+	defaultAnnotationKey = "example.com/group"
+
+	// annotationKeyKey is the PodGroup annotation holding the group name of a job.
+	annotationKeyKey = "annotationKey"
+	// resourceMapKey is the quota shared by every group.
+	resourceMapKey = "resourceMap"
+	// fairShareKey enables ordering of jobs by their group's usage-to-quota ratio.
+	fairShareKey = "fairShare"
+	// groupWeightsKey is the per-group weight map used in fairShare mode.
+	groupWeightsKey = "groupWeights"
+	// includeUnmanagedPodsKey enables accounting of running pods that do not belong to any job.
+	includeUnmanagedPodsKey = "includeUnmanagedPods"
+	// dominantResourceKey decides over-quota on the group's dominant share of the cluster.
+	dominantResourceKey = "dominantResource"
+	// preemptOverQuotaKey lets jobs of under-quota groups preempt tasks of over-quota groups.
+	preemptOverQuotaKey = "preemptOverQuota"
+	// minimizeVictimsKey makes preemptOverQuota return only the victims needed to fit the preemptor.
+	minimizeVictimsKey = "minimizeVictims"
+	// maxInqueueJobsKey is the per-group limit of jobs in Inqueue or Running phase. A limit
+	// must be positive: a limit of 0 is rejected rather than read as unlimited or as admitting
+	// no job, and the group falls back on defaultMaxInqueueJobs.
+	maxInqueueJobsKey = "maxInqueueJobs"
+	// defaultMaxInqueueJobsKey is the limit for groups not listed in maxInqueueJobs, 0 means unlimited.
+	defaultMaxInqueueJobsKey = "defaultMaxInqueueJobs"
+	// exemptPrioritiesKey selects the job priorities never deprioritized or blocked by the quota.
+	exemptPrioritiesKey = "exemptPriorities"
+	// exemptWorkloadsKey selects the jobs never deprioritized or blocked by the quota, by
+	// priority, queue, namespace, labels or annotations.
+	exemptWorkloadsKey = "exemptWorkloads"
+	// reclaimOverDeservedKey lets reclaim take back the usage of the groups over their quota,
+	// treated as their deserved resources, for jobs of the groups under it.
+	reclaimOverDeservedKey = "reclaimOverDeserved"
+	// maxRunTimeKey makes the tasks that overran their maximum run time reclaimable.
+	maxRunTimeKey = "maxRunTime"
+	// groupQuotasKey takes the limits of the groups from the GroupQuota objects of the cluster.
+	groupQuotasKey = "groupQuotas"
+	// decisionLogKey enables the structured log of the decisions of the plugin.
+	decisionLogKey = "decisionLog"
+)
+
+// pluginArguments is the parsed form of the groupquota plugin arguments.
+type pluginArguments struct {
+	annotationKey string
+	// quota is the resource limit applied to each group.
+	quota *api.Resource
+	// quotaNames are the resource dimensions that have a limit configured.
+	quotaNames []v1.ResourceName
+
+	fairShare            bool
+	groupWeights         map[string]float64
+	includeUnmanagedPods bool
+	dominantResource     bool
+	preemptOverQuota     bool
+	minimizeVictims      bool
+	reclaimOverDeserved  bool
+	// groupQuotas takes the limits of the groups with a GroupQuota object from that object.
+	groupQuotas bool
+	// inheritNamespaceGroup takes the group of a job without group from its namespace.
+	inheritNamespaceGroup bool
+
+	maxInqueueJobs        map[string]int
+	defaultMaxInqueueJobs int
+	// nodePools is the node pool of the groups listed in allowedNodeSelectors.
+	nodePools map[string]*nodePool
+	// countStatuses is nil unless the usage is the requests of the tasks in these statuses.
+	countStatuses []api.TaskStatus
+	// intraGroupOrder is the order of the jobs of the same group, and of the jobs the
+	// quotas and the fair shares do not order, empty to abstain.
+	intraGroupOrder string
+
+	// exemptPriorities is nil unless exemptPriorities is configured.
+	exemptPriorities *priority.PrioritySelector
+	// exemptWorkloads is nil unless exemptWorkloads is configured.
+	exemptWorkloads *workloadselector.WorkloadSelector
+	// maxRunTime is nil unless maxRunTime is configured.
+	maxRunTime *runtime.Limits
+
+	// windowedQuota is nil unless a windowedQuota section is configured.
+	windowedQuota *windowedQuotaArguments
+	// statusReport is nil unless the status report is enabled.
+	statusReport *statusReportArguments
+	// remoteUsage is nil unless the usage of sibling clusters is added to the groups.
+	remoteUsage *remoteUsageArguments
+	// burst is nil unless the groups may burst over their quota.
+	burst *burstArguments
+	// premiumNodes is nil unless the groups over quota are kept away from premium nodes.
+	premiumNodes *premiumNodeArguments
+	// offendersReport is nil unless the groups the most over quota are reported.
+	offendersReport *offendersArguments
+	// usageDecay is nil unless the groups are ordered by their decayed historical usage.
+	usageDecay *usageDecayArguments
+	// decisionLog is nil unless the decisions of the plugin are logged.
+	decisionLog *decisionlog.Config
+}
+
+func parseArguments(arguments framework.Arguments) *pluginArguments {
+	if converted, strict, err := convertArguments(arguments); err != nil {
+		klog.Errorf("groupquota plugin: %v, parsing the arguments as legacy arguments", err)
+	} else {
+		arguments = converted
+		if strict {
+			for _, key := range unknownKeys(arguments) {
+				klog.Warningf("groupquota plugin: unknown argument %s, ignoring it", key)
+			}
+		}
+	}
+
+	args := &pluginArguments{
+		annotationKey: defaultAnnotationKey,
+	}
+
+	if arg, ok := arguments[annotationKeyKey]; ok {
+		if val, ok := arg.(string); ok {
+			args.annotationKey = val
+		}
+	} else {
+		klog.Warningf("groupquota plugin: annotationKey argument not provided, using default %s", args.annotationKey)
+	}
+
+	quota := parseResourceMap(arguments[resourceMapKey])
+	args.quota = api.NewResource(quota)
+	for name := range quota {
+		args.quotaNames = append(args.quotaNames, name)
+	}
+
+	arguments.GetBool(&args.fairShare, fairShareKey)
+	args.groupWeights = parseGroupWeights(arguments[groupWeightsKey])
+	arguments.GetBool(&args.includeUnmanagedPods, includeUnmanagedPodsKey)
+	arguments.GetBool(&args.dominantResource, dominantResourceKey)
+	arguments.GetBool(&args.preemptOverQuota, preemptOverQuotaKey)
+	arguments.GetBool(&args.minimizeVictims, minimizeVictimsKey)
+	arguments.GetBool(&args.reclaimOverDeserved, reclaimOverDeservedKey)
+	arguments.GetBool(&args.groupQuotas, groupQuotasKey)
+	arguments.GetBool(&args.inheritNamespaceGroup, inheritNamespaceGroupKey)
+	args.maxInqueueJobs = parseMaxInqueueJobs(arguments[maxInqueueJobsKey])
+	arguments.GetInt(&args.defaultMaxInqueueJobs, defaultMaxInqueueJobsKey)
+	args.nodePools = parseNodePools(arguments[allowedNodeSelectorsKey])
+	args.countStatuses = parseCountStatuses(arguments[countStatusesKey])
+	args.intraGroupOrder = parseIntraGroupOrder(arguments[intraGroupOrderKey])
+	if selector, err := priority.ParseSelector(arguments[exemptPrioritiesKey]); err != nil {
+		klog.Errorf("groupquota plugin: invalid %s, no priority is exempt: %v", exemptPrioritiesKey, err)
+	} else {
+		args.exemptPriorities = selector
+	}
+	if selector, err := workloadselector.Parse(arguments[exemptWorkloadsKey]); err != nil {
+		klog.Errorf("groupquota plugin: invalid %s, no workload is exempt: %v", exemptWorkloadsKey, err)
+	} else {
+		args.exemptWorkloads = selector
+	}
+	if limits, err := runtime.Parse(arguments[maxRunTimeKey]); err != nil {
+		klog.Errorf("groupquota plugin: invalid %s, no task is reclaimed for its run time: %v", maxRunTimeKey, err)
+	} else {
+		args.maxRunTime = limits
+	}
+	args.windowedQuota = parseWindowedQuota(arguments[windowedQuotaKey])
+	args.statusReport = parseStatusReport(arguments[statusReportKey])
+	args.remoteUsage = parseRemoteUsage(arguments[remoteUsageKey])
+	args.burst = parseBurst(arguments[burstKey])
+	args.premiumNodes = parsePremiumNodes(arguments[premiumNodeSelectorKey])
+	args.offendersReport = parseOffendersReport(arguments[offendersReportKey])
+	args.usageDecay = parseUsageDecay(arguments[usageDecayKey])
+	if config, err := decisionlog.Parse(arguments[decisionLogKey]); err != nil {
+		klog.Errorf("groupquota plugin: invalid %s, decisions are not logged: %v", decisionLogKey, err)
+	} else {
+		args.decisionLog = config
+	}
+
+	return args
+}
+
+// ValidateArguments reports the arguments that parseArguments would ignore or fall back
+// on, so that a configuration with such arguments is rejected when it is reloaded.
+func ValidateArguments(arguments framework.Arguments) error {
+	var errs []error
+	if converted, strict, err := convertArguments(arguments); err != nil {
+		errs = append(errs, err)
+	} else {
+		arguments = converted
+		if strict {
+			for _, key := range unknownKeys(arguments) {
+				errs = append(errs, fmt.Errorf("unknown argument %s", key))
+			}
+		}
+	}
+	if v, found := arguments[annotationKeyKey]; found {
+		if key, ok := v.(string); !ok || key == "" {
+			errs = append(errs, fmt.Errorf("%s must be a non-empty string, got %v", annotationKeyKey, v))
+		}
+	}
+	errs = append(errs, validateResourceMap(resourceMapKey, arguments[resourceMapKey])...)
+	for _, key := range []string{fairShareKey, includeUnmanagedPodsKey, dominantResourceKey, preemptOverQuotaKey, minimizeVictimsKey, reclaimOverDeservedKey, groupQuotasKey, inheritNamespaceGroupKey} {
+		if v, found := arguments[key]; found {
+			if _, ok := v.(bool); !ok {
+				errs = append(errs, fmt.Errorf("%s must be a bool, got %v", key, v))
+			}
+		}
+	}
+	errs = append(errs, validateGroupWeights(arguments[groupWeightsKey])...)
+	errs = append(errs, validateMaxInqueueJobs(arguments[maxInqueueJobsKey])...)
+	errs = append(errs, validateNodePools(arguments[allowedNodeSelectorsKey])...)
+	errs = append(errs, validateCountStatuses(arguments[countStatusesKey])...)
+	errs = append(errs, validateIntraGroupOrder(arguments[intraGroupOrderKey])...)
+	if v, found := arguments[defaultMaxInqueueJobsKey]; found {
+		if limit, ok := v.(int); !ok || limit < 0 {
+			errs = append(errs, fmt.Errorf("%s must be a non-negative integer, got %v", defaultMaxInqueueJobsKey, v))
+		}
+	}
+	if _, err := priority.ParseSelector(arguments[exemptPrioritiesKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", exemptPrioritiesKey, err))
+	}
+	if _, err := workloadselector.Parse(arguments[exemptWorkloadsKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", exemptWorkloadsKey, err))
+	}
+	if _, err := runtime.Parse(arguments[maxRunTimeKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", maxRunTimeKey, err))
+	}
+	errs = append(errs, validateWindowedQuota(arguments[windowedQuotaKey])...)
+	errs = append(errs, validateStatusReport(arguments[statusReportKey])...)
+	errs = append(errs, validateRemoteUsage(arguments[remoteUsageKey])...)
+	errs = append(errs, validateBurst(arguments[burstKey])...)
+	errs = append(errs, validatePremiumNodes(arguments[premiumNodeSelectorKey])...)
+	errs = append(errs, validateOffendersReport(arguments[offendersReportKey])...)
+	errs = append(errs, validateUsageDecay(arguments[usageDecayKey], arguments[fairShareKey])...)
+	if _, err := decisionlog.Parse(arguments[decisionLogKey]); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %v", decisionLogKey, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validateResourceMap reports the entries of a resource map that parseResourceMap skips.
+func validateResourceMap(key string, rm interface{}) []error {
+	if rm == nil {
+		return nil
+	}
+	resMap, ok := toStringMap(rm)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", key, rm)}
+	}
+	var errs []error
+	for name, v := range resMap {
+		vStr, ok := v.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s value for %s is not a string", key, name))
+			continue
+		}
+		if _, err := resource.ParseQuantity(vStr); err != nil {
+			errs = append(errs, fmt.Errorf("%s value for %s: %v", key, name, err))
+		}
+	}
+	return errs
+}
+
+// validateGroupWeights reports the entries that parseGroupWeights skips.
+func validateGroupWeights(arg interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", groupWeightsKey, arg)}
+	}
+	var errs []error
+	for group, v := range m {
+		if weight, ok := toFloat64(v); !ok || weight <= 0 {
+			errs = append(errs, fmt.Errorf("%s of group %s must be a positive number, got %v", groupWeightsKey, group, v))
+		}
+	}
+	return errs
+}
+
+// validateMaxInqueueJobs reports the entries that parseMaxInqueueJobs skips.
+func validateMaxInqueueJobs(arg interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", maxInqueueJobsKey, arg)}
+	}
+	var errs []error
+	for group, v := range m {
+		if limit, ok := v.(int); !ok || limit <= 0 {
+			errs = append(errs, fmt.Errorf("%s of group %s must be a positive integer, got %v", maxInqueueJobsKey, group, v))
+		}
+	}
+	return errs
+}
+
+// parseResourceMap parses a map of resource name to quantity string into a ResourceList.
+// Invalid entries are skipped.
+func parseResourceMap(rm interface{}) v1.ResourceList {
+	quota := v1.ResourceList{}
+	if rm == nil {
+		return quota
+	}
+
+	resMap, ok := toStringMap(rm)
+	if !ok {
+		klog.Warningf("groupquota plugin: resourceMap is not a map, got %T", rm)
+		return quota
+	}
+	for k, v := range resMap {
+		vStr, ok := v.(string)
+		if !ok {
+			klog.Warningf("groupquota plugin: resourceMap value for %s is not string, skipping", k)
+			continue
+		}
+		q, err := resource.ParseQuantity(vStr)
+		if err != nil {
+			klog.Errorf("groupquota plugin: failed to parse quantity for %s: %v", k, err)
+			continue
+		}
+		quota[v1.ResourceName(k)] = q
+	}
+	return quota
+}
+
+// parseGroupWeights parses the groupWeights argument, which maps a group name to a
+// positive weight. Invalid entries are skipped.
+func parseGroupWeights(arg interface{}) map[string]float64 {
+	weights := make(map[string]float64)
+	if arg == nil {
+		return weights
+	}
+
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: groupWeights is not a map, got %T", arg)
+		return weights
+	}
+	for group, v := range m {
+		weight, ok := toFloat64(v)
+		if !ok || weight <= 0 {
+			klog.Warningf("groupquota plugin: invalid weight %v for group %s, skipping", v, group)
+			continue
+		}
+		weights[group] = weight
+	}
+	return weights
+}
+
+// parseMaxInqueueJobs parses the maxInqueueJobs argument, which maps a group name to
+// the maximum number of its jobs in Inqueue or Running phase. Invalid entries, including
+// limits of 0, are skipped so that the group gets the default limit.
+func parseMaxInqueueJobs(arg interface{}) map[string]int {
+	limits := make(map[string]int)
+	if arg == nil {
+		return limits
+	}
+
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: maxInqueueJobs is not a map, got %T", arg)
+		return limits
+	}
+	for group, v := range m {
+		limit, ok := v.(int)
+		if !ok || limit <= 0 {
+			klog.Warningf("groupquota plugin: invalid maxInqueueJobs %v for group %s, skipping", v, group)
+			continue
+		}
+		limits[group] = limit
+	}
+	return limits
+}
+
+// maxInqueueJobsOf returns the inqueue job limit of the group, 0 means unlimited.
+func (args *pluginArguments) maxInqueueJobsOf(group string) int {
+	if limit, found := args.maxInqueueJobs[group]; found {
+		return limit
+	}
+	return args.defaultMaxInqueueJobs
+}
+
+// toStringMap normalizes the map types produced by the yaml decoder and by
+// callers building arguments in code into a map keyed by string.
+func toStringMap(arg interface{}) (map[string]interface{}, bool) {
+	switch m := arg.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			kStr, ok := k.(string)
+			if !ok {
+				klog.Warningf("groupquota plugin: map key is not string, skipping %v", k)
+				continue
+			}
+			result[kStr] = v
+		}
+		return result, true
+	case map[string]string:
+		result := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			result[k] = v
+		}
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+func toFloat64(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case float64:
+		return val, true
+	case string:
+		q, err := resource.ParseQuantity(val)
+		if err != nil {
+			return 0, false
+		}
+		return q.AsApproximateFloat64(), true
+	default:
+		return 0, false
+	}
+}
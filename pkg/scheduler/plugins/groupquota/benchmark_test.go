@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/synthetic"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// benchmarkSpec is 5000 running jobs of 2 tasks spread over 500 groups.
+var benchmarkSpec = synthetic.Spec{
+	Namespace:   "ns1",
+	Queue:       "q1",
+	Jobs:        5000,
+	TasksPerJob: 2,
+	Groups:      500,
+	GroupKey:    testGroupKey,
+	Priorities:  10,
+	TaskCPU:     "100m",
+	TaskMemory:  "128Mi",
+	Node:        "node1",
+}
+
+// openBenchmarkSession opens a session of the benchmark jobs, plus one pending job of an
+// idle group, with only the groupquota plugin enabled.
+func openBenchmarkSession(b *testing.B, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	b.Helper()
+
+	podGroups := append(synthetic.PodGroups(benchmarkSpec),
+		util.BuildPodGroupWithAnno("idle", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("idle")))
+	pods := append(synthetic.Pods(benchmarkSpec),
+		util.BuildPod("ns1", "idle-0", "", v1.PodPending, api.BuildResourceList("100m", "128Mi"), "idle", nil, nil))
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      b.Name(),
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("2000", "4000Gi", []api.ScalarResource{{Name: "pods", Value: "20000"}}...), nil),
+		},
+		Queues:   []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		Recorder: &record.FakeRecorder{},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobOrder:    &trueValue,
+			EnabledPreemptable: &trueValue,
+			Arguments:          arguments,
+		}},
+	}}
+	return tc.RegisterSession(tiers, nil), tc
+}
+
+func BenchmarkSessionOpen(b *testing.B) {
+	arguments := framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "1", "memory": "1Gi"},
+		fairShareKey:    true,
+	}
+	ssn, tc := openBenchmarkSession(b, arguments)
+	defer tc.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		New(arguments).OnSessionOpen(ssn)
+	}
+}
+
+func BenchmarkPreemptable(b *testing.B) {
+	arguments := framework.Arguments{
+		"annotationKey":     testGroupKey,
+		"resourceMap":       map[string]interface{}{"cpu": "1"},
+		preemptOverQuotaKey: true,
+	}
+	ssn, tc := openBenchmarkSession(b, arguments)
+	defer tc.Close()
+
+	preemptor := ssn.Jobs["ns1/idle"].Tasks[api.TaskID("ns1-idle-0")]
+	var preemptees []*api.TaskInfo
+	for _, job := range ssn.Jobs {
+		for _, task := range job.TaskStatusIndex[api.Running] {
+			preemptees = append(preemptees, task)
+		}
+	}
+	if len(preemptees) != benchmarkSpec.Jobs*benchmarkSpec.TasksPerJob {
+		b.Fatalf("expected %d preemptees, got %d", benchmarkSpec.Jobs*benchmarkSpec.TasksPerJob, len(preemptees))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if victims := ssn.Preemptable(preemptor, preemptees); len(victims) != len(preemptees) {
+			b.Fatalf("expected every preemptee to be a victim, got %d", len(victims))
+		}
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// burstKey is the section letting a group exceed its quota up to a burst ceiling for a
+	// limited accumulated duration, e.g.
+	//
+	//	burst:
+	//	  limits:
+	//	    cpu: "12"
+	//	  duration: 1h
+	//	  refillPeriod: 24h
+	burstKey = "burst"
+
+	defaultBurstRefillPeriod = 24 * time.Hour
+	defaultBurstNamespace    = "volcano-system"
+	defaultBurstConfigMap    = "groupquota-burst-buckets"
+	burstStateDataKey        = "buckets"
+	burstStatePersistPeriod  = time.Minute
+	burstLimitsKey           = "limits"
+	burstDurationKey         = "duration"
+	burstRefillPeriodKey     = "refillPeriod"
+	burstNamespaceKey        = "configMapNamespace"
+	burstNameKey             = "configMapName"
+)
+
+// burstArguments configures the token bucket of every group, whose tokens are the time the
+// group may still spend over its quota.
+type burstArguments struct {
+	// limits is the ceiling of the usage of a group bursting over its quota.
+	limits     *api.Resource
+	limitNames []v1.ResourceName
+	// duration is the capacity of the bucket, the accumulated time a group may burst.
+	duration time.Duration
+	// refillPeriod is the time a group under its quota takes to refill an empty bucket.
+	refillPeriod time.Duration
+
+	configMapNamespace string
+	configMapName      string
+}
+
+// burstState is the bucket of every group that is not full, persisted in a ConfigMap so
+// that a restart of the scheduler does not grant every group a full burst again.
+type burstState struct {
+	LastUpdate time.Time `json:"lastUpdate"`
+	// Tokens is the burst time left to each group, groups missing have a full bucket.
+	Tokens map[string]time.Duration `json:"tokens"`
+}
+
+// burstTracker is the state of the buckets persisted in one ConfigMap.
+type burstTracker struct {
+	state       *burstState
+	lastPersist time.Time
+}
+
+var (
+	// burstMutex guards burstTrackers, which outlive the plugin instance of one session.
+	burstMutex sync.Mutex
+	// burstTrackers holds the state of the buckets of each ConfigMap, by namespace/name, so
+	// that scheduler configurations persisting to different ConfigMaps do not share buckets.
+	burstTrackers = map[string]*burstTracker{}
+)
+
+func parseBurst(arg interface{}) *burstArguments {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: burst is not a map, got %T", arg)
+		return nil
+	}
+
+	ba := &burstArguments{
+		refillPeriod:       defaultBurstRefillPeriod,
+		configMapNamespace: defaultBurstNamespace,
+		configMapName:      defaultBurstConfigMap,
+	}
+	if v, ok := m[burstDurationKey].(string); ok {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			ba.duration = d
+		}
+	}
+	if ba.duration == 0 {
+		klog.Warningf("groupquota plugin: burst has no valid duration, ignoring it")
+		return nil
+	}
+	if v, ok := m[burstRefillPeriodKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			klog.Errorf("groupquota plugin: invalid burst refillPeriod %q, using default %v", v, defaultBurstRefillPeriod)
+		} else {
+			ba.refillPeriod = d
+		}
+	}
+	if v, ok := m[burstNamespaceKey].(string); ok && v != "" {
+		ba.configMapNamespace = v
+	}
+	if v, ok := m[burstNameKey].(string); ok && v != "" {
+		ba.configMapName = v
+	}
+
+	limits := parseResourceMap(m[burstLimitsKey])
+	if len(limits) == 0 {
+		klog.Warningf("groupquota plugin: burst has no limits, ignoring it")
+		return nil
+	}
+	ba.limits = api.NewResource(limits)
+	for name := range limits {
+		ba.limitNames = append(ba.limitNames, name)
+	}
+	return ba
+}
+
+// validateBurst reports the settings that parseBurst ignores or falls back on.
+func validateBurst(arg interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", burstKey, arg)}
+	}
+
+	var errs []error
+	for _, key := range []string{burstDurationKey, burstRefillPeriodKey} {
+		v, found := m[key]
+		if !found {
+			if key == burstDurationKey {
+				errs = append(errs, fmt.Errorf("%s has no %s", burstKey, key))
+			}
+			continue
+		}
+		if d, err := parseNonNegativeDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %v", burstKey, key, err))
+		} else if d == 0 {
+			errs = append(errs, fmt.Errorf("%s %s must be positive", burstKey, key))
+		}
+	}
+	limits, found := m[burstLimitsKey]
+	if !found {
+		errs = append(errs, fmt.Errorf("%s has no %s", burstKey, burstLimitsKey))
+	} else {
+		errs = append(errs, validateResourceMap(burstKey+" "+burstLimitsKey, limits)...)
+	}
+	return errs
+}
+
+// consumeBurstTokens drains the bucket of the groups over their quota by the time elapsed
+// since the last session, and refills the bucket of the others. It returns the groups over
+// their quota that may keep bursting: those with tokens left and under the burst ceiling.
+func consumeBurstTokens(client kubernetes.Interface, ba *burstArguments, overQuota map[string]bool, groupUsage map[string]*api.Resource) map[string]bool {
+	burstMutex.Lock()
+	defer burstMutex.Unlock()
+
+	current := now()
+	key := ba.configMapNamespace + "/" + ba.configMapName
+	tracker, found := burstTrackers[key]
+	if !found {
+		tracker = &burstTracker{state: loadBurstState(client, ba, current)}
+		burstTrackers[key] = tracker
+	}
+	burst := tracker.state
+
+	elapsed := current.Sub(burst.LastUpdate)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	burst.LastUpdate = current
+	refill := time.Duration(float64(elapsed) * float64(ba.duration) / float64(ba.refillPeriod))
+
+	for group, tokens := range burst.Tokens {
+		if overQuota[group] {
+			continue
+		}
+		if tokens += refill; tokens >= ba.duration {
+			delete(burst.Tokens, group)
+		} else {
+			burst.Tokens[group] = tokens
+		}
+	}
+
+	bursting := make(map[string]bool)
+	for group, over := range overQuota {
+		if !over {
+			continue
+		}
+		tokens, found := burst.Tokens[group]
+		if !found || tokens > ba.duration {
+			tokens = ba.duration
+		}
+		if tokens -= elapsed; tokens < 0 {
+			tokens = 0
+		}
+		burst.Tokens[group] = tokens
+
+		if tokens == 0 {
+			klog.V(4).Infof("groupquota: group %s exhausted its burst duration", group)
+			continue
+		}
+		if usage, found := groupUsage[group]; found && overBurstLimits(usage, ba) {
+			klog.V(4).Infof("groupquota: group %s is over its burst ceiling, usage <%v>", group, usage)
+			continue
+		}
+		bursting[group] = true
+	}
+	return bursting
+}
+
+// overBurstLimits returns whether the usage exceeds the burst ceiling of any resource.
+func overBurstLimits(usage *api.Resource, ba *burstArguments) bool {
+	for _, name := range ba.limitNames {
+		if usage.Get(name) > ba.limits.Get(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadBurstState reads the persisted state, or starts with full buckets if there is none.
+func loadBurstState(client kubernetes.Interface, ba *burstArguments, current time.Time) *burstState {
+	state := &burstState{
+		LastUpdate: current,
+		Tokens:     map[string]time.Duration{},
+	}
+	if client == nil {
+		return state
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(ba.configMapNamespace).Get(context.TODO(), ba.configMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("groupquota: failed to get ConfigMap %s/%s: %v", ba.configMapNamespace, ba.configMapName, err)
+		}
+		return state
+	}
+
+	persisted := &burstState{}
+	if err := json.Unmarshal([]byte(cm.Data[burstStateDataKey]), persisted); err != nil {
+		klog.Errorf("groupquota: failed to decode burst buckets from ConfigMap %s/%s: %v", ba.configMapNamespace, ba.configMapName, err)
+		return state
+	}
+	if persisted.Tokens == nil {
+		persisted.Tokens = map[string]time.Duration{}
+	}
+	return persisted
+}
+
+// persistBurstState writes the state to the ConfigMap, at most once per persist period.
+func persistBurstState(client kubernetes.Interface, ba *burstArguments) {
+	burstMutex.Lock()
+	defer burstMutex.Unlock()
+
+	tracker, found := burstTrackers[ba.configMapNamespace+"/"+ba.configMapName]
+	if !found || client == nil {
+		return
+	}
+	current := now()
+	if current.Sub(tracker.lastPersist) < burstStatePersistPeriod {
+		return
+	}
+
+	data, err := json.Marshal(tracker.state)
+	if err != nil {
+		klog.Errorf("groupquota: failed to encode burst buckets: %v", err)
+		return
+	}
+
+	if err := util.UpsertConfigMapData(client, ba.configMapNamespace, ba.configMapName, burstStateDataKey, string(data)); err != nil {
+		klog.Errorf("groupquota: failed to persist burst buckets to ConfigMap %s/%s: %v", ba.configMapNamespace, ba.configMapName, err)
+		return
+	}
+	tracker.lastPersist = current
+}
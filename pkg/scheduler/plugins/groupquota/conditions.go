@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
+)
+
+const (
+	// GroupQuotaExceededType is the PodGroup condition explaining why the plugin rejects the
+	// enqueue of a job, True while the job is rejected.
+	GroupQuotaExceededType scheduling.PodGroupConditionType = "GroupQuotaExceeded"
+
+	// WindowedQuotaExhaustedReason is the reason of a job whose group consumed its windowed quota.
+	WindowedQuotaExhaustedReason = "WindowedQuotaExhausted"
+	// BorrowingForbiddenReason is the reason of a job whose group is over quota and may not borrow.
+	BorrowingForbiddenReason = "BorrowingForbidden"
+	// InqueueJobsLimitReason is the reason of a job whose group reached its limit of inqueue jobs.
+	InqueueJobsLimitReason = "InqueueJobsLimitReached"
+	// WithinQuotaReason is the reason of a job no longer rejected.
+	WithinQuotaReason = "WithinQuota"
+)
+
+// rejectionReasons are the condition reasons of the rules rejecting the enqueue of a job.
+var rejectionReasons = map[string]string{
+	windowedQuotaKey:  WindowedQuotaExhaustedReason,
+	groupQuotasKey:    BorrowingForbiddenReason,
+	maxInqueueJobsKey: InqueueJobsLimitReason,
+}
+
+// quotaUsage describes the usage of the limited resources of the group against its quota,
+// the resources at or over their limit first, e.g. "cpu usage 5 of limit 4".
+func (gp *groupquotaPlugin) quotaUsage(group string) string {
+	quota, names := gp.quotaOf(group)
+	usage, found := gp.groupUsage[group]
+	if !found {
+		usage = api.EmptyResource()
+	}
+
+	sorted := append([]v1.ResourceName(nil), names...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		iOver := usage.Get(sorted[i]) >= quota.Get(sorted[i])
+		jOver := usage.Get(sorted[j]) >= quota.Get(sorted[j])
+		if iOver != jOver {
+			return iOver
+		}
+		return sorted[i] < sorted[j]
+	})
+	parts := make([]string, 0, len(sorted))
+	for _, name := range sorted {
+		used, limit := resourceconv.Quantity(name, usage.Get(name)), resourceconv.Quantity(name, quota.Get(name))
+		parts = append(parts, fmt.Sprintf("%s usage %s of limit %s", name, used.String(), limit.String()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// recordRejection sets the GroupQuotaExceeded condition of the rejected job, keeping its
+// transition time if it was already rejected.
+func (gp *groupquotaPlugin) recordRejection(ssn *framework.Session, job *api.JobInfo, rule, msg string) {
+	gp.updateCondition(ssn, job, &scheduling.PodGroupCondition{
+		Type:    GroupQuotaExceededType,
+		Status:  v1.ConditionTrue,
+		Reason:  rejectionReasons[rule],
+		Message: msg,
+	})
+}
+
+// clearRejection sets the GroupQuotaExceeded condition of a job no longer rejected to False.
+// Jobs never rejected do not get the condition.
+func (gp *groupquotaPlugin) clearRejection(ssn *framework.Session, job *api.JobInfo) {
+	if job.PodGroup == nil {
+		return
+	}
+	for _, c := range job.PodGroup.Status.Conditions {
+		if c.Type == GroupQuotaExceededType && c.Status == v1.ConditionTrue {
+			gp.updateCondition(ssn, job, &scheduling.PodGroupCondition{
+				Type:    GroupQuotaExceededType,
+				Status:  v1.ConditionFalse,
+				Reason:  WithinQuotaReason,
+				Message: "the group quota no longer rejects the job",
+			})
+			return
+		}
+	}
+}
+
+func (gp *groupquotaPlugin) updateCondition(ssn *framework.Session, job *api.JobInfo, cond *scheduling.PodGroupCondition) {
+	if job.PodGroup == nil {
+		return
+	}
+	cond.LastTransitionTime = metav1.Now()
+	cond.TransitionID = string(ssn.UID)
+	for _, c := range job.PodGroup.Status.Conditions {
+		if c.Type == cond.Type && c.Status == cond.Status {
+			cond.LastTransitionTime = c.LastTransitionTime
+		}
+	}
+	if err := ssn.UpdatePodGroupCondition(job, cond); err != nil {
+		klog.Errorf("groupquota: failed to update condition of job <%s/%s>: %v", job.Namespace, job.Name, err)
+	}
+}
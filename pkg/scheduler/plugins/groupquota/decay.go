@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// usageDecayKey is the section ordering the groups in fairShare mode by their historical
+	// usage, decayed exponentially, instead of their current usage.
+	usageDecayKey = "usageDecay"
+
+	defaultHalfLife            = 24 * time.Hour
+	defaultHistoryNamespace    = "volcano-system"
+	defaultHistoryConfigMap    = "groupquota-usage-history"
+	historyStateDataKey        = "history"
+	historyStatePersistPeriod  = time.Minute
+	usageDecayHalfLifeKey      = "halfLife"
+	usageDecayConfigMapNsKey   = "configMapNamespace"
+	usageDecayConfigMapNameKey = "configMapName"
+	negligibleHistoricalUsage  = 1.0
+)
+
+// usageDecayArguments configures the decay of the historical usage of the groups.
+type usageDecayArguments struct {
+	// halfLife is the time after which past usage counts for half of its weight.
+	halfLife time.Duration
+
+	configMapNamespace string
+	configMapName      string
+}
+
+// historyState is the decayed historical usage of every group, persisted in a ConfigMap so
+// that it survives scheduler restarts.
+type historyState struct {
+	LastUpdate time.Time `json:"lastUpdate"`
+	// Usage is the decayed average usage of each group, in api.Resource units.
+	Usage map[string]map[v1.ResourceName]float64 `json:"usage"`
+}
+
+// historyTracker is the state of the history persisted in one ConfigMap.
+type historyTracker struct {
+	state       *historyState
+	lastPersist time.Time
+}
+
+var (
+	// historyMutex guards historyTrackers, which outlive the plugin instance of one session.
+	historyMutex sync.Mutex
+	// historyTrackers holds the state of the history of each ConfigMap, by namespace/name, so
+	// that scheduler configurations persisting to different ConfigMaps do not share history.
+	historyTrackers = map[string]*historyTracker{}
+)
+
+func parseUsageDecay(arg interface{}) *usageDecayArguments {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: usageDecay is not a map, got %T", arg)
+		return nil
+	}
+
+	ud := &usageDecayArguments{
+		halfLife:           defaultHalfLife,
+		configMapNamespace: defaultHistoryNamespace,
+		configMapName:      defaultHistoryConfigMap,
+	}
+	if v, ok := m[usageDecayHalfLifeKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			klog.Errorf("groupquota plugin: invalid usageDecay halfLife %q, using default %v", v, defaultHalfLife)
+		} else {
+			ud.halfLife = d
+		}
+	}
+	if v, ok := m[usageDecayConfigMapNsKey].(string); ok && v != "" {
+		ud.configMapNamespace = v
+	}
+	if v, ok := m[usageDecayConfigMapNameKey].(string); ok && v != "" {
+		ud.configMapName = v
+	}
+	return ud
+}
+
+// validateUsageDecay reports the settings that parseUsageDecay ignores or falls back on.
+func validateUsageDecay(arg interface{}, fairShare interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", usageDecayKey, arg)}
+	}
+
+	var errs []error
+	if enabled, _ := fairShare.(bool); !enabled {
+		errs = append(errs, fmt.Errorf("%s requires %s", usageDecayKey, fairShareKey))
+	}
+	if v, found := m[usageDecayHalfLifeKey]; found {
+		if d, err := parseNonNegativeDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %v", usageDecayKey, usageDecayHalfLifeKey, err))
+		} else if d == 0 {
+			errs = append(errs, fmt.Errorf("%s %s must be positive", usageDecayKey, usageDecayHalfLifeKey))
+		}
+	}
+	return errs
+}
+
+// decayHistoricalUsage folds the current usage of every group into its historical usage,
+// weighting the history by the time elapsed since the last session, and returns the
+// historical usage of every group.
+func decayHistoricalUsage(client kubernetes.Interface, ud *usageDecayArguments, groupUsage map[string]*api.Resource) map[string]*api.Resource {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	current := now()
+	key := ud.configMapNamespace + "/" + ud.configMapName
+	tracker, found := historyTrackers[key]
+	if !found {
+		tracker = &historyTracker{state: loadHistoryState(client, ud, current)}
+		historyTrackers[key] = tracker
+	}
+	history := tracker.state
+
+	elapsed := current.Sub(history.LastUpdate)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	history.LastUpdate = current
+	// keep is the weight of the history after the elapsed time, the current usage
+	// accounts for the rest.
+	keep := math.Exp2(-float64(elapsed) / float64(ud.halfLife))
+
+	for group, past := range history.Usage {
+		usage, found := groupUsage[group]
+		negligible := true
+		for name, value := range past {
+			used := 0.0
+			if found {
+				used = usage.Get(name)
+			}
+			past[name] = value*keep + used*(1-keep)
+			if past[name] >= negligibleHistoricalUsage {
+				negligible = false
+			}
+		}
+		if negligible && !found {
+			delete(history.Usage, group)
+		}
+	}
+	for group, usage := range groupUsage {
+		past, found := history.Usage[group]
+		if !found {
+			// The first usage seen of a group is its history.
+			past = map[v1.ResourceName]float64{}
+			for _, name := range usage.ResourceNames() {
+				past[name] = usage.Get(name)
+			}
+			history.Usage[group] = past
+			continue
+		}
+		for _, name := range usage.ResourceNames() {
+			if _, seen := past[name]; !seen {
+				past[name] = usage.Get(name) * (1 - keep)
+			}
+		}
+	}
+
+	historical := make(map[string]*api.Resource, len(history.Usage))
+	for group, past := range history.Usage {
+		r := api.EmptyResource()
+		for name, value := range past {
+			resourceconv.Set(r, name, value)
+		}
+		historical[group] = r
+	}
+	return historical
+}
+
+// loadHistoryState reads the persisted state, or starts an empty history if there is none.
+func loadHistoryState(client kubernetes.Interface, ud *usageDecayArguments, current time.Time) *historyState {
+	state := &historyState{
+		LastUpdate: current,
+		Usage:      map[string]map[v1.ResourceName]float64{},
+	}
+	if client == nil {
+		return state
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(ud.configMapNamespace).Get(context.TODO(), ud.configMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("groupquota: failed to get ConfigMap %s/%s: %v", ud.configMapNamespace, ud.configMapName, err)
+		}
+		return state
+	}
+
+	persisted := &historyState{}
+	if err := json.Unmarshal([]byte(cm.Data[historyStateDataKey]), persisted); err != nil {
+		klog.Errorf("groupquota: failed to decode usage history from ConfigMap %s/%s: %v", ud.configMapNamespace, ud.configMapName, err)
+		return state
+	}
+	if persisted.Usage == nil {
+		persisted.Usage = map[string]map[v1.ResourceName]float64{}
+	}
+	return persisted
+}
+
+// persistHistoryState writes the state to the ConfigMap, at most once per persist period.
+func persistHistoryState(client kubernetes.Interface, ud *usageDecayArguments) {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	tracker, found := historyTrackers[ud.configMapNamespace+"/"+ud.configMapName]
+	if !found || client == nil {
+		return
+	}
+	current := now()
+	if current.Sub(tracker.lastPersist) < historyStatePersistPeriod {
+		return
+	}
+
+	data, err := json.Marshal(tracker.state)
+	if err != nil {
+		klog.Errorf("groupquota: failed to encode usage history: %v", err)
+		return
+	}
+
+	if err := util.UpsertConfigMapData(client, ud.configMapNamespace, ud.configMapName, historyStateDataKey, string(data)); err != nil {
+		klog.Errorf("groupquota: failed to persist usage history to ConfigMap %s/%s: %v", ud.configMapNamespace, ud.configMapName, err)
+		return
+	}
+	tracker.lastPersist = current
+}
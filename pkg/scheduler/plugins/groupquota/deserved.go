@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// groupDeserved returns the deserved resources of every group with usage, i.e. its quota,
+// as the queues have theirs in the proportion plugin.
+func (gp *groupquotaPlugin) groupDeserved() map[string]*api.Resource {
+	deserved := make(map[string]*api.Resource, len(gp.groupUsage))
+	for group := range gp.groupUsage {
+		quota, _ := gp.quotaOf(group)
+		deserved[group] = quota.Clone()
+	}
+	return deserved
+}
+
+// isGroupOverDeserved is the overused check of a group: whether its usage exceeds its
+// deserved resources. Unlike overQuotaGroups, it ignores burst and windowed quota, so
+// that a bursting group is still reclaimed down to its deserved share.
+func (gp *groupquotaPlugin) isGroupOverDeserved(group string) bool {
+	usage, found := gp.groupUsage[group]
+	return found && gp.exceedsDeserved(group, usage)
+}
+
+// exceedsDeserved returns whether the usage exceeds the deserved resources of the group
+// on any limited resource. Unlike isOverQuota, a group using exactly its deserved
+// resources is not over them, as a queue in the proportion plugin.
+func (gp *groupquotaPlugin) exceedsDeserved(group string, usage *api.Resource) bool {
+	quota, names := gp.quotaOf(group)
+	for _, name := range names {
+		if usage.Get(name) > quota.Get(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// overDeservedVictims returns the reclaimees of the groups over their deserved resources,
+// as long as their group stays over it once the previous victims are evicted, so that a
+// group is never reclaimed below its deserved share. The reclaimer must belong to a group
+// that is not over its deserved resources, and never reclaims from its own group.
+func (gp *groupquotaPlugin) overDeservedVictims(ssn *framework.Session, reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) []*api.TaskInfo {
+	reclaimerJob, found := ssn.Jobs[reclaimer.Job]
+	if !found {
+		return nil
+	}
+	reclaimerGroup := gp.jobGroup(reclaimerJob)
+	if reclaimerGroup == "" || gp.isGroupOverDeserved(reclaimerGroup) {
+		return nil
+	}
+
+	var victims []*api.TaskInfo
+	allocations := map[string]*api.Resource{}
+	for _, reclaimee := range reclaimees {
+		job, found := ssn.Jobs[reclaimee.Job]
+		if !found || gp.isJobExempt(job) {
+			continue
+		}
+		group := gp.jobGroup(job)
+		if group == "" || group == reclaimerGroup {
+			continue
+		}
+		if _, found := allocations[group]; !found {
+			usage, found := gp.groupUsage[group]
+			if !found {
+				continue
+			}
+			allocations[group] = usage.Clone()
+		}
+		if gp.exceedsDeserved(group, allocations[group]) {
+			// The usage may not account the reclaimee, e.g. with countStatuses, so it is
+			// clamped at zero instead of asserting.
+			allocations[group] = api.ExceededPart(allocations[group], reclaimee.Resreq)
+			victims = append(victims, reclaimee)
+			gp.decisions.Log(job, reclaimOverDeservedKey, "reclaim", map[string]interface{}{
+				"group":     group,
+				"task":      reclaimee.Name,
+				"reclaimer": reclaimer.Namespace + "/" + reclaimer.Name,
+			})
+		}
+	}
+	if len(victims) > 0 {
+		klog.V(4).Infof("groupquota: victims of groups over their deserved resources for reclaimer <%s/%s> of group %s: %d",
+			reclaimer.Namespace, reclaimer.Name, reclaimerGroup, len(victims))
+	}
+	return victims
+}
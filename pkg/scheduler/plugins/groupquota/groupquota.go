@@ -17,20 +17,81 @@ limitations under the License.
 package groupquota
 
 import (
+	"fmt"
+	"sort"
+
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota/accounting"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/decisionlog"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
 )
 
 // PluginName indicates name of volcano scheduler plugin.
 const PluginName = "groupquota"
 
+const (
+	// GroupUsageDataKey is the session plugin data holding the usage of each group
+	// at session open, as a map[string]*api.Resource.
+	GroupUsageDataKey = "groupquota/groupUsage"
+	// OverQuotaGroupsDataKey is the session plugin data holding the groups over quota,
+	// as a map[string]bool.
+	OverQuotaGroupsDataKey = "groupquota/overQuotaGroups"
+	// GroupDeservedDataKey is the session plugin data holding the deserved resources of
+	// each group with usage, i.e. its quota, as a map[string]*api.Resource.
+	GroupDeservedDataKey = "groupquota/groupDeserved"
+
+	// QueueOptOutAnnotationKey is the Queue annotation opting the jobs of the queue out of
+	// the plugin when set to QueueOptOutDisabled.
+	QueueOptOutAnnotationKey = "scheduling.volcano.sh/groupquota"
+	// QueueOptOutDisabled is the value of QueueOptOutAnnotationKey disabling the plugin.
+	QueueOptOutDisabled = "disabled"
+)
+
 type groupquotaPlugin struct {
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
+
+	args *pluginArguments
+
+	// groupUsage is the resource usage of each group in the current session, including
+	// the usage of sibling clusters with remoteUsage.
+	groupUsage map[string]*api.Resource
+	// remoteUsage is the part of groupUsage reported by sibling clusters.
+	remoteUsage map[string]*api.Resource
+	// overQuotaGroups contains the groups exceeding their quota in the current session.
+	overQuotaGroups map[string]bool
+	// groupRatios is the usage-to-quota ratio of each group.
+	groupRatios map[string]float64
+	// groupShares is the weighted usage-to-quota ratio of each group, only set in fairShare mode.
+	groupShares map[string]float64
+	// exhaustedGroups contains the groups that consumed their windowed quota budget.
+	exhaustedGroups map[string]bool
+	// deprioritizedJobs is the number of pending jobs of each over-quota group.
+	deprioritizedJobs map[string]int
+	// rejectedJobs contains the jobs of each group rejected by the plugin in the current session.
+	rejectedJobs map[string]sets.Set[api.JobID]
+	// inqueueJobs is the number of jobs in Inqueue or Running phase of each group,
+	// including the jobs enqueued in the current session.
+	inqueueJobs map[string]int
+	// groupLimits is the quota of the groups with a GroupQuota object, only set when
+	// groupQuotas is enabled.
+	groupLimits map[string]*groupLimit
+	// optedOutQueues contains the queues whose jobs are neither accounted nor governed
+	// by the plugin in the current session.
+	optedOutQueues sets.Set[api.QueueID]
+	// decisions is nil unless decisionLog is configured.
+	decisions *decisionlog.Logger
+	// namespaceGroups is nil unless inheritNamespaceGroup is enabled.
+	namespaceGroups *namespaceGroups
 }
 
 // New return groupquota plugin
@@ -43,79 +104,133 @@ func (gp *groupquotaPlugin) Name() string {
 }
 
 func (gp *groupquotaPlugin) OnSessionOpen(ssn *framework.Session) {
-	annotationKey := "example.com/group"
-	if arg, ok := gp.pluginArguments["annotationKey"]; ok {
-		if val, ok := arg.(string); ok {
-			annotationKey = val
-		}
-	} else {
-		klog.Warningf("groupquota plugin: annotationKey argument not provided, using default %s", annotationKey)
-	}
-
-	quota := v1.ResourceList{}
-	if rm, ok := gp.pluginArguments["resourceMap"]; ok {
-		if resMap, ok := rm.(map[interface{}]interface{}); ok {
-			for k, v := range resMap {
-				kStr, okK := k.(string)
-				vStr, okV := v.(string)
-				if !okK || !okV {
-					klog.Warningf("groupquota plugin: resourceMap key/value is not string, skipping %v: %v", k, v)
-					continue
-				}
-				q, err := resource.ParseQuantity(vStr)
-				if err != nil {
-					klog.Errorf("groupquota plugin: failed to parse quantity for %s: %v", kStr, err)
-					continue
-				}
-				quota[v1.ResourceName(kStr)] = q
-			}
-		} else if resMap, ok := rm.(map[string]interface{}); ok {
-			for k, v := range resMap {
-				vStr, ok := v.(string)
-				if !ok {
-					klog.Warningf("groupquota plugin: resourceMap value for %s is not string, skipping", k)
-					continue
-				}
-				q, err := resource.ParseQuantity(vStr)
-				if err != nil {
-					klog.Errorf("groupquota plugin: failed to parse quantity for %s: %v", k, err)
-					continue
-				}
-				quota[v1.ResourceName(k)] = q
-			}
-		} else {
-			klog.Warningf("groupquota plugin: resourceMap is not a map, got %T", rm)
+	gp.args = parseArguments(gp.pluginArguments)
+	gp.args.exemptPriorities = gp.args.exemptPriorities.Resolve(ssn.PriorityClasses)
+	gp.args.exemptWorkloads = gp.args.exemptWorkloads.Resolve(ssn.PriorityClasses)
+	if gp.args.maxRunTime != nil {
+		gp.args.maxRunTime = gp.args.maxRunTime.WithNamespaces(ssn.InformerFactory())
+	}
+	gp.groupUsage = make(map[string]*api.Resource)
+	gp.overQuotaGroups = make(map[string]bool)
+	gp.groupRatios = make(map[string]float64)
+	gp.groupShares = make(map[string]float64)
+	gp.exhaustedGroups = make(map[string]bool)
+	gp.inqueueJobs = make(map[string]int)
+	gp.deprioritizedJobs = make(map[string]int)
+	gp.rejectedJobs = make(map[string]sets.Set[api.JobID])
+	gp.groupLimits = nil
+	gp.optedOutQueues = sets.New[api.QueueID]()
+	gp.decisions = decisionlog.New(PluginName, gp.args.decisionLog)
+	gp.namespaceGroups = nil
+	if gp.args.inheritNamespaceGroup {
+		gp.namespaceGroups = newNamespaceGroups(ssn.InformerFactory(), gp.args.annotationKey)
+	}
+	for _, queue := range ssn.Queues {
+		if queue.Queue != nil && queue.Queue.Annotations[QueueOptOutAnnotationKey] == QueueOptOutDisabled {
+			klog.V(4).Infof("groupquota: queue %s opted out", queue.Name)
+			gp.optedOutQueues.Insert(queue.UID)
 		}
 	}
 
-	groupUsage := make(map[string]v1.ResourceList)
-	overQuotaGroups := make(map[string]bool)
+	if gp.args.groupQuotas {
+		gp.loadGroupLimits(ssn)
+	}
 
 	for _, job := range ssn.Jobs {
-		if !isJobAllocated(job) {
+		groupName := gp.jobGroup(job)
+		if groupName == "" {
 			continue
 		}
 
-		if job.PodGroup == nil || job.PodGroup.Annotations == nil {
-			continue
+		if isJobInqueue(job) {
+			gp.inqueueJobs[groupName]++
 		}
 
-		groupName, found := job.PodGroup.Annotations[annotationKey]
-		if !found {
-			continue
+		gp.usageOf(groupName).Add(gp.jobUsage(job))
+	}
+
+	if gp.args.includeUnmanagedPods {
+		gp.addUnmanagedPodsUsage(ssn)
+	}
+
+	if gp.args.remoteUsage != nil {
+		gp.remoteUsage = fetchRemoteUsage(gp.args.remoteUsage)
+		for group, usage := range gp.remoteUsage {
+			gp.usageOf(group).Add(usage)
 		}
+	}
 
-		if _, ok := groupUsage[groupName]; !ok {
-			groupUsage[groupName] = v1.ResourceList{}
+	if gp.args.windowedQuota != nil {
+		gp.exhaustedGroups = accumulateWindowedUsage(ssn.KubeClient(), gp.args.windowedQuota, gp.groupUsage)
+		for group := range gp.exhaustedGroups {
+			gp.overQuotaGroups[group] = true
 		}
+	}
+
+	// In fairShare mode with usageDecay, the groups are ordered by their historical usage,
+	// so that a group which dominated the cluster in the past is not ordered first again
+	// as soon as its jobs complete.
+	shareUsage := gp.groupUsage
+	if gp.args.fairShare && gp.args.usageDecay != nil {
+		shareUsage = decayHistoricalUsage(ssn.KubeClient(), gp.args.usageDecay, gp.groupUsage)
+	}
+
+	overQuota := make(map[string]bool)
+	for group, usage := range gp.groupUsage {
+		quota, names := gp.quotaOf(group)
+		overQuota[group] = gp.isOverQuota(usage, quota, names, ssn.TotalResource)
+	}
+	// With burst, a group over its quota is only treated as such once it exhausted its
+	// burst duration or crossed the burst ceiling.
+	var bursting map[string]bool
+	if gp.args.burst != nil {
+		bursting = consumeBurstTokens(ssn.KubeClient(), gp.args.burst, overQuota, gp.groupUsage)
+	}
 
-		addResourceList(groupUsage[groupName], job.Allocated)
+	metrics.ResetGroupQuotaGauges()
+	for group, usage := range gp.groupUsage {
+		quota, names := gp.quotaOf(group)
+		if bursting[group] {
+			klog.V(4).Infof("groupquota: group %s bursts over quota, usage <%v>, quota <%v>", group, usage, quota)
+		} else if overQuota[group] {
+			gp.overQuotaGroups[group] = true
+			klog.V(4).Infof("groupquota: group %s is over quota, usage <%v>, quota <%v>", group, usage, quota)
+		}
+		gp.groupRatios[group] = calculateShare(usage, quota, names)
+		if gp.args.fairShare {
+			share := gp.groupRatios[group]
+			if past, found := shareUsage[group]; found {
+				share = calculateShare(past, quota, names)
+			}
+			gp.groupShares[group] = share / getGroupWeight(gp.args.groupWeights, group)
+			klog.V(4).Infof("groupquota: group %s has weighted share %f", group, gp.groupShares[group])
+		}
+		metrics.UpdateGroupQuotaUsage(group, toMetricValues(usage, append(usage.ResourceNames(), names...)))
+		metrics.UpdateGroupQuotaQuota(group, toMetricValues(quota, names))
+		metrics.UpdateGroupQuotaShare(group, gp.groupRatios[group])
 	}
 
-	for group, usage := range groupUsage {
-		if isOverQuota(usage, quota) {
-			overQuotaGroups[group] = true
-			klog.V(4).Infof("groupquota: group %s is over quota", group)
+	for key, value := range map[string]interface{}{
+		GroupUsageDataKey:      gp.groupUsage,
+		OverQuotaGroupsDataKey: gp.overQuotaGroups,
+		GroupDeservedDataKey:   gp.groupDeserved(),
+	} {
+		if err := ssn.SetPluginData(gp.Name(), key, value); err != nil {
+			klog.Errorf("groupquota: failed to publish %s: %v", key, err)
+		}
+	}
+
+	// Jobs still waiting for resources in an over-quota group are ordered
+	// behind the other groups in this session.
+	for _, job := range ssn.Jobs {
+		group := gp.jobGroup(job)
+		if gp.isJobOverQuota(job) && len(job.TaskStatusIndex[api.Pending]) > 0 {
+			gp.deprioritizedJobs[group]++
+			metrics.RegisterGroupQuotaJobDecision(group, metrics.GroupQuotaDeprioritized)
+			gp.decisions.Log(job, "overQuota", metrics.GroupQuotaDeprioritized, map[string]interface{}{
+				"group": group,
+				"ratio": gp.groupRatios[group],
+			})
 		}
 	}
 
@@ -123,11 +238,11 @@ func (gp *groupquotaPlugin) OnSessionOpen(ssn *framework.Session) {
 		lv := l.(*api.JobInfo)
 		rv := r.(*api.JobInfo)
 
-		lGroup := getJobGroup(lv, annotationKey)
-		rGroup := getJobGroup(rv, annotationKey)
+		lGroup := gp.jobGroup(lv)
+		rGroup := gp.jobGroup(rv)
 
-		lOver := overQuotaGroups[lGroup]
-		rOver := overQuotaGroups[rGroup]
+		lOver := gp.isJobOverQuota(lv)
+		rOver := gp.isJobOverQuota(rv)
 
 		if lOver && !rOver {
 			return 1 // r > l (r has higher priority)
@@ -136,67 +251,502 @@ func (gp *groupquotaPlugin) OnSessionOpen(ssn *framework.Session) {
 			return -1 // l > r (l has higher priority)
 		}
 
-		return 0
+		// Among over-quota groups, the least over-quota group goes first, so that
+		// the most over-quota group is the first to give its resources back.
+		if lOver && rOver && lGroup != rGroup {
+			lRatio := gp.groupRatios[lGroup]
+			rRatio := gp.groupRatios[rGroup]
+			if lRatio < rRatio {
+				return -1
+			}
+			if lRatio > rRatio {
+				return 1
+			}
+		}
+
+		// In fairShare mode, the group with the lower weighted usage-to-quota
+		// ratio goes first. Groups without usage have a share of 0, and so do
+		// the jobs without group and the exempt jobs, which are not ordered by
+		// the share of their group: comparing them to every other job, even of
+		// the same group, keeps the order transitive.
+		if gp.args.fairShare {
+			lShare := gp.fairShareOf(lv, lGroup)
+			rShare := gp.fairShareOf(rv, rGroup)
+			if lShare < rShare {
+				return -1
+			}
+			if lShare > rShare {
+				return 1
+			}
+		}
+
+		return gp.compareIntraGroup(lGroup, lv, rGroup, rv)
 	}
 
 	ssn.AddJobOrderFn(gp.Name(), jobOrderFn)
+
+	if gp.args.preemptOverQuota {
+		preemptableFn := func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			preemptorJob, found := ssn.Jobs[preemptor.Job]
+			if !found {
+				return nil, util.Abstain
+			}
+			preemptorGroup := gp.jobGroup(preemptorJob)
+			if preemptorGroup == "" || gp.isJobOverQuota(preemptorJob) {
+				return nil, util.Abstain
+			}
+
+			var victims []*api.TaskInfo
+			for _, preemptee := range preemptees {
+				preempteeJob, found := ssn.Jobs[preemptee.Job]
+				if !found {
+					continue
+				}
+				group := gp.jobGroup(preempteeJob)
+				if group == preemptorGroup || !gp.isJobOverQuota(preempteeJob) {
+					continue
+				}
+				victims = append(victims, preemptee)
+			}
+			if len(victims) == 0 {
+				return nil, util.Abstain
+			}
+
+			// Prefer victims of the most over-quota group, then the lowest priority tasks,
+			// then the largest tasks so that fewer victims are needed.
+			sort.SliceStable(victims, func(i, j int) bool {
+				iRatio := gp.groupRatios[gp.jobGroup(ssn.Jobs[victims[i].Job])]
+				jRatio := gp.groupRatios[gp.jobGroup(ssn.Jobs[victims[j].Job])]
+				if iRatio != jRatio {
+					return iRatio > jRatio
+				}
+				if victims[i].Priority != victims[j].Priority {
+					return victims[i].Priority < victims[j].Priority
+				}
+				return victims[j].Resreq.Less(victims[i].Resreq, api.Zero)
+			})
+			if gp.args.minimizeVictims {
+				victims = minimalVictims(preemptor, victims, ssn.Nodes)
+			}
+
+			klog.V(4).Infof("groupquota: victims of over-quota groups for preemptor <%s/%s> of group %s: %d",
+				preemptor.Namespace, preemptor.Name, preemptorGroup, len(victims))
+			gp.decisions.Log(preemptorJob, preemptOverQuotaKey, "preempt", map[string]interface{}{
+				"group":   preemptorGroup,
+				"task":    preemptor.Name,
+				"victims": taskNames(victims),
+			})
+			return victims, util.Permit
+		}
+		ssn.AddPreemptableFn(gp.Name(), preemptableFn)
+	}
+
+	if gp.args.maxRunTime != nil || gp.args.reclaimOverDeserved {
+		// Tasks that overran their maximum run time, and with reclaimOverDeserved the tasks
+		// of the groups over their deserved resources, give their resources back first.
+		reclaimableFn := func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			var victims []*api.TaskInfo
+			if gp.args.maxRunTime != nil {
+				victims = gp.overRunTimeVictims(ssn, reclaimer, reclaimees)
+			}
+			if gp.args.reclaimOverDeserved {
+				victims = appendMissingTasks(victims, gp.overDeservedVictims(ssn, reclaimer, reclaimees))
+			}
+			if len(victims) == 0 {
+				return nil, util.Abstain
+			}
+			return victims, util.Permit
+		}
+		ssn.AddReclaimableFn(gp.Name(), reclaimableFn)
+	}
+
+	if len(gp.args.nodePools) > 0 || gp.args.premiumNodes != nil {
+		ssn.AddPredicateFn(gp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			job, found := ssn.Jobs[task.Job]
+			if !found {
+				return nil
+			}
+			if err := gp.nodePoolPredicate(job, task, node); err != nil {
+				return err
+			}
+			return gp.premiumNodePredicate(job, task, node)
+		})
+		ssn.AddNodeOrderFn(gp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+			job, found := ssn.Jobs[task.Job]
+			if !found {
+				return 0, nil
+			}
+			return gp.nodePoolScore(job, node) + gp.premiumNodeScore(job, node), nil
+		})
+	}
+
+	enforceWindow := gp.args.windowedQuota != nil && gp.args.windowedQuota.enforce
+	if enforceWindow || len(gp.args.maxInqueueJobs) > 0 || gp.args.defaultMaxInqueueJobs > 0 || gp.forbidsBorrowing() {
+		jobEnqueueableFn := func(obj interface{}) int {
+			job := obj.(*api.JobInfo)
+			group := gp.jobGroup(job)
+			if group == "" || gp.isJobExempt(job) {
+				gp.clearRejection(ssn, job)
+				return util.Abstain
+			}
+
+			var rule, msg string
+			if enforceWindow && gp.exhaustedGroups[group] {
+				rule, msg = windowedQuotaKey, fmt.Sprintf("group %s exhausted its windowed quota", group)
+			} else if gp.overQuotaGroups[group] && gp.borrowingOf(group) == schedulingv1beta1.BorrowingPolicyNever {
+				rule, msg = groupQuotasKey, fmt.Sprintf("group %s is over quota and its GroupQuota forbids borrowing: %s", group, gp.quotaUsage(group))
+			} else if limit := gp.args.maxInqueueJobsOf(group); limit > 0 && gp.inqueueJobs[group] >= limit {
+				rule, msg = maxInqueueJobsKey, fmt.Sprintf("group %s reached its limit of %d inqueue jobs", group, limit)
+			}
+			if msg == "" {
+				gp.clearRejection(ssn, job)
+				return util.Abstain
+			}
+
+			klog.V(3).Infof("groupquota: reject enqueue of job <%s/%s>: %s", job.Namespace, job.Name, msg)
+			ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, string(scheduling.PodGroupUnschedulableType), msg)
+			gp.recordRejection(ssn, job, rule, msg)
+			if gp.rejectedJobs[group] == nil {
+				gp.rejectedJobs[group] = sets.New[api.JobID]()
+			}
+			gp.rejectedJobs[group].Insert(job.UID)
+			metrics.RegisterGroupQuotaJobDecision(group, metrics.GroupQuotaRejected)
+			gp.decisions.Log(job, rule, metrics.GroupQuotaRejected, map[string]interface{}{
+				"group":       group,
+				"inqueueJobs": gp.inqueueJobs[group],
+				"message":     msg,
+			})
+			return util.Reject
+		}
+		ssn.AddJobEnqueueableFn(gp.Name(), jobEnqueueableFn)
+
+		jobEnqueuedFn := func(obj interface{}) {
+			job := obj.(*api.JobInfo)
+			if group := gp.jobGroup(job); group != "" {
+				gp.inqueueJobs[group]++
+			}
+		}
+		ssn.AddJobEnqueuedFn(gp.Name(), jobEnqueuedFn)
+	}
 }
 
-func (gp *groupquotaPlugin) OnSessionClose(ssn *framework.Session) {}
+func (gp *groupquotaPlugin) OnSessionClose(ssn *framework.Session) {
+	if gp.args != nil && gp.args.windowedQuota != nil {
+		persistWindowState(ssn.KubeClient(), gp.args.windowedQuota)
+	}
+	if gp.args != nil && gp.args.burst != nil {
+		persistBurstState(ssn.KubeClient(), gp.args.burst)
+	}
+	if gp.args != nil && gp.args.fairShare && gp.args.usageDecay != nil {
+		persistHistoryState(ssn.KubeClient(), gp.args.usageDecay)
+	}
+	if gp.args != nil && gp.args.statusReport != nil {
+		writeStatusReport(ssn.KubeClient(), gp.args.statusReport, gp.buildStatusReport(ssn.Jobs))
+	}
+	if gp.args != nil && gp.args.offendersReport != nil {
+		gp.reportOffenders(ssn)
+	}
 
-// Helper functions
+	gp.groupUsage = nil
+	gp.remoteUsage = nil
+	gp.overQuotaGroups = nil
+	gp.groupRatios = nil
+	gp.groupShares = nil
+	gp.exhaustedGroups = nil
+	gp.inqueueJobs = nil
+	gp.deprioritizedJobs = nil
+	gp.rejectedJobs = nil
+	gp.groupLimits = nil
+	gp.optedOutQueues = nil
+	gp.decisions = nil
+	gp.namespaceGroups = nil
+}
 
-func isJobAllocated(job *api.JobInfo) bool {
-	// Check if job has any allocated resources/tasks.
-	// In volcano, if a job is in Running or partially allocated state, it holds resources.
-	// We check job.Allocated which is maintained by volcano.
-	return !job.Allocated.IsEmpty()
+// usageOf returns the usage of the group, initializing it if absent.
+func (gp *groupquotaPlugin) usageOf(group string) *api.Resource {
+	usage, found := gp.groupUsage[group]
+	if !found {
+		usage = api.EmptyResource()
+		gp.groupUsage[group] = usage
+	}
+	return usage
 }
 
-func getJobGroup(job *api.JobInfo, key string) string {
-	if job.PodGroup == nil || job.PodGroup.Annotations == nil {
+// jobGroup returns the group of the job, or "" if the job has no group or its queue
+// opted out of the plugin. A job without group annotation inherits the group of its
+// namespace when inheritNamespaceGroup is enabled.
+func (gp *groupquotaPlugin) jobGroup(job *api.JobInfo) string {
+	if gp.optedOutQueues.Has(job.Queue) {
 		return ""
 	}
-	return job.PodGroup.Annotations[key]
+	if group := getJobGroup(job, gp.args.annotationKey); group != "" {
+		return group
+	}
+	return gp.namespaceGroups.groupOf(job.Namespace)
+}
+
+// accountedJobs returns the jobs whose usage is accounted, i.e. the jobs of the queues
+// that did not opt out.
+func (gp *groupquotaPlugin) accountedJobs(jobs map[api.JobID]*api.JobInfo) map[api.JobID]*api.JobInfo {
+	if gp.optedOutQueues.Len() == 0 {
+		return jobs
+	}
+	accounted := make(map[api.JobID]*api.JobInfo, len(jobs))
+	for uid, job := range jobs {
+		if !gp.optedOutQueues.Has(job.Queue) {
+			accounted[uid] = job
+		}
+	}
+	return accounted
+}
+
+// isJobExempt returns whether the job is exempt from quota enforcement.
+func (gp *groupquotaPlugin) isJobExempt(job *api.JobInfo) bool {
+	return gp.optedOutQueues.Has(job.Queue) ||
+		gp.args.exemptPriorities.Matches(job.Priority) || gp.args.exemptWorkloads.Matches(job)
+}
+
+// isJobOverQuota returns whether the job belongs to an over-quota group and is not exempt.
+func (gp *groupquotaPlugin) isJobOverQuota(job *api.JobInfo) bool {
+	return gp.overQuotaGroups[gp.jobGroup(job)] && !gp.isJobExempt(job)
+}
+
+// addUnmanagedPodsUsage adds the requests of pods placed on nodes that are not part of
+// any job in the session, e.g. pods of plain Deployments, to the usage of the group
+// found in their annotations or labels.
+func (gp *groupquotaPlugin) addUnmanagedPodsUsage(ssn *framework.Session) {
+	for _, node := range ssn.Nodes {
+		for _, task := range node.Tasks {
+			if _, found := ssn.Jobs[task.Job]; found {
+				continue
+			}
+			if !api.AllocatedStatus(task.Status) {
+				continue
+			}
+
+			groupName := accounting.PodGroup(task.Pod, gp.args.annotationKey)
+			if groupName == "" {
+				groupName = gp.namespaceGroups.groupOf(task.Namespace)
+			}
+			if groupName == "" {
+				continue
+			}
+
+			gp.usageOf(groupName).Add(task.Resreq)
+			klog.V(5).Infof("groupquota: account unmanaged pod %s/%s to group %s", task.Namespace, task.Name, groupName)
+		}
+	}
+}
+
+// isOverQuota checks the usage of a group against the quota. By default a group is over
+// quota once any limited resource reaches its limit; in dominantResource mode it is over
+// quota once its dominant share of the cluster reaches the dominant share of the quota.
+func (gp *groupquotaPlugin) isOverQuota(usage, quota *api.Resource, names []v1.ResourceName, total *api.Resource) bool {
+	if gp.args.dominantResource {
+		return isOverDominantShare(usage, quota, total, names)
+	}
+	return accounting.IsOverQuota(usage, quota, names)
+}
+
+// quotaOf returns the quota of the group and its limited resources: the limits of its
+// GroupQuota object if any, the resourceMap otherwise.
+func (gp *groupquotaPlugin) quotaOf(group string) (*api.Resource, []v1.ResourceName) {
+	if limit, found := gp.groupLimits[group]; found {
+		return limit.quota, limit.names
+	}
+	return gp.args.quota, gp.args.quotaNames
 }
 
-func addResourceList(list v1.ResourceList, res *api.Resource) {
-	// Convert api.Resource to v1.ResourceList and add
-	// Since api.Resource separates scalar and dimension resources
+// fairShareOf returns the share of the group of the job in fairShare mode, 0 for the jobs
+// without group and the exempt jobs.
+func (gp *groupquotaPlugin) fairShareOf(job *api.JobInfo, group string) float64 {
+	if group == "" || gp.isJobExempt(job) {
+		return 0
+	}
+	return gp.groupShares[group]
+}
 
-	if res == nil {
+// borrowingOf returns the borrowing policy of the group, Allow unless its GroupQuota
+// object says otherwise.
+func (gp *groupquotaPlugin) borrowingOf(group string) schedulingv1beta1.BorrowingPolicy {
+	if limit, found := gp.groupLimits[group]; found && limit.borrowing != "" {
+		return limit.borrowing
+	}
+	return schedulingv1beta1.BorrowingPolicyAllow
+}
+
+// forbidsBorrowing returns whether any GroupQuota object forbids its group to borrow.
+func (gp *groupquotaPlugin) forbidsBorrowing() bool {
+	for _, limit := range gp.groupLimits {
+		if limit.borrowing == schedulingv1beta1.BorrowingPolicyNever {
+			return true
+		}
+	}
+	return false
+}
+
+// loadGroupLimits takes the quota of the groups from the GroupQuota objects. On failure
+// every group keeps the resourceMap quota for the session.
+func (gp *groupquotaPlugin) loadGroupLimits(ssn *framework.Session) {
+	lister, err := groupQuotaListerFor(ssn.VCClient())
+	if err != nil {
+		klog.Errorf("groupquota: failed to watch GroupQuota objects, using resourceMap: %v", err)
 		return
 	}
+	limits, err := loadGroupLimits(lister)
+	if err != nil {
+		klog.Errorf("groupquota: failed to list GroupQuota objects, using resourceMap: %v", err)
+		return
+	}
+	gp.groupLimits = limits
+}
 
-	if res.MilliCPU > 0 {
-		cpu := list[v1.ResourceCPU]
-		cpu.Add(*resource.NewMilliQuantity(int64(res.MilliCPU), resource.DecimalSI))
-		list[v1.ResourceCPU] = cpu
+// Helper functions
+
+// overRunTimeVictims returns the reclaimees that overran their maximum run time.
+func (gp *groupquotaPlugin) overRunTimeVictims(ssn *framework.Session, reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) []*api.TaskInfo {
+	current := now()
+	var victims []*api.TaskInfo
+	for _, reclaimee := range reclaimees {
+		job, found := ssn.Jobs[reclaimee.Job]
+		if !found || gp.isJobExempt(job) {
+			continue
+		}
+		if gp.args.maxRunTime.Exceeded(reclaimee, job, current) {
+			victims = append(victims, reclaimee)
+			gp.decisions.Log(job, maxRunTimeKey, "reclaim", map[string]interface{}{
+				"task":      reclaimee.Name,
+				"reclaimer": reclaimer.Namespace + "/" + reclaimer.Name,
+			})
+		}
+	}
+	if len(victims) > 0 {
+		klog.V(4).Infof("groupquota: victims over their max run time for reclaimer <%s/%s>: %d",
+			reclaimer.Namespace, reclaimer.Name, len(victims))
 	}
+	return victims
+}
 
-	if res.Memory > 0 {
-		mem := list[v1.ResourceMemory]
-		mem.Add(*resource.NewQuantity(int64(res.Memory), resource.BinarySI))
-		list[v1.ResourceMemory] = mem
+// appendMissingTasks appends the tasks not yet in the list.
+func appendMissingTasks(tasks []*api.TaskInfo, more []*api.TaskInfo) []*api.TaskInfo {
+	seen := make(map[api.TaskID]bool, len(tasks))
+	for _, task := range tasks {
+		seen[task.UID] = true
+	}
+	for _, task := range more {
+		if !seen[task.UID] {
+			seen[task.UID] = true
+			tasks = append(tasks, task)
+		}
 	}
+	return tasks
+}
 
-	for name, val := range res.ScalarResources {
-		rName := v1.ResourceName(name)
-		q := list[rName]
-		q.Add(*resource.NewQuantity(int64(val), resource.DecimalSI))
-		list[rName] = q
+// minimalVictims returns the shortest prefix of the ordered victims whose resources, with
+// the future idle resources of their node when they share one, fit the request of the
+// preemptor. All the victims are returned when even together they do not fit it.
+func minimalVictims(preemptor *api.TaskInfo, victims []*api.TaskInfo, nodes map[string]*api.NodeInfo) []*api.TaskInfo {
+	freed := api.EmptyResource()
+	if node, found := nodes[victims[0].NodeName]; found {
+		sameNode := true
+		for _, victim := range victims {
+			if victim.NodeName != node.Name {
+				sameNode = false
+				break
+			}
+		}
+		if sameNode {
+			freed.Add(node.FutureIdle())
+		}
+	}
+	for i, victim := range victims {
+		freed.Add(victim.Resreq)
+		if preemptor.InitResreq.LessEqual(freed, api.Zero) {
+			return victims[:i+1]
+		}
+	}
+	return victims
+}
+
+// taskNames returns the <namespace>/<name> of the tasks.
+func taskNames(tasks []*api.TaskInfo) []string {
+	names := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		names = append(names, task.Namespace+"/"+task.Name)
 	}
+	return names
 }
 
-func isOverQuota(usage, quota v1.ResourceList) bool {
-	for name, limit := range quota {
-		used, ok := usage[name]
-		if !ok {
+// isJobInqueue returns true if the job already passed the enqueue stage.
+func isJobInqueue(job *api.JobInfo) bool {
+	if job.PodGroup == nil {
+		return false
+	}
+	phase := job.PodGroup.Status.Phase
+	return phase == scheduling.PodGroupInqueue || phase == scheduling.PodGroupRunning
+}
+
+func getJobGroup(job *api.JobInfo, key string) string {
+	if job.PodGroup == nil || job.PodGroup.Annotations == nil {
+		return ""
+	}
+	return job.PodGroup.Annotations[key]
+}
+
+func getGroupWeight(weights map[string]float64, group string) float64 {
+	if w, ok := weights[group]; ok {
+		return w
+	}
+	return 1
+}
+
+// isOverDominantShare returns true if the dominant share of the usage in the cluster
+// reaches the dominant share of the quota.
+func isOverDominantShare(usage, quota, total *api.Resource, names []v1.ResourceName) bool {
+	quotaShare := dominantShare(quota, total, names)
+	if quotaShare <= 0 {
+		return dominantShare(usage, total, names) > 0
+	}
+	return dominantShare(usage, total, names) >= quotaShare
+}
+
+// dominantShare returns the highest share of the total among the given resources.
+func dominantShare(r, total *api.Resource, names []v1.ResourceName) float64 {
+	share := 0.0
+	for _, name := range names {
+		totalValue := total.Get(name)
+		if totalValue <= 0 {
 			continue
 		}
-		if used.Cmp(limit) >= 0 {
-			return true
+		if s := r.Get(name) / totalValue; s > share {
+			share = s
 		}
 	}
-	return false
+	return share
+}
+
+// calculateShare returns the highest usage-to-quota ratio among the resources
+// that have a quota defined, in the spirit of DRF's dominant share.
+func calculateShare(usage, quota *api.Resource, names []v1.ResourceName) float64 {
+	share := 0.0
+	for _, name := range names {
+		limit := quota.Get(name)
+		if limit <= 0 {
+			continue
+		}
+		if ratio := usage.Get(name) / limit; ratio > share {
+			share = ratio
+		}
+	}
+	return share
+}
+
+// toMetricValues converts the given dimensions of the resource into their natural
+// units: cores for cpu, bytes for memory and whole units for scalar resources.
+func toMetricValues(r *api.Resource, names []v1.ResourceName) map[v1.ResourceName]float64 {
+	values := make(map[v1.ResourceName]float64, len(names))
+	for _, name := range names {
+		values[name] = resourceconv.MetricValue(name, r.Get(name))
+	}
+	return values
 }
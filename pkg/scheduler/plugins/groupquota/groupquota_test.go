@@ -0,0 +1,1906 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakevcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota/accounting"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/decisionlog"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const testGroupKey = "example.com/group"
+
+func init() {
+	options.Default()
+}
+
+func groupAnno(group string) map[string]string {
+	return map[string]string{testGroupKey: group}
+}
+
+// openTestSession opens a session with only the groupquota plugin enabled on a single 8 CPU node.
+func openTestSession(name string, podGroups []*vcapisv1.PodGroup, pods []*v1.Pod, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	return openTestSessionWithQueues(name, []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)}, podGroups, pods, arguments)
+}
+
+// openTestSessionWithQueues is openTestSession with the given queues instead of q1.
+func openTestSessionWithQueues(name string, queues []*vcapisv1.Queue, podGroups []*vcapisv1.PodGroup, pods []*v1.Pod, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	return registerTestSession(&uthelper.TestCommonStruct{
+		Name:      name,
+		PodGroups: podGroups,
+		Pods:      pods,
+		Queues:    queues,
+	}, arguments)
+}
+
+// openTestSessionWithNamespaces is openTestSession with the given namespaces in the cluster.
+func openTestSessionWithNamespaces(name string, namespaces []*v1.Namespace, podGroups []*vcapisv1.PodGroup, pods []*v1.Pod, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	return registerTestSession(&uthelper.TestCommonStruct{
+		Name:       name,
+		PodGroups:  podGroups,
+		Pods:       pods,
+		Queues:     []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		Namespaces: namespaces,
+	}, arguments)
+}
+
+// registerTestSession opens the session of the test case on node1 with only the groupquota
+// plugin enabled.
+func registerTestSession(tc *uthelper.TestCommonStruct, arguments framework.Arguments) (*framework.Session, *uthelper.TestCommonStruct) {
+	trueValue := true
+	tc.Plugins = map[string]framework.PluginBuilder{PluginName: New}
+	tc.Nodes = []*v1.Node{
+		util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:               PluginName,
+			EnabledJobOrder:    &trueValue,
+			EnabledJobEnqueued: &trueValue,
+			EnabledPreemptable: &trueValue,
+			EnabledReclaimable: &trueValue,
+			Arguments:          arguments,
+		}},
+	}}
+	return tc.RegisterSession(tiers, nil), tc
+}
+
+func TestJobOrderFairShare(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "b-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+
+	tests := []struct {
+		name          string
+		arguments     framework.Arguments
+		expectAFirst  bool
+		expectAbstain bool
+	}{
+		{
+			name: "fairShare disabled abstains between under-quota groups",
+			arguments: framework.Arguments{
+				"annotationKey": testGroupKey,
+				"resourceMap":   map[string]interface{}{"cpu": "4"},
+			},
+			expectAbstain: true,
+		},
+		{
+			name: "fairShare prefers group with lower usage-to-quota ratio",
+			arguments: framework.Arguments{
+				"annotationKey": testGroupKey,
+				"resourceMap":   map[string]interface{}{"cpu": "4"},
+				fairShareKey:    true,
+			},
+			expectAFirst: false,
+		},
+		{
+			name: "fairShare honors group weights",
+			arguments: framework.Arguments{
+				"annotationKey": testGroupKey,
+				"resourceMap":   map[string]interface{}{"cpu": "4"},
+				fairShareKey:    true,
+				groupWeightsKey: map[string]interface{}{"team-a": 4},
+			},
+			expectAFirst: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSession(test.name, podGroups, pods, test.arguments)
+			defer tc.Close()
+
+			jobA := ssn.Jobs["ns1/pg-a-pending"]
+			jobB := ssn.Jobs["ns1/pg-b-pending"]
+			fn := ssn.JobOrderFn
+			if test.expectAbstain {
+				// the framework falls back to UID ordering when all plugins abstain
+				if !fn(jobA, jobB) || fn(jobB, jobA) {
+					t.Errorf("expected plugin to abstain")
+				}
+				return
+			}
+			if got := fn(jobA, jobB); got != test.expectAFirst {
+				t.Errorf("expected team-a first: %v, got %v", test.expectAFirst, got)
+			}
+		})
+	}
+}
+
+func TestIncludeUnmanagedPods(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		// a pod of a plain Deployment labeled with the group, not managed by any podgroup
+		util.BuildPod("ns1", "deployment-a", "node1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "", groupAnno("team-a"), nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+
+	tests := []struct {
+		name         string
+		include      bool
+		expectAFirst bool
+	}{
+		{
+			name:         "unmanaged pods are ignored by default",
+			include:      false,
+			expectAFirst: true,
+		},
+		{
+			name:         "unmanaged pods count against the group quota",
+			include:      true,
+			expectAFirst: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSession(test.name, podGroups, pods, framework.Arguments{
+				"annotationKey":         testGroupKey,
+				"resourceMap":           map[string]interface{}{"cpu": "2"},
+				includeUnmanagedPodsKey: test.include,
+			})
+			defer tc.Close()
+
+			if got := ssn.JobOrderFn(ssn.Jobs["ns1/pg-a-pending"], ssn.Jobs["ns1/pg-b-pending"]); got != test.expectAFirst {
+				t.Errorf("expected team-a first: %v, got %v", test.expectAFirst, got)
+			}
+		})
+	}
+}
+
+func TestPreemptOverQuota(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-c")),
+		util.BuildPodGroupWithAnno("pg-c-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-c")),
+	}
+	lowPriority, highPriority := int32(10), int32(100)
+	pods := []*v1.Pod{
+		util.BuildPodWithPriority("ns1", "a-high", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil, &highPriority),
+		util.BuildPodWithPriority("ns1", "a-low", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil, &lowPriority),
+		util.BuildPod("ns1", "b-1", "node1", v1.PodRunning, api.BuildResourceList("3", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "c-1", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-c-running", nil, nil),
+		util.BuildPod("ns1", "c-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c-pending", nil, nil),
+	}
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		minimize      bool
+		expectVictims []string
+	}{
+		{
+			name:          "preemption of over-quota groups disabled by default",
+			enabled:       false,
+			expectVictims: nil,
+		},
+		{
+			name:          "victims from the most over-quota group and lowest priority first",
+			enabled:       true,
+			expectVictims: []string{"b-1", "a-low", "a-high"},
+		},
+		{
+			name:          "only the victims needed to fit the preemptor",
+			enabled:       true,
+			minimize:      true,
+			expectVictims: []string{"b-1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSession(test.name, podGroups, pods, framework.Arguments{
+				"annotationKey":     testGroupKey,
+				"resourceMap":       map[string]interface{}{"cpu": "2"},
+				preemptOverQuotaKey: test.enabled,
+				minimizeVictimsKey:  test.minimize,
+			})
+			defer tc.Close()
+
+			var preemptor *api.TaskInfo
+			for _, task := range ssn.Jobs["ns1/pg-c-pending"].Tasks {
+				preemptor = task
+			}
+			var preemptees []*api.TaskInfo
+			for _, jobID := range []api.JobID{"ns1/pg-a-running", "ns1/pg-b-running", "ns1/pg-c-running"} {
+				for _, task := range ssn.Jobs[jobID].Tasks {
+					preemptees = append(preemptees, task)
+				}
+			}
+
+			victims := ssn.Preemptable(preemptor, preemptees)
+			var names []string
+			for _, victim := range victims {
+				names = append(names, victim.Name)
+			}
+			if !equality.Semantic.DeepEqual(names, test.expectVictims) {
+				t.Errorf("expected victims %v, got %v", test.expectVictims, names)
+			}
+		})
+	}
+}
+
+func TestMaxInqueueJobs(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending1", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending2", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending1", nil, nil),
+		util.BuildPod("ns1", "a-pending2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending2", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+
+	ssn, tc := openTestSession("max inqueue jobs", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "8"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 2},
+	})
+	defer tc.Close()
+
+	first := ssn.Jobs["ns1/pg-a-pending1"]
+	if !ssn.JobEnqueueable(first) {
+		t.Fatalf("expected first pending job of team-a to be enqueueable")
+	}
+	ssn.JobEnqueued(first)
+
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending2"]) {
+		t.Errorf("expected second pending job of team-a to be rejected")
+	}
+	if !ssn.JobEnqueueable(ssn.Jobs["ns1/pg-b-pending"]) {
+		t.Errorf("expected team-b without limit to be enqueueable")
+	}
+}
+
+func TestMaxInqueueJobsOf(t *testing.T) {
+	args := parseArguments(framework.Arguments{
+		maxInqueueJobsKey:        map[string]interface{}{"team-a": 3, "team-b": 0},
+		defaultMaxInqueueJobsKey: 5,
+	})
+	for group, expected := range map[string]int{"team-a": 3, "team-b": 5, "team-c": 5} {
+		if got := args.maxInqueueJobsOf(group); got != expected {
+			t.Errorf("expected limit %d for %s, got %d", expected, group, got)
+		}
+	}
+}
+
+func TestDecisionLog(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+	}
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	ssn, tc := openTestSession("decision log", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "8"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+		decisionLogKey:    map[string]interface{}{"file": path},
+	})
+	defer tc.Close()
+
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending"]) {
+		t.Fatalf("expected the pending job of team-a to be rejected")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var record decisionlog.Record
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("invalid record %s: %v", data, err)
+	}
+	if record.Job != "pg-a-pending" || record.Rule != maxInqueueJobsKey || record.Action != "rejected" || record.Inputs["group"] != "team-a" {
+		t.Errorf("unexpected record %+v", record)
+	}
+}
+
+func TestExemptPriorities(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-urgent", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "a-urgent", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-urgent", nil, nil),
+	}
+
+	ssn, tc := openTestSession("exempt priorities", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "2"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+		exemptPrioritiesKey: map[string]interface{}{
+			"expressions": []interface{}{
+				map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}},
+			},
+		},
+	})
+	defer tc.Close()
+
+	pending := ssn.Jobs["ns1/pg-a-pending"]
+	urgent := ssn.Jobs["ns1/pg-a-urgent"]
+	urgent.Priority = 1000
+
+	if !ssn.JobOrderFn(urgent, pending) || ssn.JobOrderFn(pending, urgent) {
+		t.Errorf("expected exempt job to be ordered before the deprioritized job of its group")
+	}
+	if ssn.JobEnqueueable(pending) {
+		t.Errorf("expected job of team-a over its inqueue limit to be rejected")
+	}
+	if !ssn.JobEnqueueable(urgent) {
+		t.Errorf("expected exempt job to be enqueueable")
+	}
+}
+
+func TestMaxRunTimeReclaim(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start.Add(3 * time.Hour) }
+	defer func() { now = time.Now }()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-1", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "b-1", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "c-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c-pending", nil, nil),
+	}
+	startTime := metav1.NewTime(start)
+	for _, pod := range pods[:2] {
+		pod.Status.StartTime = &startTime
+	}
+	// b-1 may run longer than the default limit
+	pods[1].Annotations = map[string]string{"volcano.sh/max-run-time": "4h"}
+
+	ssn, tc := openTestSession("max run time reclaim", podGroups, pods, framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "8"},
+		maxRunTimeKey:   map[string]interface{}{"default": "2h"},
+	})
+	defer tc.Close()
+
+	var reclaimer *api.TaskInfo
+	for _, task := range ssn.Jobs["ns1/pg-c-pending"].Tasks {
+		reclaimer = task
+	}
+	var reclaimees []*api.TaskInfo
+	for _, jobID := range []api.JobID{"ns1/pg-a-running", "ns1/pg-b-running"} {
+		for _, task := range ssn.Jobs[jobID].Tasks {
+			reclaimees = append(reclaimees, task)
+		}
+	}
+
+	var names []string
+	for _, victim := range ssn.Reclaimable(reclaimer, reclaimees) {
+		names = append(names, victim.Name)
+	}
+	if expected := []string{"a-1"}; !equality.Semantic.DeepEqual(names, expected) {
+		t.Errorf("expected victims %v, got %v", expected, names)
+	}
+}
+
+func TestReclaimOverDeserved(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-1", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-2", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-3", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "b-1", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "c-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c-pending", nil, nil),
+	}
+
+	ssn, tc := openTestSession("reclaim over deserved", podGroups, pods, framework.Arguments{
+		"annotationKey":        testGroupKey,
+		"resourceMap":          map[string]interface{}{"cpu": "2"},
+		reclaimOverDeservedKey: true,
+	})
+	defer tc.Close()
+
+	deserved, found := framework.GetPluginData[map[string]*api.Resource](ssn, GroupDeservedDataKey)
+	if !found || deserved["team-a"] == nil || deserved["team-a"].MilliCPU != 2000 {
+		t.Errorf("expected the deserved resources of team-a to be published, got %v", deserved)
+	}
+
+	tasksOf := func(jobIDs ...api.JobID) []*api.TaskInfo {
+		var tasks []*api.TaskInfo
+		for _, jobID := range jobIDs {
+			for _, task := range ssn.Jobs[jobID].Tasks {
+				tasks = append(tasks, task)
+			}
+		}
+		return tasks
+	}
+	reclaimer := tasksOf("ns1/pg-c-pending")[0]
+
+	// team-a uses 3 cpu out of the 2 it deserves, team-b exactly what it deserves, so
+	// one task of team-a is enough to bring it back to its deserved resources.
+	victims := ssn.Reclaimable(reclaimer, tasksOf("ns1/pg-a-running", "ns1/pg-b-running"))
+	if len(victims) != 1 || victims[0].Job != "ns1/pg-a-running" {
+		t.Errorf("expected one victim of team-a, got %v", taskNames(victims))
+	}
+
+	// A reclaimer of a group over its deserved resources takes nothing back.
+	if victims := ssn.Reclaimable(tasksOf("ns1/pg-a-running")[0], tasksOf("ns1/pg-b-running")); len(victims) != 0 {
+		t.Errorf("expected no victim for a reclaimer of team-a, got %v", taskNames(victims))
+	}
+}
+
+func TestExemptWorkloads(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-oncall", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending,
+			map[string]string{testGroupKey: "team-a", "example.com/oncall": "true"}),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "a-oncall", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-oncall", nil, nil),
+	}
+
+	ssn, tc := openTestSession("exempt workloads", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "2"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+		exemptWorkloadsKey: map[string]interface{}{
+			"queues":      []interface{}{"q*"},
+			"annotations": map[string]interface{}{"example.com/oncall": "true"},
+		},
+	})
+	defer tc.Close()
+
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending"]) {
+		t.Errorf("expected job of team-a over its inqueue limit to be rejected")
+	}
+	if !ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-oncall"]) {
+		t.Errorf("expected job selected by exemptWorkloads to be enqueueable")
+	}
+}
+
+func TestCalculateShare(t *testing.T) {
+	quota := api.NewResource(api.BuildResourceList("4", "8Gi"))
+	names := []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+	tests := []struct {
+		name   string
+		usage  *api.Resource
+		expect float64
+	}{
+		{
+			name:   "empty usage",
+			usage:  api.EmptyResource(),
+			expect: 0,
+		},
+		{
+			name:   "dominant resource is cpu",
+			usage:  api.NewResource(api.BuildResourceList("2", "2Gi")),
+			expect: 0.5,
+		},
+		{
+			name:   "dominant resource is memory",
+			usage:  api.NewResource(api.BuildResourceList("1", "6Gi")),
+			expect: 0.75,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := calculateShare(test.usage, quota, names); got != test.expect {
+				t.Errorf("expected share %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestIsOverQuota(t *testing.T) {
+	gpu := v1.ResourceName(api.GPUResourceName)
+	quotaList := parseResourceMap(map[string]interface{}{"cpu": "8", "nvidia.com/gpu": "2"})
+	quota := api.NewResource(quotaList)
+	names := []v1.ResourceName{v1.ResourceCPU, gpu}
+	total := api.NewResource(api.BuildResourceList("16", "64Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "8"}))
+
+	tests := []struct {
+		name            string
+		usage           *api.Resource
+		expectOver      bool
+		expectDominance bool
+	}{
+		{
+			name:            "cpu only usage below quota",
+			usage:           api.NewResource(api.BuildResourceList("4", "4Gi")),
+			expectOver:      false,
+			expectDominance: false,
+		},
+		{
+			name:            "one gpu below quota of two",
+			usage:           api.NewResource(api.BuildResourceList("1", "1Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "1"})),
+			expectOver:      false,
+			expectDominance: false,
+		},
+		{
+			name:            "two gpus reach the gpu quota",
+			usage:           api.NewResource(api.BuildResourceList("1", "1Gi", api.ScalarResource{Name: "nvidia.com/gpu", Value: "2"})),
+			expectOver:      true,
+			expectDominance: false,
+		},
+		{
+			name:            "dominant share of cpu reaches dominant share of quota",
+			usage:           api.NewResource(api.BuildResourceList("8", "1Gi")),
+			expectOver:      true,
+			expectDominance: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := accounting.IsOverQuota(test.usage, quota, names); got != test.expectOver {
+				t.Errorf("expected over quota %v, got %v", test.expectOver, got)
+			}
+			if got := isOverDominantShare(test.usage, quota, total, names); got != test.expectDominance {
+				t.Errorf("expected over dominant share %v, got %v", test.expectDominance, got)
+			}
+		})
+	}
+}
+
+func TestWindowedQuota(t *testing.T) {
+	// The window starts on a bucket boundary, so that the usage of one bucket expires at once.
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC).Truncate(bucketLength(168 * time.Hour))
+	defer func() {
+		now = time.Now
+		windowTrackers = map[string]*windowTracker{}
+	}()
+	windowTrackers = map[string]*windowTracker{}
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-b")),
+	}
+	pendingPods := []*v1.Pod{
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+	runningPod := util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "pg-a-running", nil, nil)
+	arguments := framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "8"},
+		windowedQuotaKey: map[string]interface{}{
+			windowedQuotaWindowKey:  "168h",
+			windowedQuotaEnforceKey: true,
+			windowedQuotaLimitsKey:  map[string]interface{}{"cpu": "10"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		elapsed         time.Duration
+		running         bool
+		expectExhausted bool
+	}{
+		{
+			name:            "first session starts the window",
+			elapsed:         0,
+			running:         true,
+			expectExhausted: false,
+		},
+		{
+			name:            "2 cpus for 4 hours stay within 10 cpu-hours",
+			elapsed:         4 * time.Hour,
+			running:         true,
+			expectExhausted: false,
+		},
+		{
+			name:            "2 cpus for 6 hours exhaust 10 cpu-hours",
+			elapsed:         6 * time.Hour,
+			running:         true,
+			expectExhausted: true,
+		},
+		{
+			name:            "usage of the last 7 days still exhausts the budget after the job ends",
+			elapsed:         167 * time.Hour,
+			expectExhausted: true,
+		},
+		{
+			name:            "the window slides instead of resetting after 7 days",
+			elapsed:         168*time.Hour + time.Minute,
+			expectExhausted: true,
+		},
+		{
+			name:            "budget is restored once the usage leaves the window",
+			elapsed:         8 * 24 * time.Hour,
+			expectExhausted: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = func() time.Time { return start.Add(test.elapsed) }
+			pods := pendingPods
+			if test.running {
+				pods = append([]*v1.Pod{runningPod}, pendingPods...)
+			}
+			ssn, tc := openTestSession(test.name, podGroups, pods, arguments)
+			defer tc.Close()
+
+			jobA := ssn.Jobs["ns1/pg-a-pending"]
+			jobB := ssn.Jobs["ns1/pg-b-pending"]
+			if got := ssn.JobEnqueueable(jobA); got == test.expectExhausted {
+				t.Errorf("expected team-a enqueueable: %v, got %v", !test.expectExhausted, got)
+			}
+			if !ssn.JobEnqueueable(jobB) {
+				t.Errorf("expected team-b to be enqueueable")
+			}
+			if got := ssn.JobOrderFn(jobA, jobB); got == test.expectExhausted {
+				t.Errorf("expected team-a first: %v, got %v", !test.expectExhausted, got)
+			}
+		})
+	}
+}
+
+func TestWindowStateKey(t *testing.T) {
+	defer func() {
+		now = time.Now
+		windowTrackers = map[string]*windowTracker{}
+	}()
+	windowTrackers = map[string]*windowTracker{}
+
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	parse := func(window, configMap string) *windowedQuotaArguments {
+		return parseWindowedQuota(map[string]interface{}{
+			windowedQuotaWindowKey: window,
+			windowedQuotaNameKey:   configMap,
+			windowedQuotaLimitsKey: map[string]interface{}{"cpu": "10"},
+		})
+	}
+	charged := parse("24h", "usage-a")
+	others := map[string]*windowedQuotaArguments{
+		"another ConfigMap":     parse("24h", "usage-b"),
+		"another window length": parse("48h", "usage-a"),
+	}
+	usage := map[string]*api.Resource{"team-a": api.NewResource(api.BuildResourceList("2", "2Gi"))}
+
+	for _, elapsed := range []time.Duration{0, 6 * time.Hour} {
+		now = func() time.Time { return start.Add(elapsed) }
+		accumulateWindowedUsage(nil, charged, usage)
+		for _, wq := range others {
+			accumulateWindowedUsage(nil, wq, nil)
+		}
+	}
+	if !accumulateWindowedUsage(nil, charged, nil)["team-a"] {
+		t.Errorf("expected team-a to exhaust the charged window")
+	}
+	for name, wq := range others {
+		if accumulateWindowedUsage(nil, wq, nil)["team-a"] {
+			t.Errorf("%s: expected team-a not to be charged", name)
+		}
+	}
+}
+
+func TestWindowStatePersistence(t *testing.T) {
+	defer func() {
+		now = time.Now
+		windowTrackers = map[string]*windowTracker{}
+	}()
+	windowTrackers = map[string]*windowTracker{}
+
+	current := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	client := fake.NewSimpleClientset()
+	wq := parseWindowedQuota(map[string]interface{}{
+		windowedQuotaLimitsKey: map[string]interface{}{"nvidia.com/gpu": "500"},
+	})
+
+	state := &windowState{
+		Window:     wq.window,
+		LastUpdate: current,
+		Buckets: []*windowBucket{{
+			Start:       current.Add(-time.Hour),
+			Consumption: map[string]map[v1.ResourceName]float64{"team-a": {"nvidia.com/gpu": 4000}},
+		}},
+	}
+	windowTrackers[windowKey(wq)] = &windowTracker{state: state}
+	persistWindowState(client, wq)
+
+	loaded := loadWindowState(client, wq, current)
+	if len(loaded.Buckets) != 1 || !loaded.Buckets[0].Start.Equal(state.Buckets[0].Start) {
+		t.Fatalf("expected one bucket starting at %v, got %v", state.Buckets[0].Start, loaded.Buckets)
+	}
+	if got := loaded.Buckets[0].Consumption["team-a"]["nvidia.com/gpu"]; got != 4000 {
+		t.Errorf("expected consumption 4000, got %v", got)
+	}
+
+	wq.window = 24 * time.Hour
+	if loaded := loadWindowState(client, wq, current); len(loaded.Buckets) != 0 {
+		t.Errorf("expected the state of another window length to be discarded, got %v", loaded.Buckets)
+	}
+}
+
+func TestBurst(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() {
+		now = time.Now
+		burstTrackers = map[string]*burstTracker{}
+	}()
+	burstTrackers = map[string]*burstTracker{}
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("3", "3Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "b-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+	}
+	arguments := framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "2"},
+		burstKey: map[string]interface{}{
+			burstLimitsKey:   map[string]interface{}{"cpu": "4"},
+			burstDurationKey: "1h",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		elapsed         time.Duration
+		expectOverQuota bool
+	}{
+		{
+			name:    "first session starts with a full bucket",
+			elapsed: 0,
+		},
+		{
+			name:    "40 minutes of burst stay within 1 hour",
+			elapsed: 40 * time.Minute,
+		},
+		{
+			name:            "70 minutes of burst exhaust the bucket",
+			elapsed:         70 * time.Minute,
+			expectOverQuota: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = func() time.Time { return start.Add(test.elapsed) }
+			ssn, tc := openTestSession(test.name, podGroups, pods, arguments)
+			defer tc.Close()
+
+			overQuota, _ := framework.GetPluginData[map[string]bool](ssn, OverQuotaGroupsDataKey)
+			if overQuota["team-a"] != test.expectOverQuota {
+				t.Errorf("expected team-a over quota: %v, got %v", test.expectOverQuota, overQuota["team-a"])
+			}
+			// team-b under its quota keeps a full bucket
+			if tokens, found := burstTrackers[defaultBurstNamespace+"/"+defaultBurstConfigMap].state.Tokens["team-b"]; found {
+				t.Errorf("expected team-b under quota to keep a full bucket, got %v", tokens)
+			}
+		})
+	}
+}
+
+func TestConsumeBurstTokens(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() {
+		now = time.Now
+		burstTrackers = map[string]*burstTracker{}
+	}()
+
+	parse := func(configMap string) *burstArguments {
+		return parseBurst(map[string]interface{}{
+			burstLimitsKey:       map[string]interface{}{"cpu": "4"},
+			burstDurationKey:     "1h",
+			burstRefillPeriodKey: "2h",
+			burstNameKey:         configMap,
+		})
+	}
+	ba := parse("buckets")
+	usage := map[string]*api.Resource{
+		"team-a": api.NewResource(api.BuildResourceList("3", "3Gi")),
+		"team-b": api.NewResource(api.BuildResourceList("6", "6Gi")),
+		"team-c": api.NewResource(api.BuildResourceList("1", "1Gi")),
+	}
+
+	burst := &burstState{LastUpdate: start, Tokens: map[string]time.Duration{"team-a": 0, "team-c": 10 * time.Minute}}
+	burstTrackers = map[string]*burstTracker{"volcano-system/buckets": {state: burst}}
+	now = func() time.Time { return start.Add(time.Hour) }
+	bursting := consumeBurstTokens(nil, ba, map[string]bool{"team-a": false, "team-b": true, "team-c": false}, usage)
+	if bursting["team-b"] {
+		t.Errorf("expected team-b over its burst ceiling not to burst")
+	}
+	if got := burst.Tokens["team-a"]; got != 30*time.Minute {
+		t.Errorf("expected 1 hour under quota to refill 30 minutes, got %v", got)
+	}
+	if got := burst.Tokens["team-c"]; got != 40*time.Minute {
+		t.Errorf("expected team-c under quota to be refilled, got %v", got)
+	}
+
+	now = func() time.Time { return start.Add(time.Hour + time.Minute) }
+	if bursting := consumeBurstTokens(nil, ba, map[string]bool{"team-a": true}, usage); !bursting["team-a"] {
+		t.Errorf("expected team-a with tokens left to burst")
+	}
+	if got := burst.Tokens["team-a"]; got != 29*time.Minute {
+		t.Errorf("expected 29 minutes left, got %v", got)
+	}
+
+	// the buckets of another ConfigMap are independent
+	if bursting := consumeBurstTokens(nil, parse("other-buckets"), map[string]bool{"team-a": true}, usage); !bursting["team-a"] {
+		t.Errorf("expected team-a to start with a full bucket in another ConfigMap")
+	}
+	if got := burst.Tokens["team-a"]; got != 29*time.Minute {
+		t.Errorf("expected the buckets of another ConfigMap not to drain team-a, got %v left", got)
+	}
+
+	now = func() time.Time { return start.Add(4 * time.Hour) }
+	consumeBurstTokens(nil, ba, map[string]bool{}, usage)
+	if _, found := burst.Tokens["team-a"]; found {
+		t.Errorf("expected the full bucket of team-a to be dropped")
+	}
+}
+
+func TestRemoteUsage(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() {
+		now = time.Now
+		remoteReports = map[string]*remoteReport{}
+	}()
+
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		json.NewEncoder(w).Encode(&StatusReport{
+			UpdateTime: metav1.NewTime(start),
+			Groups: map[string]GroupStatus{
+				"team-a": {Usage: api.BuildResourceList("6", "6Gi")},
+			},
+		})
+	}))
+	defer server.Close()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("3", "3Gi"), "pg-a-running", nil, nil),
+	}
+	arguments := framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "8"},
+		remoteUsageKey: map[string]interface{}{
+			remoteUsageEndpointsKey:    []interface{}{server.URL},
+			remoteUsageMaxStalenessKey: "5m",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		elapsed         time.Duration
+		expectUsage     float64
+		expectOverQuota bool
+		expectFetches   int
+	}{
+		{
+			name:            "remote usage is added to the local usage",
+			elapsed:         time.Minute,
+			expectUsage:     9000,
+			expectOverQuota: true,
+			expectFetches:   1,
+		},
+		{
+			name:            "report is not fetched again within the refresh interval",
+			elapsed:         time.Minute + 10*time.Second,
+			expectUsage:     9000,
+			expectOverQuota: true,
+			expectFetches:   1,
+		},
+		{
+			name:          "stale report is ignored",
+			elapsed:       10 * time.Minute,
+			expectUsage:   3000,
+			expectFetches: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = func() time.Time { return start.Add(test.elapsed) }
+			ssn, tc := openTestSession(test.name, podGroups, pods, arguments)
+			defer tc.Close()
+
+			usage, _ := framework.GetPluginData[map[string]*api.Resource](ssn, GroupUsageDataKey)
+			if got := usage["team-a"].MilliCPU; got != test.expectUsage {
+				t.Errorf("expected usage of team-a %v, got %v", test.expectUsage, got)
+			}
+			overQuota, _ := framework.GetPluginData[map[string]bool](ssn, OverQuotaGroupsDataKey)
+			if overQuota["team-a"] != test.expectOverQuota {
+				t.Errorf("expected team-a over quota: %v, got %v", test.expectOverQuota, overQuota["team-a"])
+			}
+			if fetches != test.expectFetches {
+				t.Errorf("expected %d fetches, got %d", test.expectFetches, fetches)
+			}
+		})
+	}
+}
+
+func TestStatusReportRemoteUsage(t *testing.T) {
+	gp := &groupquotaPlugin{
+		args: parseArguments(framework.Arguments{"resourceMap": map[string]interface{}{"cpu": "8"}}),
+		groupUsage: map[string]*api.Resource{
+			"team-a": api.NewResource(api.BuildResourceList("9", "9Gi")),
+		},
+		remoteUsage: map[string]*api.Resource{
+			"team-a": api.NewResource(api.BuildResourceList("6", "6Gi")),
+		},
+		overQuotaGroups: map[string]bool{"team-a": true},
+	}
+	status := gp.buildStatusReport(nil).Groups["team-a"]
+	if usage := status.Usage[v1.ResourceCPU]; usage.MilliValue() != 3000 {
+		t.Errorf("expected the local usage of 3 cpus, got %s", usage.String())
+	}
+	if remote := status.RemoteUsage[v1.ResourceCPU]; remote.MilliValue() != 6000 {
+		t.Errorf("expected the remote usage of 6 cpus, got %s", remote.String())
+	}
+}
+
+func TestUsageDecay(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() {
+		now = time.Now
+		historyTrackers = map[string]*historyTracker{}
+	}()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "b-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+	arguments := framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "8"},
+		fairShareKey:    true,
+		usageDecayKey:   map[string]interface{}{usageDecayHalfLifeKey: "1h"},
+	}
+
+	tests := []struct {
+		name         string
+		elapsed      time.Duration
+		expectAFirst bool
+	}{
+		{
+			// team-a used 4 cpus, decayed to 2 cpus, team-b uses 1 cpu.
+			name:         "recent usage of an idle group still counts",
+			elapsed:      time.Hour,
+			expectAFirst: false,
+		},
+		{
+			// team-a used 4 cpus, decayed to 0.25 cpu, team-b uses 1 cpu.
+			name:         "old usage of an idle group has decayed",
+			elapsed:      4 * time.Hour,
+			expectAFirst: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			historyTrackers = map[string]*historyTracker{
+				defaultHistoryNamespace + "/" + defaultHistoryConfigMap: {state: &historyState{
+					LastUpdate: start,
+					Usage:      map[string]map[v1.ResourceName]float64{"team-a": {v1.ResourceCPU: 4000}},
+				}},
+			}
+			now = func() time.Time { return start.Add(test.elapsed) }
+			ssn, tc := openTestSession(test.name, podGroups, pods, arguments)
+			defer tc.Close()
+
+			jobA := ssn.Jobs["ns1/pg-a-pending"]
+			jobB := ssn.Jobs["ns1/pg-b-pending"]
+			if got := ssn.JobOrderFn(jobA, jobB); got != test.expectAFirst {
+				t.Errorf("expected team-a first: %v, got %v", test.expectAFirst, got)
+			}
+		})
+	}
+}
+
+func TestHistoryStatePersistence(t *testing.T) {
+	defer func() {
+		now = time.Now
+		historyTrackers = map[string]*historyTracker{}
+	}()
+
+	current := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	client := fake.NewSimpleClientset()
+	ud := parseUsageDecay(map[string]interface{}{})
+
+	other := parseUsageDecay(map[string]interface{}{usageDecayConfigMapNameKey: "other-history"})
+
+	decayHistoricalUsage(client, ud, map[string]*api.Resource{"team-a": api.NewResource(api.BuildResourceList("4", "1Gi"))})
+	decayHistoricalUsage(client, other, map[string]*api.Resource{"team-b": api.NewResource(api.BuildResourceList("2", "1Gi"))})
+	persistHistoryState(client, ud)
+	persistHistoryState(client, other)
+
+	loaded := loadHistoryState(client, ud, current.Add(time.Hour))
+	if !loaded.LastUpdate.Equal(current) {
+		t.Errorf("expected last update %v, got %v", current, loaded.LastUpdate)
+	}
+	if got := loaded.Usage["team-a"][v1.ResourceCPU]; got != 4000 {
+		t.Errorf("expected usage 4000, got %v", got)
+	}
+	if _, found := loaded.Usage["team-b"]; found {
+		t.Errorf("expected the usage of team-b to be kept in another ConfigMap, got %v", loaded.Usage)
+	}
+
+	loaded = loadHistoryState(client, other, current.Add(time.Hour))
+	if got := loaded.Usage["team-b"][v1.ResourceCPU]; got != 2000 {
+		t.Errorf("expected usage 2000 in the other ConfigMap, got %v", got)
+	}
+	if _, found := loaded.Usage["team-a"]; found {
+		t.Errorf("expected the usage of team-a to be kept in another ConfigMap, got %v", loaded.Usage)
+	}
+}
+
+func TestNodePools(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a", nil, nil),
+		util.BuildPod("ns1", "b-running", "node-shared", v1.PodRunning, api.BuildResourceList("4", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "b", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b", nil, nil),
+		util.BuildPod("ns1", "c", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c", nil, nil),
+	}
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "node pools",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node-pool", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"pool": "gpu"}),
+			util.BuildNode("node-shared", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	pool := map[string]interface{}{nodePoolSelectorsKey: []interface{}{map[string]interface{}{"pool": "gpu"}}}
+	spillingPool := map[string]interface{}{nodePoolSelectorsKey: pool[nodePoolSelectorsKey], nodePoolAllowSpillKey: true}
+	tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+		Name:             PluginName,
+		EnabledPredicate: &trueValue,
+		EnabledNodeOrder: &trueValue,
+		Arguments: framework.Arguments{
+			annotationKeyKey:        testGroupKey,
+			resourceMapKey:          map[string]interface{}{"cpu": "4"},
+			allowedNodeSelectorsKey: map[string]interface{}{"team-a": pool, "team-b": spillingPool},
+		},
+	}}}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	tests := []struct {
+		name            string
+		job             api.JobID
+		node            string
+		expectFit       bool
+		expectPreferred bool
+	}{
+		{name: "restricted group on its pool", job: "ns1/pg-a", node: "node-pool", expectFit: true, expectPreferred: true},
+		{name: "restricted group outside its pool", job: "ns1/pg-a", node: "node-shared", expectFit: false},
+		{name: "over-quota group allowed to spill", job: "ns1/pg-b", node: "node-shared", expectFit: true},
+		{name: "over-quota group does not prefer its pool", job: "ns1/pg-b", node: "node-pool", expectFit: true, expectPreferred: false},
+		{name: "group without pool", job: "ns1/pg-c", node: "node-shared", expectFit: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := ssn.Jobs[test.job]
+			var task *api.TaskInfo
+			for _, pending := range job.TaskStatusIndex[api.Pending] {
+				task = pending
+			}
+			node := ssn.Nodes[test.node]
+			if err := ssn.PredicateFn(task, node); (err == nil) != test.expectFit {
+				t.Errorf("expected fit %v, got %v", test.expectFit, err)
+			}
+			score, err := ssn.NodeOrderFn(task, node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (score > 0) != test.expectPreferred {
+				t.Errorf("expected preferred %v, got score %v", test.expectPreferred, score)
+			}
+		})
+	}
+}
+
+func TestPremiumNodes(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "b-running", "node-standard", v1.PodRunning, api.BuildResourceList("4", "1Gi"), "pg-b-running", nil, nil),
+		util.BuildPod("ns1", "b", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b", nil, nil),
+		util.BuildPod("ns1", "c", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c", nil, nil),
+	}
+
+	for _, enforce := range []bool{false, true} {
+		trueValue := true
+		tc := &uthelper.TestCommonStruct{
+			Name:      "premium nodes",
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: podGroups,
+			Pods:      pods,
+			Nodes: []*v1.Node{
+				util.BuildNode("node-premium", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"accelerator": "a100"}),
+				util.BuildNode("node-standard", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		}
+		tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+			Name:             PluginName,
+			EnabledPredicate: &trueValue,
+			EnabledNodeOrder: &trueValue,
+			Arguments: framework.Arguments{
+				annotationKeyKey: testGroupKey,
+				resourceMapKey:   map[string]interface{}{"cpu": "4"},
+				premiumNodeSelectorKey: map[string]interface{}{
+					nodePoolSelectorsKey: []interface{}{map[string]interface{}{"accelerator": "a100"}},
+					premiumEnforceKey:    enforce,
+				},
+			},
+		}}}}
+		ssn := tc.RegisterSession(tiers, nil)
+
+		tests := []struct {
+			name          string
+			job           api.JobID
+			node          string
+			expectFit     bool
+			expectDemoted bool
+		}{
+			{name: "over-quota group on premium node", job: "ns1/pg-b", node: "node-premium", expectFit: !enforce, expectDemoted: !enforce},
+			{name: "over-quota group on standard node", job: "ns1/pg-b", node: "node-standard", expectFit: true},
+			{name: "under-quota group on premium node", job: "ns1/pg-c", node: "node-premium", expectFit: true},
+		}
+		for _, test := range tests {
+			var task *api.TaskInfo
+			for _, pending := range ssn.Jobs[test.job].TaskStatusIndex[api.Pending] {
+				task = pending
+			}
+			node := ssn.Nodes[test.node]
+			if err := ssn.PredicateFn(task, node); (err == nil) != test.expectFit {
+				t.Errorf("enforce %v, %s: expected fit %v, got %v", enforce, test.name, test.expectFit, err)
+			}
+			score, err := ssn.NodeOrderFn(task, node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (score < 0) != test.expectDemoted {
+				t.Errorf("enforce %v, %s: expected demoted %v, got score %v", enforce, test.name, test.expectDemoted, score)
+			}
+		}
+		tc.Close()
+	}
+}
+
+func TestStatusReport(t *testing.T) {
+	defer func() {
+		statusLastWrite = time.Time{}
+	}()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending1", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending2", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-b")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending1", nil, nil),
+		util.BuildPod("ns1", "a-pending2", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending2", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+	}
+
+	ssn, tc := openTestSession("status report", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "2"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+		statusReportKey:   true,
+	})
+	client := ssn.KubeClient()
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending1"]) {
+		t.Errorf("expected pending job of team-a to be rejected")
+	}
+	tc.Close()
+
+	cm, err := client.CoreV1().ConfigMaps(defaultStatusReportNamespace).Get(context.TODO(), defaultStatusReportName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get status report ConfigMap: %v", err)
+	}
+	report := &StatusReport{}
+	if err := json.Unmarshal([]byte(cm.Data[StatusReportDataKey]), report); err != nil {
+		t.Fatalf("failed to decode status report: %v", err)
+	}
+
+	a, found := report.Groups["team-a"]
+	if !found {
+		t.Fatalf("expected team-a in status report, got %v", report.Groups)
+	}
+	if !a.OverQuota || a.DeprioritizedJobs != 2 || a.RejectedJobs != 1 {
+		t.Errorf("unexpected status of team-a: %+v", a)
+	}
+	if !reflect.DeepEqual(a.RejectedJobNames, []string{"ns1/pg-a-pending1"}) {
+		t.Errorf("expected rejected job ns1/pg-a-pending1 of team-a, got %v", a.RejectedJobNames)
+	}
+	if cpu := a.Usage[v1.ResourceCPU]; cpu.MilliValue() != 2000 {
+		t.Errorf("expected team-a cpu usage 2, got %s", cpu.String())
+	}
+	if cpu := a.Quota[v1.ResourceCPU]; cpu.MilliValue() != 2000 {
+		t.Errorf("expected team-a cpu quota 2, got %s", cpu.String())
+	}
+
+	b := report.Groups["team-b"]
+	if b.OverQuota || b.DeprioritizedJobs != 0 || b.RejectedJobs != 0 {
+		t.Errorf("unexpected status of team-b: %+v", b)
+	}
+}
+
+func TestIntraGroupOrder(t *testing.T) {
+	created := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	podGroup := func(name, priorityClass string, age time.Duration) *vcapisv1.PodGroup {
+		pg := util.BuildPodGroupWithAnno(name, "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a"))
+		pg.Spec.PriorityClassName = priorityClass
+		pg.CreationTimestamp = metav1.NewTime(created.Add(-age))
+		return pg
+	}
+	// pg-big is the oldest job, pg-small has the highest priority and requests the least.
+	podGroups := []*vcapisv1.PodGroup{
+		podGroup("pg-big", "low", time.Hour),
+		podGroup("pg-small", "high", time.Minute),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "big", "", v1.PodPending, api.BuildResourceList("4", "4Gi"), "pg-big", nil, nil),
+		util.BuildPod("ns1", "small", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-small", nil, nil),
+	}
+
+	tests := []struct {
+		name           string
+		order          string
+		expectBigFirst bool
+	}{
+		{name: "abstain by default", expectBigFirst: true},
+		{name: "fifo", order: intraGroupOrderFIFO, expectBigFirst: true},
+		{name: "priority", order: intraGroupOrderPriority, expectBigFirst: false},
+		{name: "smallest first", order: intraGroupOrderSmallestFirst, expectBigFirst: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			arguments := framework.Arguments{
+				"annotationKey": testGroupKey,
+				"resourceMap":   map[string]interface{}{"cpu": "8"},
+			}
+			if test.order != "" {
+				arguments[intraGroupOrderKey] = test.order
+			}
+			ssn, tc := registerTestSession(&uthelper.TestCommonStruct{
+				Name:      test.name,
+				PodGroups: podGroups,
+				Pods:      pods,
+				Queues:    []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+				PriClass:  []*schedulingv1.PriorityClass{util.BuildPriorityClass("low", 1), util.BuildPriorityClass("high", 100)},
+			}, arguments)
+			defer tc.Close()
+
+			big, small := ssn.Jobs["ns1/pg-big"], ssn.Jobs["ns1/pg-small"]
+			if got := ssn.JobOrderFn(big, small); got != test.expectBigFirst {
+				t.Errorf("expected pg-big first: %v, got %v", test.expectBigFirst, got)
+			}
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	valid := framework.Arguments{
+		annotationKeyKey:         testGroupKey,
+		resourceMapKey:           map[interface{}]interface{}{"cpu": "8"},
+		maxRunTimeKey:            map[string]interface{}{"default": "2h"},
+		fairShareKey:             true,
+		groupWeightsKey:          map[string]interface{}{"team-a": 2, "team-b": "0.5"},
+		maxInqueueJobsKey:        map[string]interface{}{"team-a": 3},
+		defaultMaxInqueueJobsKey: 10,
+		windowedQuotaKey: map[string]interface{}{
+			windowedQuotaWindowKey: "24h",
+			windowedQuotaLimitsKey: map[string]interface{}{"nvidia.com/gpu": "500"},
+		},
+		statusReportKey:  map[string]interface{}{statusReportIntervalKey: "0s"},
+		usageDecayKey:    map[string]interface{}{usageDecayHalfLifeKey: "12h"},
+		countStatusesKey: []interface{}{"Allocated", "Pipelined", "Running"},
+		burstKey: map[string]interface{}{
+			burstLimitsKey:   map[string]interface{}{"cpu": "12"},
+			burstDurationKey: "1h",
+		},
+		remoteUsageKey: map[string]interface{}{
+			remoteUsageEndpointsKey:    []interface{}{"https://cluster-b.example.com/status"},
+			remoteUsageMaxStalenessKey: "10m",
+		},
+	}
+	if err := ValidateArguments(valid); err != nil {
+		t.Errorf("expected valid arguments, got %v", err)
+	}
+
+	for name, arguments := range map[string]framework.Arguments{
+		"bad quantity":            {resourceMapKey: map[string]interface{}{"cpu": "lots"}},
+		"not a map":               {resourceMapKey: "8"},
+		"bad selector":            {exemptPrioritiesKey: map[string]interface{}{"expressions": "all"}},
+		"bad maxRunTime":          {maxRunTimeKey: map[string]interface{}{"default": "forever"}},
+		"empty annotationKey":     {annotationKeyKey: ""},
+		"fairShare not a bool":    {fairShareKey: "yes"},
+		"reclaim not a bool":      {reclaimOverDeservedKey: "yes"},
+		"premium no selectors":    {premiumNodeSelectorKey: map[string]interface{}{premiumWeightKey: 5}},
+		"premium bad weight":      {premiumNodeSelectorKey: map[string]interface{}{nodePoolSelectorsKey: []interface{}{map[string]interface{}{"a": "b"}}, premiumWeightKey: 0}},
+		"zero offenders interval": {offendersReportKey: map[string]interface{}{offendersIntervalKey: 0}},
+		"offenders not a map":     {offendersReportKey: "daily"},
+		"negative group weight":   {groupWeightsKey: map[string]interface{}{"team-a": -1}},
+		"negative inqueue limit":  {maxInqueueJobsKey: map[string]interface{}{"team-a": -1}},
+		"zero inqueue limit":      {maxInqueueJobsKey: map[string]interface{}{"team-a": 0}},
+		"negative default limit":  {defaultMaxInqueueJobsKey: -1},
+		"window without limits":   {windowedQuotaKey: map[string]interface{}{windowedQuotaWindowKey: "24h"}},
+		"zero window":             {windowedQuotaKey: map[string]interface{}{windowedQuotaWindowKey: "0s", windowedQuotaLimitsKey: map[string]interface{}{"cpu": "1"}}},
+		"bad window limit":        {windowedQuotaKey: map[string]interface{}{windowedQuotaLimitsKey: map[string]interface{}{"cpu": "lots"}}},
+		"bad status interval":     {statusReportKey: map[string]interface{}{statusReportIntervalKey: "-1m"}},
+		"status report not a map": {statusReportKey: "yes"},
+		"decay without fairShare": {usageDecayKey: map[string]interface{}{}},
+		"bad decision log":        {decisionLogKey: map[string]interface{}{"path": "/tmp/decisions"}},
+		"node pool without nodes": {allowedNodeSelectorsKey: map[string]interface{}{"team-a": map[string]interface{}{}}},
+		"bad node pool selector":  {allowedNodeSelectorsKey: map[string]interface{}{"team-a": map[string]interface{}{nodePoolSelectorsKey: []interface{}{map[string]interface{}{"pool": "a b"}}}}},
+		"zero half-life":          {fairShareKey: true, usageDecayKey: map[string]interface{}{usageDecayHalfLifeKey: "0s"}},
+		"unknown count status":    {countStatusesKey: []interface{}{"Running", "Pending"}},
+		"count statuses not list": {countStatusesKey: "Running"},
+		"unknown intra order":     {intraGroupOrderKey: "largestFirst"},
+		"remote no endpoints":     {remoteUsageKey: map[string]interface{}{}},
+		"bad remote endpoint":     {remoteUsageKey: map[string]interface{}{remoteUsageEndpointsKey: []interface{}{"cluster-b"}}},
+		"zero remote timeout":     {remoteUsageKey: map[string]interface{}{remoteUsageEndpointsKey: []interface{}{"http://b"}, remoteUsageTimeoutKey: "0s"}},
+		"burst without duration":  {burstKey: map[string]interface{}{burstLimitsKey: map[string]interface{}{"cpu": "12"}}},
+		"burst without limits":    {burstKey: map[string]interface{}{burstDurationKey: "1h"}},
+		"zero burst refill":       {burstKey: map[string]interface{}{burstLimitsKey: map[string]interface{}{"cpu": "12"}, burstDurationKey: "1h", burstRefillPeriodKey: "0s"}},
+	} {
+		if err := ValidateArguments(arguments); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestVersionedArguments(t *testing.T) {
+	versioned := func(arguments framework.Arguments) framework.Arguments {
+		arguments[apiVersionKey] = ArgumentsAPIVersionV1alpha1
+		arguments[kindKey] = ArgumentsKind
+		return arguments
+	}
+
+	tests := []struct {
+		name        string
+		arguments   framework.Arguments
+		expectError bool
+	}{
+		{
+			name:      "legacy arguments ignore unknown keys",
+			arguments: framework.Arguments{resourceMapKey: map[string]interface{}{"cpu": "8"}, "resoureMap": true},
+		},
+		{
+			name:      "versioned arguments",
+			arguments: versioned(framework.Arguments{resourceMapKey: map[string]interface{}{"cpu": "8"}, fairShareKey: true}),
+		},
+		{
+			name:        "versioned arguments reject unknown keys",
+			arguments:   versioned(framework.Arguments{"resoureMap": map[string]interface{}{"cpu": "8"}}),
+			expectError: true,
+		},
+		{
+			name:        "unsupported version",
+			arguments:   framework.Arguments{apiVersionKey: "groupquota.scheduling.volcano.sh/v2"},
+			expectError: true,
+		},
+		{
+			name:        "wrong kind",
+			arguments:   framework.Arguments{apiVersionKey: ArgumentsAPIVersionV1alpha1, kindKey: "Queue"},
+			expectError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateArguments(test.arguments); (err != nil) != test.expectError {
+				t.Errorf("expected error: %v, got %v", test.expectError, err)
+			}
+		})
+	}
+
+	args := parseArguments(versioned(framework.Arguments{resourceMapKey: map[string]interface{}{"cpu": "8"}}))
+	if args.annotationKey != defaultAnnotationKey || args.quota.MilliCPU != 8000 {
+		t.Errorf("expected the versioned arguments to be parsed with their defaults, got %+v", args)
+	}
+}
+
+func TestPluginData(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "2Gi"), "pg-a-running", nil, nil),
+	}
+	ssn, tc := openTestSession("plugin data", podGroups, pods, framework.Arguments{
+		"annotationKey": testGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "2"},
+	})
+	defer tc.Close()
+
+	usage, found := framework.GetPluginData[map[string]*api.Resource](ssn, GroupUsageDataKey)
+	if !found || usage["team-a"] == nil || usage["team-a"].MilliCPU != 2000 {
+		t.Errorf("expected the usage of team-a to be published, got %v", usage)
+	}
+	overQuota, found := framework.GetPluginData[map[string]bool](ssn, OverQuotaGroupsDataKey)
+	if !found || !overQuota["team-a"] {
+		t.Errorf("expected team-a to be published as over quota, got %v", overQuota)
+	}
+}
+
+func TestGroupQuotaObjects(t *testing.T) {
+	defer func() {
+		if groupQuotaStop != nil {
+			close(groupQuotaStop)
+		}
+		groupQuotaClient, groupQuotaLister, groupQuotaStop = nil, nil, nil
+	}()
+
+	client := fakevcclient.NewSimpleClientset(
+		&vcapisv1.GroupQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: vcapisv1.GroupQuotaSpec{
+				Group:           "a",
+				Limits:          api.BuildResourceList("2", "4Gi"),
+				BorrowingPolicy: vcapisv1.BorrowingPolicyNever,
+			},
+		},
+		&vcapisv1.GroupQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a-duplicate"},
+			Spec: vcapisv1.GroupQuotaSpec{
+				Group:  "a",
+				Limits: api.BuildResourceList("20", "40Gi"),
+			},
+		},
+	)
+	lister, err := groupQuotaListerFor(client)
+	if err != nil {
+		t.Fatalf("failed to watch GroupQuota objects: %v", err)
+	}
+	if again, _ := groupQuotaListerFor(client); again != lister {
+		t.Errorf("expected the lister to be reused for the same client")
+	}
+	limits, err := loadGroupLimits(lister)
+	if err != nil {
+		t.Fatalf("failed to load group limits: %v", err)
+	}
+
+	gp := &groupquotaPlugin{
+		args:        parseArguments(framework.Arguments{resourceMapKey: map[string]interface{}{"cpu": "8"}, groupQuotasKey: true}),
+		groupLimits: limits,
+	}
+	quota, names := gp.quotaOf("a")
+	if quota.MilliCPU != 2000 || len(names) != 2 {
+		t.Errorf("expected the limits of GroupQuota team-a for group a, got <%v> on %v", quota, names)
+	}
+	quota, names = gp.quotaOf("b")
+	if quota.MilliCPU != 8000 || !reflect.DeepEqual(names, []v1.ResourceName{v1.ResourceCPU}) {
+		t.Errorf("expected the resourceMap quota for group b, got <%v> on %v", quota, names)
+	}
+	if got := gp.borrowingOf("a"); got != vcapisv1.BorrowingPolicyNever {
+		t.Errorf("expected group a not to borrow, got %s", got)
+	}
+	if got := gp.borrowingOf("b"); got != vcapisv1.BorrowingPolicyAllow {
+		t.Errorf("expected group b to borrow, got %s", got)
+	}
+	if !gp.forbidsBorrowing() {
+		t.Errorf("expected a group to forbid borrowing")
+	}
+}
+
+func TestQueueOptOut(t *testing.T) {
+	optedOut := util.BuildQueue("q2", 1, nil)
+	optedOut.Annotations = map[string]string{QueueOptOutAnnotationKey: QueueOptOutDisabled}
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-opted-out", "ns1", "q2", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending-opted-out", "ns1", "q2", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-opted-out", "node1", v1.PodRunning, api.BuildResourceList("4", "1Gi"), "pg-a-opted-out", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "a-pending-opted-out", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending-opted-out", nil, nil),
+	}
+
+	ssn, tc := openTestSessionWithQueues("queue opt out", []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil), optedOut}, podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "2"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+	})
+	defer tc.Close()
+
+	usage, found := framework.GetPluginData[map[string]*api.Resource](ssn, GroupUsageDataKey)
+	if !found {
+		t.Fatalf("expected the group usage to be published")
+	}
+	if got := usage["team-a"].MilliCPU; got != 1000 {
+		t.Errorf("expected only the job of q1 in the usage of team-a, got %v milli CPU", got)
+	}
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending"]) {
+		t.Errorf("expected the pending job of q1 to be rejected by the inqueue limit of team-a")
+	}
+	if !ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending-opted-out"]) {
+		t.Errorf("expected the pending job of the opted-out queue to be enqueueable")
+	}
+}
+
+func TestInheritNamespaceGroup(t *testing.T) {
+	namespaces := []*v1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-annotated", Annotations: groupAnno("team-a")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-labeled", Labels: groupAnno("team-b")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns-plain"}},
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroup("pg-annotated-ns", "ns-annotated", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroupWithAnno("pg-own-group", "ns-annotated", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-c")),
+		util.BuildPodGroup("pg-labeled-ns", "ns-labeled", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroup("pg-plain-ns", "ns-plain", "q1", 1, nil, vcapisv1.PodGroupRunning),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns-annotated", "annotated-ns", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-annotated-ns", nil, nil),
+		util.BuildPod("ns-annotated", "own-group", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-own-group", nil, nil),
+		util.BuildPod("ns-labeled", "labeled-ns", "node1", v1.PodRunning, api.BuildResourceList("3", "1Gi"), "pg-labeled-ns", nil, nil),
+		util.BuildPod("ns-plain", "plain-ns", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-plain-ns", nil, nil),
+	}
+
+	tests := []struct {
+		name        string
+		inherit     bool
+		expectUsage map[string]float64
+	}{
+		{
+			name:        "groups inherited from the namespaces",
+			inherit:     true,
+			expectUsage: map[string]float64{"team-a": 1000, "team-b": 3000, "team-c": 2000},
+		},
+		{
+			name:        "inheritance disabled",
+			expectUsage: map[string]float64{"team-c": 2000},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openTestSessionWithNamespaces(test.name, namespaces, podGroups, pods, framework.Arguments{
+				"annotationKey":          testGroupKey,
+				"resourceMap":            map[string]interface{}{"cpu": "4"},
+				inheritNamespaceGroupKey: test.inherit,
+			})
+			defer tc.Close()
+
+			usage, found := framework.GetPluginData[map[string]*api.Resource](ssn, GroupUsageDataKey)
+			if !found {
+				t.Fatalf("expected the group usage to be published")
+			}
+			got := map[string]float64{}
+			for group, used := range usage {
+				got[group] = used.MilliCPU
+			}
+			if !reflect.DeepEqual(got, test.expectUsage) {
+				t.Errorf("expected usage %v, got %v", test.expectUsage, got)
+			}
+		})
+	}
+}
+
+func TestCountStatuses(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a", nil, nil),
+		util.BuildPod("ns1", "a-pipelined", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("4", "1Gi"), "pg-a", nil, nil),
+	}
+	ssn, tc := openTestSession("count statuses", podGroups, pods, framework.Arguments{"annotationKey": testGroupKey})
+	defer tc.Close()
+
+	job := ssn.Jobs["ns1/pg-a"]
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if task.Name == "a-pipelined" {
+			if err := job.UpdateTaskStatus(task, api.Pipelined); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	tests := []struct {
+		name          string
+		countStatuses interface{}
+		expectCPU     float64
+	}{
+		{name: "allocated resources by default", expectCPU: 2000},
+		{name: "pipelined tasks counted", countStatuses: []interface{}{"Pipelined", "Running"}, expectCPU: 3000},
+		{name: "only running tasks counted", countStatuses: []interface{}{"Running"}, expectCPU: 2000},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gp := &groupquotaPlugin{args: parseArguments(framework.Arguments{countStatusesKey: test.countStatuses})}
+			if got := gp.jobUsage(job).MilliCPU; got != test.expectCPU {
+				t.Errorf("expected %v milli CPU, got %v", test.expectCPU, got)
+			}
+		})
+	}
+}
+
+func TestMinimalVictims(t *testing.T) {
+	node := api.NewNodeInfo(util.BuildNode("node1", api.BuildResourceList("8", "8Gi"), nil))
+	task := func(name, cpu string) *api.TaskInfo {
+		return api.NewTaskInfo(util.BuildPod("ns1", name, "node1", v1.PodRunning, api.BuildResourceList(cpu, "1Gi"), "pg", nil, nil))
+	}
+	victims := []*api.TaskInfo{task("v1", "2"), task("v2", "1"), task("v3", "1")}
+	for _, victim := range append(victims, task("other", "3")) {
+		if err := node.AddTask(victim); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nodes := map[string]*api.NodeInfo{"node1": node}
+
+	tests := []struct {
+		name          string
+		cpu           string
+		expectVictims int
+	}{
+		{name: "idle and first victims fit the preemptor", cpu: "3", expectVictims: 1},
+		{name: "prefix of the victims fits the preemptor", cpu: "4", expectVictims: 2},
+		{name: "all the victims do not fit the preemptor", cpu: "16", expectVictims: 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preemptor := api.NewTaskInfo(util.BuildPod("ns1", "preemptor", "", v1.PodPending, api.BuildResourceList(test.cpu, "1Gi"), "pg-p", nil, nil))
+			if got := minimalVictims(preemptor, victims, nodes); len(got) != test.expectVictims {
+				t.Errorf("expected %d victims, got %d", test.expectVictims, len(got))
+			}
+		})
+	}
+}
+
+func TestRejectionCondition(t *testing.T) {
+	rejectedBefore := util.BuildPodGroupWithAnno("pg-b-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-b"))
+	rejectedBefore.Status.Conditions = []vcapisv1.PodGroupCondition{{
+		Type:   vcapisv1.PodGroupConditionType(GroupQuotaExceededType),
+		Status: v1.ConditionTrue,
+		Reason: InqueueJobsLimitReason,
+	}}
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-a")),
+		rejectedBefore,
+		util.BuildPodGroupWithAnno("pg-c-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+		util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+		util.BuildPod("ns1", "b-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-b-pending", nil, nil),
+		util.BuildPod("ns1", "c-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-c-pending", nil, nil),
+	}
+
+	ssn, tc := openTestSession("rejection condition", podGroups, pods, framework.Arguments{
+		"annotationKey":   testGroupKey,
+		"resourceMap":     map[string]interface{}{"cpu": "8"},
+		maxInqueueJobsKey: map[string]interface{}{"team-a": 1},
+	})
+	defer tc.Close()
+
+	conditionOf := func(name string) *scheduling.PodGroupCondition {
+		for i, c := range ssn.Jobs[api.JobID("ns1/"+name)].PodGroup.Status.Conditions {
+			if c.Type == GroupQuotaExceededType {
+				return &ssn.Jobs[api.JobID("ns1/"+name)].PodGroup.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	if ssn.JobEnqueueable(ssn.Jobs["ns1/pg-a-pending"]) {
+		t.Fatalf("expected the pending job of team-a to be rejected by its inqueue limit")
+	}
+	if c := conditionOf("pg-a-pending"); c == nil || c.Status != v1.ConditionTrue || c.Reason != InqueueJobsLimitReason ||
+		!strings.Contains(c.Message, "limit of 1 inqueue jobs") {
+		t.Errorf("expected the rejected job to explain its inqueue limit, got %+v", c)
+	}
+
+	if !ssn.JobEnqueueable(ssn.Jobs["ns1/pg-b-pending"]) {
+		t.Fatalf("expected the pending job of team-b to be enqueueable")
+	}
+	if c := conditionOf("pg-b-pending"); c == nil || c.Status != v1.ConditionFalse || c.Reason != WithinQuotaReason {
+		t.Errorf("expected the condition of the job no longer rejected to be cleared, got %+v", c)
+	}
+
+	if !ssn.JobEnqueueable(ssn.Jobs["ns1/pg-c-pending"]) {
+		t.Fatalf("expected the pending job of team-c to be enqueueable")
+	}
+	if c := conditionOf("pg-c-pending"); c != nil {
+		t.Errorf("expected no condition on a job never rejected, got %+v", c)
+	}
+}
+
+func TestQuotaUsage(t *testing.T) {
+	gp := &groupquotaPlugin{
+		args: parseArguments(framework.Arguments{resourceMapKey: map[string]interface{}{"cpu": "2", "memory": "4Gi"}}),
+		groupUsage: map[string]*api.Resource{
+			"team-a": api.NewResource(api.BuildResourceList("3", "1Gi")),
+		},
+	}
+	if got, want := gp.quotaUsage("team-a"), "cpu usage 3 of limit 2, memory usage 1Gi of limit 4Gi"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got, want := gp.quotaUsage("team-b"), "cpu usage 0 of limit 2, memory usage 0 of limit 4Gi"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestOffendersReport(t *testing.T) {
+	defer func() { offendersSessions = 0 }()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-a-big", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-a-small", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-a")),
+		util.BuildPodGroupWithAnno("pg-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-b")),
+		util.BuildPodGroupWithAnno("pg-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, groupAnno("team-c")),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "a-big", "node1", v1.PodRunning, api.BuildResourceList("4", "1Gi"), "pg-a-big", nil, nil),
+		util.BuildPod("ns1", "a-small", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-a-small", nil, nil),
+		util.BuildPod("ns1", "b", "node1", v1.PodRunning, api.BuildResourceList("5", "1Gi"), "pg-b", nil, nil),
+		util.BuildPod("ns1", "c", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-c", nil, nil),
+	}
+	arguments := framework.Arguments{
+		"annotationKey":    testGroupKey,
+		"resourceMap":      map[string]interface{}{"cpu": "4"},
+		offendersReportKey: map[string]interface{}{offendersIntervalKey: 2, offendersTopKey: 1, offendersNamespaceKey: "ops"},
+	}
+	offenderEvents := func() []string {
+		recorder := record.NewFakeRecorder(100)
+		tc := &uthelper.TestCommonStruct{
+			Name:      "offenders report",
+			PodGroups: podGroups,
+			Pods:      pods,
+			Queues:    []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			Recorder:  recorder,
+		}
+		tc.Nodes = []*v1.Node{util.BuildNode("node1", api.BuildResourceList("16", "16Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)}
+		registerTestSession(tc, arguments)
+		tc.Close()
+		close(recorder.Events)
+
+		var events []string
+		for event := range recorder.Events {
+			if strings.Contains(event, OverQuotaOffenderReason) {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	if events := offenderEvents(); len(events) != 0 {
+		t.Errorf("expected no report before the interval, got %v", events)
+	}
+	events := offenderEvents()
+	expected := "Warning GroupQuotaOffender top groups over quota: team-a 150%"
+	if len(events) != 1 || events[0] != expected {
+		t.Errorf("expected the report %q, got %v", expected, events)
+	}
+
+	gp := &groupquotaPlugin{
+		args:            parseArguments(arguments),
+		groupUsage:      map[string]*api.Resource{"team-a": api.NewResource(api.BuildResourceList("6", "2Gi"))},
+		overQuotaGroups: map[string]bool{"team-a": true},
+		groupRatios:     map[string]float64{"team-a": 1.5},
+	}
+	jobs := map[api.JobID]*api.JobInfo{}
+	for name, cpu := range map[string]string{"a-small": "2", "a-big": "4", "b": "5"} {
+		job := api.NewJobInfo(api.JobID("ns1/" + name))
+		job.Namespace, job.Name = "ns1", name
+		job.PodGroup = &api.PodGroup{PodGroup: scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{Annotations: groupAnno("team-" + name[:1])}}}
+		job.Allocated = api.NewResource(api.BuildResourceList(cpu, "1Gi"))
+		jobs[job.UID] = job
+	}
+	offenders := gp.buildOffenders(jobs, 3)
+	if len(offenders) != 1 || !reflect.DeepEqual(offenders[0].jobs, []string{"ns1/a-big (100%)", "ns1/a-small (50%)"}) ||
+		offenders[0].usage != "cpu usage 6 of limit 4" {
+		t.Errorf("expected team-a with its jobs largest first, got %+v", offenders)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglisters "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// groupQuotaSyncTimeout bounds the wait for the first list of GroupQuota objects.
+const groupQuotaSyncTimeout = 10 * time.Second
+
+// groupLimit is the quota of one group taken from its GroupQuota object.
+type groupLimit struct {
+	quota     *api.Resource
+	names     []v1.ResourceName
+	borrowing schedulingv1beta1.BorrowingPolicy
+	// object is the GroupQuota object setting the limits.
+	object *schedulingv1beta1.GroupQuota
+}
+
+var (
+	// groupQuotaMutex guards the informer of GroupQuota objects, which outlives the
+	// plugin instance of one session.
+	groupQuotaMutex  sync.Mutex
+	groupQuotaClient vcclientset.Interface
+	groupQuotaLister schedulinglisters.GroupQuotaLister
+	groupQuotaStop   chan struct{}
+)
+
+// groupQuotaListerFor returns a lister of the GroupQuota objects served by the client,
+// starting its informer on first use.
+func groupQuotaListerFor(client vcclientset.Interface) (schedulinglisters.GroupQuotaLister, error) {
+	groupQuotaMutex.Lock()
+	defer groupQuotaMutex.Unlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("no volcano client in the session")
+	}
+	if groupQuotaClient == client {
+		return groupQuotaLister, nil
+	}
+	if groupQuotaStop != nil {
+		close(groupQuotaStop)
+	}
+
+	factory := vcinformer.NewSharedInformerFactory(client, 0)
+	informer := factory.Scheduling().V1beta1().GroupQuotas()
+	lister := informer.Lister()
+	stop := make(chan struct{})
+	factory.Start(stop)
+
+	timeout := time.AfterFunc(groupQuotaSyncTimeout, func() { close(stop) })
+	synced := cache.WaitForCacheSync(stop, informer.Informer().HasSynced)
+	if !timeout.Stop() || !synced {
+		groupQuotaClient, groupQuotaLister, groupQuotaStop = nil, nil, nil
+		return nil, fmt.Errorf("timed out waiting for the GroupQuota cache to sync")
+	}
+
+	groupQuotaClient, groupQuotaLister, groupQuotaStop = client, lister, stop
+	return lister, nil
+}
+
+// loadGroupLimits returns the limits of every group with a GroupQuota object. When several
+// objects select the same group, the one with the smallest name wins.
+func loadGroupLimits(lister schedulinglisters.GroupQuotaLister) (map[string]*groupLimit, error) {
+	gqs, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(gqs, func(i, j int) bool {
+		return gqs[i].Name < gqs[j].Name
+	})
+
+	limits := make(map[string]*groupLimit, len(gqs))
+	for _, gq := range gqs {
+		group := gq.Spec.Group
+		if _, found := limits[group]; found {
+			klog.Warningf("groupquota: GroupQuota %s ignored, group %s already has a GroupQuota", gq.Name, group)
+			continue
+		}
+		limit := &groupLimit{
+			quota:     api.NewResource(gq.Spec.Limits),
+			borrowing: gq.Spec.BorrowingPolicy,
+			object:    gq,
+		}
+		for name := range gq.Spec.Limits {
+			limit.names = append(limit.names, name)
+		}
+		limits[group] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// intraGroupOrderKey is the order of the jobs of the same group. It also orders the jobs
+// of different groups that the quotas and the fair shares do not, so that the order stays
+// transitive. By default the plugin abstains and the jobs are ordered by the next plugins.
+const intraGroupOrderKey = "intraGroupOrder"
+
+const (
+	// intraGroupOrderFIFO orders the jobs by the creation of their PodGroup, oldest first.
+	intraGroupOrderFIFO = "fifo"
+	// intraGroupOrderPriority orders the jobs by priority, highest first.
+	intraGroupOrderPriority = "priority"
+	// intraGroupOrderSmallestFirst orders the jobs by the share of the quota of their group
+	// they request, smallest first.
+	intraGroupOrderSmallestFirst = "smallestFirst"
+)
+
+// parseIntraGroupOrder parses the intraGroupOrder argument. An unknown order is ignored.
+func parseIntraGroupOrder(arg interface{}) string {
+	order, err := decodeIntraGroupOrder(arg)
+	if err != nil {
+		klog.Warningf("groupquota plugin: %v, ignoring it", err)
+	}
+	return order
+}
+
+// validateIntraGroupOrder reports the order that parseIntraGroupOrder ignores.
+func validateIntraGroupOrder(arg interface{}) []error {
+	if _, err := decodeIntraGroupOrder(arg); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+func decodeIntraGroupOrder(arg interface{}) (string, error) {
+	if arg == nil {
+		return "", nil
+	}
+	switch order, _ := arg.(string); order {
+	case intraGroupOrderFIFO, intraGroupOrderPriority, intraGroupOrderSmallestFirst:
+		return order, nil
+	}
+	return "", fmt.Errorf("%s must be one of %s, %s or %s, got %v", intraGroupOrderKey,
+		intraGroupOrderFIFO, intraGroupOrderPriority, intraGroupOrderSmallestFirst, arg)
+}
+
+// compareIntraGroup orders two jobs of the given groups by the intraGroupOrder policy.
+func (gp *groupquotaPlugin) compareIntraGroup(lGroup string, l *api.JobInfo, rGroup string, r *api.JobInfo) int {
+	switch gp.args.intraGroupOrder {
+	case intraGroupOrderFIFO:
+		if l.CreationTimestamp.Before(&r.CreationTimestamp) {
+			return -1
+		}
+		if r.CreationTimestamp.Before(&l.CreationTimestamp) {
+			return 1
+		}
+	case intraGroupOrderPriority:
+		if l.Priority > r.Priority {
+			return -1
+		}
+		if l.Priority < r.Priority {
+			return 1
+		}
+	case intraGroupOrderSmallestFirst:
+		lQuota, lNames := gp.quotaOf(lGroup)
+		rQuota, rNames := gp.quotaOf(rGroup)
+		lShare := calculateShare(l.TotalRequest, lQuota, lNames)
+		rShare := calculateShare(r.TotalRequest, rQuota, rNames)
+		if lShare < rShare {
+			return -1
+		}
+		if lShare > rShare {
+			return 1
+		}
+	}
+	return 0
+}
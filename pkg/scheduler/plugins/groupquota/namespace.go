@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// inheritNamespaceGroupKey makes the jobs whose PodGroup has no group annotation, and the
+// unmanaged pods without group, inherit the group of their namespace, found in the
+// namespace annotations or labels under annotationKey.
+const inheritNamespaceGroupKey = "inheritNamespaceGroup"
+
+// namespaceGroups resolves the group of the namespaces, each namespace being looked up
+// once per session.
+type namespaceGroups struct {
+	lister corelisters.NamespaceLister
+	key    string
+	groups map[string]string
+}
+
+func newNamespaceGroups(factory informers.SharedInformerFactory, key string) *namespaceGroups {
+	if factory == nil {
+		klog.Errorf("groupquota: no informer factory in the session, namespaces are not inherited")
+		return nil
+	}
+	return &namespaceGroups{
+		lister: factory.Core().V1().Namespaces().Lister(),
+		key:    key,
+		groups: make(map[string]string),
+	}
+}
+
+// groupOf returns the group of the namespace, or "" if it has none. A nil namespaceGroups
+// has no group.
+func (ng *namespaceGroups) groupOf(namespace string) string {
+	if ng == nil {
+		return ""
+	}
+	if group, found := ng.groups[namespace]; found {
+		return group
+	}
+
+	group := ""
+	ns, err := ng.lister.Get(namespace)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("groupquota: failed to get namespace %s: %v", namespace, err)
+		}
+	} else if value, found := ns.Annotations[ng.key]; found {
+		group = value
+	} else {
+		group = ns.Labels[ng.key]
+	}
+	ng.groups[namespace] = group
+	return group
+}
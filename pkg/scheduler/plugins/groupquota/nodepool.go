@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// allowedNodeSelectorsKey is the per-group node pool map, e.g.
+	//
+	//	allowedNodeSelectors:
+	//	  team-a:
+	//	    nodeSelectors:
+	//	    - pool: team-a
+	//	    - pool: shared
+	//	    allowSpill: true
+	allowedNodeSelectorsKey = "allowedNodeSelectors"
+
+	nodePoolSelectorsKey  = "nodeSelectors"
+	nodePoolAllowSpillKey = "allowSpill"
+)
+
+// nodePool is the set of nodes designated to one group.
+type nodePool struct {
+	// selectors are ORed, a node of the pool matches one of them.
+	selectors []labels.Selector
+	// allowSpill lets the jobs of the group run outside of the pool, which is then only
+	// preferred. Otherwise the jobs are restricted to the pool.
+	allowSpill bool
+}
+
+// parseNodePools parses the allowedNodeSelectors argument. Invalid groups are skipped.
+func parseNodePools(arg interface{}) map[string]*nodePool {
+	pools, errs := decodeNodePools(arg)
+	for _, err := range errs {
+		klog.Warningf("groupquota plugin: %v, skipping", err)
+	}
+	return pools
+}
+
+// validateNodePools reports the groups that parseNodePools skips.
+func validateNodePools(arg interface{}) []error {
+	_, errs := decodeNodePools(arg)
+	return errs
+}
+
+func decodeNodePools(arg interface{}) (map[string]*nodePool, []error) {
+	pools := make(map[string]*nodePool)
+	if arg == nil {
+		return pools, nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return pools, []error{fmt.Errorf("%s is not a map, got %T", allowedNodeSelectorsKey, arg)}
+	}
+
+	var errs []error
+	for group, v := range m {
+		pool, err := decodeNodePool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s of group %s: %v", allowedNodeSelectorsKey, group, err))
+			continue
+		}
+		pools[group] = pool
+	}
+	return pools, errs
+}
+
+func decodeNodePool(arg interface{}) (*nodePool, error) {
+	m, ok := toStringMap(arg)
+	if !ok {
+		return nil, fmt.Errorf("not a map, got %T", arg)
+	}
+	pool := &nodePool{}
+	if v, found := m[nodePoolAllowSpillKey]; found {
+		allowSpill, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a bool, got %v", nodePoolAllowSpillKey, v)
+		}
+		pool.allowSpill = allowSpill
+	}
+	selectors, err := decodeNodeSelectors(m[nodePoolSelectorsKey])
+	if err != nil {
+		return nil, err
+	}
+	pool.selectors = selectors
+	return pool, nil
+}
+
+// decodeNodeSelectors decodes a non-empty list of label maps into selectors.
+func decodeNodeSelectors(arg interface{}) ([]labels.Selector, error) {
+	list, ok := arg.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s must be a non-empty list", nodePoolSelectorsKey)
+	}
+	var selectors []labels.Selector
+	for i, s := range list {
+		sm, ok := toStringMap(s)
+		if !ok || len(sm) == 0 {
+			return nil, fmt.Errorf("%s[%d] must be a non-empty label map", nodePoolSelectorsKey, i)
+		}
+		set := labels.Set{}
+		for key, value := range sm {
+			str, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s[%d] value of %s is not a string", nodePoolSelectorsKey, i, key)
+			}
+			set[key] = str
+		}
+		selector, err := labels.ValidatedSelectorFromSet(set)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %v", nodePoolSelectorsKey, i, err)
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors, nil
+}
+
+// contains returns whether the node belongs to the pool.
+func (p *nodePool) contains(node *api.NodeInfo) bool {
+	return matchesNode(p.selectors, node)
+}
+
+// matchesNode returns whether the labels of the node match any of the selectors.
+func matchesNode(selectors []labels.Selector, node *api.NodeInfo) bool {
+	if node.Node == nil {
+		return false
+	}
+	nodeLabels := labels.Set(node.Node.Labels)
+	for _, selector := range selectors {
+		if selector.Matches(nodeLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodePoolPredicate restricts the tasks of the groups with a node pool to that pool, unless
+// the group may spill.
+func (gp *groupquotaPlugin) nodePoolPredicate(job *api.JobInfo, task *api.TaskInfo, node *api.NodeInfo) error {
+	group := gp.jobGroup(job)
+	pool, found := gp.args.nodePools[group]
+	if !found || pool.allowSpill || pool.contains(node) {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{
+		Code:   api.UnschedulableAndUnresolvable,
+		Reason: fmt.Sprintf("node is outside the node pool of group %s", group),
+		Plugin: PluginName,
+	})
+}
+
+// nodePoolScore prefers the nodes of the pool for the tasks of a group under quota. Over its
+// quota a group has no preference, its borrowed capacity is taken wherever it is free.
+func (gp *groupquotaPlugin) nodePoolScore(job *api.JobInfo, node *api.NodeInfo) float64 {
+	group := gp.jobGroup(job)
+	pool, found := gp.args.nodePools[group]
+	if !found || gp.overQuotaGroups[group] || !pool.contains(node) {
+		return 0
+	}
+	return float64(k8sframework.MaxNodeScore)
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// offendersReportKey is the section reporting, every interval sessions, the groups the
+	// most over quota and their largest jobs, e.g.
+	//
+	//	offendersReport:
+	//	  interval: 10
+	//	  top: 3
+	//	  namespace: volcano-system
+	//
+	// Each offender gets a Warning event on its GroupQuota object if it has one, and the
+	// whole report is recorded on the namespace and logged as a table.
+	offendersReportKey = "offendersReport"
+
+	offendersIntervalKey  = "interval"
+	offendersTopKey       = "top"
+	offendersNamespaceKey = "namespace"
+
+	defaultOffendersInterval  = 10
+	defaultOffendersTop       = 3
+	defaultOffendersNamespace = "volcano-system"
+
+	// OverQuotaOffenderReason is the reason of the events of the offenders report.
+	OverQuotaOffenderReason = "GroupQuotaOffender"
+)
+
+// offendersArguments configures the offenders report.
+type offendersArguments struct {
+	// interval is the number of sessions between two reports.
+	interval int
+	// top is the number of groups reported, and of jobs reported per group.
+	top int
+	// namespace receives the event of the whole report.
+	namespace string
+}
+
+var (
+	// offendersMutex guards offendersSessions, which outlives the plugin instance of one session.
+	offendersMutex    sync.Mutex
+	offendersSessions int
+)
+
+func parseOffendersReport(arg interface{}) *offendersArguments {
+	oa, errs := decodeOffendersReport(arg)
+	for _, err := range errs {
+		klog.Warningf("groupquota plugin: %v", err)
+	}
+	return oa
+}
+
+// validateOffendersReport reports the settings that parseOffendersReport ignores or falls back on.
+func validateOffendersReport(arg interface{}) []error {
+	_, errs := decodeOffendersReport(arg)
+	return errs
+}
+
+func decodeOffendersReport(arg interface{}) (*offendersArguments, []error) {
+	if arg == nil {
+		return nil, nil
+	}
+	oa := &offendersArguments{
+		interval:  defaultOffendersInterval,
+		top:       defaultOffendersTop,
+		namespace: defaultOffendersNamespace,
+	}
+	if enabled, ok := arg.(bool); ok {
+		if !enabled {
+			return nil, nil
+		}
+		return oa, nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return nil, []error{fmt.Errorf("%s is neither a bool nor a map, got %T", offendersReportKey, arg)}
+	}
+
+	var errs []error
+	if v, found := m[offendersIntervalKey]; found {
+		if interval, ok := v.(int); ok && interval > 0 {
+			oa.interval = interval
+		} else {
+			errs = append(errs, fmt.Errorf("%s %s must be a positive number of sessions, got %v, using default %d", offendersReportKey, offendersIntervalKey, v, defaultOffendersInterval))
+		}
+	}
+	if v, found := m[offendersTopKey]; found {
+		if top, ok := v.(int); ok && top > 0 {
+			oa.top = top
+		} else {
+			errs = append(errs, fmt.Errorf("%s %s must be a positive integer, got %v, using default %d", offendersReportKey, offendersTopKey, v, defaultOffendersTop))
+		}
+	}
+	if v, found := m[offendersNamespaceKey]; found {
+		if namespace, ok := v.(string); ok && namespace != "" {
+			oa.namespace = namespace
+		} else {
+			errs = append(errs, fmt.Errorf("%s %s must be a non-empty string, got %v, using default %s", offendersReportKey, offendersNamespaceKey, v, defaultOffendersNamespace))
+		}
+	}
+	return oa, errs
+}
+
+// offender is a group over quota with its largest jobs.
+type offender struct {
+	group string
+	// ratio is the usage-to-quota ratio of the group.
+	ratio float64
+	usage string
+	// jobs are the jobs using the largest share of the quota of the group, largest first,
+	// as <namespace>/<name> (<share of the quota>).
+	jobs []string
+}
+
+// dueOffendersReport counts the session and returns whether the report is due in it.
+func dueOffendersReport(oa *offendersArguments) bool {
+	offendersMutex.Lock()
+	defer offendersMutex.Unlock()
+	offendersSessions++
+	return offendersSessions%oa.interval == 0
+}
+
+// buildOffenders returns the top groups over quota by usage-to-quota ratio, the most over
+// quota first, each with its top jobs by share of the group quota.
+func (gp *groupquotaPlugin) buildOffenders(jobs map[api.JobID]*api.JobInfo, top int) []offender {
+	var offenders []offender
+	for group := range gp.overQuotaGroups {
+		offenders = append(offenders, offender{group: group, ratio: gp.groupRatios[group]})
+	}
+	sort.Slice(offenders, func(i, j int) bool {
+		if offenders[i].ratio != offenders[j].ratio {
+			return offenders[i].ratio > offenders[j].ratio
+		}
+		return offenders[i].group < offenders[j].group
+	})
+	if len(offenders) > top {
+		offenders = offenders[:top]
+	}
+
+	groupJobs := make(map[string][]*api.JobInfo, len(offenders))
+	for _, job := range gp.accountedJobs(jobs) {
+		group := gp.jobGroup(job)
+		if gp.overQuotaGroups[group] && !job.Allocated.IsEmpty() {
+			groupJobs[group] = append(groupJobs[group], job)
+		}
+	}
+	for i := range offenders {
+		o := &offenders[i]
+		quota, names := gp.quotaOf(o.group)
+		o.usage = gp.quotaUsage(o.group)
+		candidates := groupJobs[o.group]
+		shares := make(map[api.JobID]float64, len(candidates))
+		for _, job := range candidates {
+			shares[job.UID] = calculateShare(job.Allocated, quota, names)
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if shares[candidates[i].UID] != shares[candidates[j].UID] {
+				return shares[candidates[i].UID] > shares[candidates[j].UID]
+			}
+			return candidates[i].UID < candidates[j].UID
+		})
+		if len(candidates) > top {
+			candidates = candidates[:top]
+		}
+		for _, job := range candidates {
+			o.jobs = append(o.jobs, fmt.Sprintf("%s/%s (%.0f%%)", job.Namespace, job.Name, shares[job.UID]*100))
+		}
+	}
+	return offenders
+}
+
+// reportOffenders records the offenders report every interval sessions: an event on the
+// GroupQuota object of each offender, an event on the namespace with the whole report and a
+// table in the log. Nothing is reported while no group is over quota.
+func (gp *groupquotaPlugin) reportOffenders(ssn *framework.Session) {
+	oa := gp.args.offendersReport
+	if !dueOffendersReport(oa) {
+		return
+	}
+	offenders := gp.buildOffenders(ssn.Jobs, oa.top)
+	if len(offenders) == 0 {
+		return
+	}
+
+	summaries := make([]string, 0, len(offenders))
+	table := &strings.Builder{}
+	fmt.Fprintf(table, "%-20s %8s  %s", "GROUP", "RATIO", "TOP JOBS")
+	for _, o := range offenders {
+		msg := fmt.Sprintf("group %s is at %.0f%% of its quota (%s)", o.group, o.ratio*100, o.usage)
+		if len(o.jobs) > 0 {
+			msg += ", largest jobs: " + strings.Join(o.jobs, ", ")
+		}
+		if limit, found := gp.groupLimits[o.group]; found && limit.object != nil {
+			ssn.RecordEvent(limit.object, v1.EventTypeWarning, OverQuotaOffenderReason, msg)
+		}
+		summaries = append(summaries, fmt.Sprintf("%s %.0f%%", o.group, o.ratio*100))
+		fmt.Fprintf(table, "\n%-20s %7.0f%%  %s", o.group, o.ratio*100, strings.Join(o.jobs, ", "))
+	}
+	namespace := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: oa.namespace}}
+	ssn.RecordEvent(namespace, v1.EventTypeWarning, OverQuotaOffenderReason,
+		"top groups over quota: "+strings.Join(summaries, ", "))
+	klog.Infof("groupquota: top groups over quota\n%s", table.String())
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	k8sframework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// premiumNodeSelectorKey is the section keeping the tasks of the groups over quota away
+	// from premium nodes, so that the expensive hardware stays available to the groups under
+	// quota while the groups over quota still run on the standard nodes, e.g.
+	//
+	//	premiumNodeSelector:
+	//	  nodeSelectors:
+	//	  - accelerator: nvidia-a100
+	//	  enforce: false
+	//	  weight: 10
+	premiumNodeSelectorKey = "premiumNodeSelector"
+
+	defaultPremiumWeight = 10
+	premiumEnforceKey    = "enforce"
+	premiumWeightKey     = "weight"
+)
+
+// premiumNodeArguments configures the premium nodes.
+type premiumNodeArguments struct {
+	// selectors are ORed, a premium node matches one of them.
+	selectors []labels.Selector
+	// enforce keeps the tasks of the groups over quota off the premium nodes with a
+	// predicate. Otherwise the premium nodes are only scored down for them.
+	enforce bool
+	// weight is the multiple of the maximal node score taken off a premium node.
+	weight int
+}
+
+func parsePremiumNodes(arg interface{}) *premiumNodeArguments {
+	pa, errs := decodePremiumNodes(arg)
+	for _, err := range errs {
+		klog.Warningf("groupquota plugin: %v", err)
+	}
+	return pa
+}
+
+// validatePremiumNodes reports the settings that parsePremiumNodes ignores or falls back on.
+func validatePremiumNodes(arg interface{}) []error {
+	_, errs := decodePremiumNodes(arg)
+	return errs
+}
+
+func decodePremiumNodes(arg interface{}) (*premiumNodeArguments, []error) {
+	if arg == nil {
+		return nil, nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return nil, []error{fmt.Errorf("%s is not a map, got %T", premiumNodeSelectorKey, arg)}
+	}
+
+	pa := &premiumNodeArguments{weight: defaultPremiumWeight}
+	var errs []error
+	if v, found := m[premiumEnforceKey]; found {
+		if enforce, ok := v.(bool); ok {
+			pa.enforce = enforce
+		} else {
+			errs = append(errs, fmt.Errorf("%s %s must be a bool, got %v, using false", premiumNodeSelectorKey, premiumEnforceKey, v))
+		}
+	}
+	if v, found := m[premiumWeightKey]; found {
+		if weight, ok := v.(int); ok && weight > 0 {
+			pa.weight = weight
+		} else {
+			errs = append(errs, fmt.Errorf("%s %s must be a positive integer, got %v, using default %d", premiumNodeSelectorKey, premiumWeightKey, v, defaultPremiumWeight))
+		}
+	}
+	selectors, err := decodeNodeSelectors(m[nodePoolSelectorsKey])
+	if err != nil {
+		return nil, append(errs, fmt.Errorf("%s: %v, ignoring it", premiumNodeSelectorKey, err))
+	}
+	pa.selectors = selectors
+	return pa, errs
+}
+
+// premiumNodePredicate keeps the tasks of the groups over quota off the premium nodes when
+// enforced.
+func (gp *groupquotaPlugin) premiumNodePredicate(job *api.JobInfo, task *api.TaskInfo, node *api.NodeInfo) error {
+	pa := gp.args.premiumNodes
+	if pa == nil || !pa.enforce || !gp.isJobOverQuota(job) || !matchesNode(pa.selectors, node) {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{
+		Code:   api.Unschedulable,
+		Reason: fmt.Sprintf("node is premium and group %s is over quota", gp.jobGroup(job)),
+		Plugin: PluginName,
+	})
+}
+
+// premiumNodeScore scores the premium nodes down for the tasks of the groups over quota.
+func (gp *groupquotaPlugin) premiumNodeScore(job *api.JobInfo, node *api.NodeInfo) float64 {
+	pa := gp.args.premiumNodes
+	if pa == nil || pa.enforce || !gp.isJobOverQuota(job) || !matchesNode(pa.selectors, node) {
+		return 0
+	}
+	return -float64(pa.weight) * float64(k8sframework.MaxNodeScore)
+}
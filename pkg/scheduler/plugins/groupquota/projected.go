@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// countStatusesKey is the list of task statuses whose requests are accounted to the usage
+// of the groups, e.g. [Allocated, Pipelined, Binding, Bound, Running], instead of the
+// allocated resources of the jobs. Counting Pipelined tasks stops the transient
+// over-admission of a group right after large placements.
+const countStatusesKey = "countStatuses"
+
+// countableStatuses are the task statuses countStatuses may list.
+var countableStatuses = map[string]api.TaskStatus{
+	api.Allocated.String(): api.Allocated,
+	api.Pipelined.String(): api.Pipelined,
+	api.Binding.String():   api.Binding,
+	api.Bound.String():     api.Bound,
+	api.Running.String():   api.Running,
+}
+
+// parseCountStatuses parses the countStatuses argument. Unknown statuses are skipped.
+func parseCountStatuses(arg interface{}) []api.TaskStatus {
+	statuses, errs := decodeCountStatuses(arg)
+	for _, err := range errs {
+		klog.Warningf("groupquota plugin: %v, skipping", err)
+	}
+	return statuses
+}
+
+// validateCountStatuses reports the statuses that parseCountStatuses skips.
+func validateCountStatuses(arg interface{}) []error {
+	_, errs := decodeCountStatuses(arg)
+	return errs
+}
+
+func decodeCountStatuses(arg interface{}) ([]api.TaskStatus, []error) {
+	if arg == nil {
+		return nil, nil
+	}
+	list, ok := arg.([]interface{})
+	if !ok {
+		return nil, []error{fmt.Errorf("%s is not a list, got %T", countStatusesKey, arg)}
+	}
+
+	var statuses []api.TaskStatus
+	var errs []error
+	seen := map[api.TaskStatus]bool{}
+	for _, v := range list {
+		name, _ := v.(string)
+		status, found := countableStatuses[name]
+		if !found {
+			errs = append(errs, fmt.Errorf("%s: unknown task status %v", countStatusesKey, v))
+			continue
+		}
+		if !seen[status] {
+			seen[status] = true
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, errs
+}
+
+// jobUsage returns the resources of the job accounted to its group: its allocated
+// resources, or the requests of its tasks in the countStatuses when configured.
+func (gp *groupquotaPlugin) jobUsage(job *api.JobInfo) *api.Resource {
+	if len(gp.args.countStatuses) == 0 {
+		return job.Allocated
+	}
+	usage := api.EmptyResource()
+	for _, status := range gp.args.countStatuses {
+		for _, task := range job.TaskStatusIndex[status] {
+			usage.Add(task.Resreq)
+		}
+	}
+	return usage
+}
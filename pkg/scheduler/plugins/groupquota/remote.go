@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
+)
+
+const (
+	// remoteUsageKey is the section adding the usage reported by sibling clusters to the
+	// usage of the groups, so that a quota is enforced across clusters, e.g.
+	//
+	//	remoteUsage:
+	//	  endpoints:
+	//	  - https://cluster-b.example.com/groupquota/status
+	//	  maxStaleness: 5m
+	//
+	// Every endpoint serves the StatusReport of a sibling cluster as JSON, whose usage is
+	// the local usage of that cluster.
+	remoteUsageKey = "remoteUsage"
+
+	defaultRemoteMaxStaleness    = 5 * time.Minute
+	defaultRemoteRefreshInterval = 30 * time.Second
+	defaultRemoteTimeout         = 2 * time.Second
+	remoteUsageEndpointsKey      = "endpoints"
+	remoteUsageMaxStalenessKey   = "maxStaleness"
+	remoteUsageRefreshKey        = "refreshInterval"
+	remoteUsageTimeoutKey        = "timeout"
+)
+
+// remoteUsageArguments configures the sibling clusters whose usage is added to the groups.
+type remoteUsageArguments struct {
+	endpoints []string
+	// maxStaleness is the age after which the report of a sibling is ignored, so that an
+	// unreachable cluster does not hold the usage of its groups forever.
+	maxStaleness time.Duration
+	// refreshInterval is the minimal time between two fetches of the same endpoint.
+	refreshInterval time.Duration
+	timeout         time.Duration
+}
+
+// remoteReport is the last report fetched from one endpoint.
+type remoteReport struct {
+	report      *StatusReport
+	lastAttempt time.Time
+}
+
+var (
+	// remoteMutex guards the reports below, which outlive the plugin instance of one session.
+	remoteMutex   sync.Mutex
+	remoteReports = map[string]*remoteReport{}
+)
+
+func parseRemoteUsage(arg interface{}) *remoteUsageArguments {
+	ra, errs := decodeRemoteUsage(arg)
+	for _, err := range errs {
+		klog.Warningf("groupquota plugin: %v", err)
+	}
+	return ra
+}
+
+// validateRemoteUsage reports the settings that parseRemoteUsage ignores or falls back on.
+func validateRemoteUsage(arg interface{}) []error {
+	_, errs := decodeRemoteUsage(arg)
+	return errs
+}
+
+func decodeRemoteUsage(arg interface{}) (*remoteUsageArguments, []error) {
+	if arg == nil {
+		return nil, nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return nil, []error{fmt.Errorf("%s is not a map, got %T", remoteUsageKey, arg)}
+	}
+
+	ra := &remoteUsageArguments{
+		maxStaleness:    defaultRemoteMaxStaleness,
+		refreshInterval: defaultRemoteRefreshInterval,
+		timeout:         defaultRemoteTimeout,
+	}
+	var errs []error
+	for key, d := range map[string]*time.Duration{
+		remoteUsageMaxStalenessKey: &ra.maxStaleness,
+		remoteUsageRefreshKey:      &ra.refreshInterval,
+		remoteUsageTimeoutKey:      &ra.timeout,
+	} {
+		v, found := m[key]
+		if !found {
+			continue
+		}
+		value, err := parseNonNegativeDuration(v)
+		if err == nil && value == 0 && key != remoteUsageRefreshKey {
+			err = fmt.Errorf("must be positive")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %v, using default %v", remoteUsageKey, key, err, *d))
+			continue
+		}
+		*d = value
+	}
+
+	endpoints, _ := m[remoteUsageEndpointsKey].([]interface{})
+	for i, e := range endpoints {
+		endpoint, ok := e.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s %s[%d] is not a string", remoteUsageKey, remoteUsageEndpointsKey, i))
+			continue
+		}
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			errs = append(errs, fmt.Errorf("%s %s[%d] %q is not an http(s) URL", remoteUsageKey, remoteUsageEndpointsKey, i, endpoint))
+			continue
+		}
+		ra.endpoints = append(ra.endpoints, endpoint)
+	}
+	if len(ra.endpoints) == 0 {
+		return nil, append(errs, fmt.Errorf("%s has no valid %s, ignoring it", remoteUsageKey, remoteUsageEndpointsKey))
+	}
+	return ra, errs
+}
+
+// fetchRemoteUsage returns the usage of every group summed over the sibling clusters whose
+// report is fresh enough. Every endpoint is fetched at most once per refresh interval, and
+// the last report of an endpoint is kept when a fetch fails.
+func fetchRemoteUsage(ra *remoteUsageArguments) map[string]*api.Resource {
+	remoteMutex.Lock()
+	defer remoteMutex.Unlock()
+
+	current := now()
+	client := &http.Client{Timeout: ra.timeout}
+	usage := make(map[string]*api.Resource)
+	for _, endpoint := range ra.endpoints {
+		cached, found := remoteReports[endpoint]
+		if !found {
+			cached = &remoteReport{}
+			remoteReports[endpoint] = cached
+		}
+		if cached.lastAttempt.IsZero() || current.Sub(cached.lastAttempt) >= ra.refreshInterval {
+			cached.lastAttempt = current
+			if report, err := fetchStatusReport(client, endpoint); err != nil {
+				klog.Errorf("groupquota: failed to fetch the usage of sibling cluster %s: %v", endpoint, err)
+			} else {
+				cached.report = report
+			}
+		}
+
+		if cached.report == nil {
+			continue
+		}
+		if age := current.Sub(cached.report.UpdateTime.Time); age > ra.maxStaleness {
+			klog.V(3).Infof("groupquota: ignoring the usage of sibling cluster %s reported %v ago", endpoint, age)
+			continue
+		}
+		for group, status := range cached.report.Groups {
+			if _, found := usage[group]; !found {
+				usage[group] = api.EmptyResource()
+			}
+			usage[group].Add(resourceconv.FromResourceList(status.Usage))
+		}
+	}
+	return usage
+}
+
+func fetchStatusReport(client *http.Client, endpoint string) (*StatusReport, error) {
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	report := &StatusReport{}
+	if err := json.NewDecoder(resp.Body).Decode(report); err != nil {
+		return nil, fmt.Errorf("failed to decode status report: %v", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/resourceconv"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// statusReportKey is the section configuring the per-group status report.
+	statusReportKey = "statusReport"
+
+	statusReportNamespaceKey = "namespace"
+	statusReportNameKey      = "name"
+	statusReportIntervalKey  = "interval"
+
+	defaultStatusReportNamespace = "volcano-system"
+	defaultStatusReportName      = "groupquota-status"
+	defaultStatusReportInterval  = 30 * time.Second
+
+	// StatusReportDataKey is the ConfigMap data key holding the JSON encoded StatusReport.
+	StatusReportDataKey = "report"
+)
+
+// StatusReport is the standing of every group at the end of a session.
+type StatusReport struct {
+	UpdateTime metav1.Time            `json:"updateTime"`
+	Groups     map[string]GroupStatus `json:"groups"`
+}
+
+// GroupStatus is the standing of one group.
+type GroupStatus struct {
+	Usage             v1.ResourceList `json:"usage"`
+	Quota             v1.ResourceList `json:"quota"`
+	OverQuota         bool            `json:"overQuota"`
+	DeprioritizedJobs int             `json:"deprioritizedJobs"`
+	RejectedJobs      int             `json:"rejectedJobs"`
+	// RejectedJobNames are the jobs rejected in the session, as <namespace>/<name>.
+	RejectedJobNames []string `json:"rejectedJobNames,omitempty"`
+	// RemoteUsage is the usage of the group reported by sibling clusters with remoteUsage,
+	// Usage is the usage in this cluster only.
+	RemoteUsage v1.ResourceList `json:"remoteUsage,omitempty"`
+}
+
+type statusReportArguments struct {
+	namespace string
+	name      string
+	interval  time.Duration
+}
+
+var (
+	// statusMutex guards statusLastWrite, which outlives the plugin instance of one session.
+	statusMutex     sync.Mutex
+	statusLastWrite time.Time
+)
+
+func parseStatusReport(arg interface{}) *statusReportArguments {
+	if arg == nil {
+		return nil
+	}
+	sr := &statusReportArguments{
+		namespace: defaultStatusReportNamespace,
+		name:      defaultStatusReportName,
+		interval:  defaultStatusReportInterval,
+	}
+	if enabled, ok := arg.(bool); ok {
+		if !enabled {
+			return nil
+		}
+		return sr
+	}
+
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: statusReport is neither a bool nor a map, got %T", arg)
+		return nil
+	}
+	if v, ok := m[statusReportNamespaceKey].(string); ok && v != "" {
+		sr.namespace = v
+	}
+	if v, ok := m[statusReportNameKey].(string); ok && v != "" {
+		sr.name = v
+	}
+	if v, ok := m[statusReportIntervalKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			klog.Errorf("groupquota plugin: invalid statusReport interval %q, using default %v", v, defaultStatusReportInterval)
+		} else {
+			sr.interval = d
+		}
+	}
+	return sr
+}
+
+// validateStatusReport reports the settings that parseStatusReport ignores or falls back on.
+func validateStatusReport(arg interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	if _, ok := arg.(bool); ok {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is neither a bool nor a map, got %T", statusReportKey, arg)}
+	}
+	if v, found := m[statusReportIntervalKey]; found {
+		if _, err := parseNonNegativeDuration(v); err != nil {
+			return []error{fmt.Errorf("%s %s: %v", statusReportKey, statusReportIntervalKey, err)}
+		}
+	}
+	return nil
+}
+
+// buildStatusReport collects the standing of every group known in the session.
+func (gp *groupquotaPlugin) buildStatusReport(jobs map[api.JobID]*api.JobInfo) *StatusReport {
+	report := &StatusReport{
+		UpdateTime: metav1.NewTime(now()),
+		Groups:     make(map[string]GroupStatus, len(gp.groupUsage)),
+	}
+	for group, usage := range gp.groupUsage {
+		quota, names := gp.quotaOf(group)
+		usageList := resourceconv.ToResourceList(usage, append(usage.ResourceNames(), names...))
+		var remoteList v1.ResourceList
+		if remote, found := gp.remoteUsage[group]; found {
+			remoteList = resourceconv.ToResourceList(remote, remote.ResourceNames())
+			usageList = resourceconv.Sub(usageList, remoteList)
+		}
+		report.Groups[group] = GroupStatus{
+			Usage:             usageList,
+			RemoteUsage:       remoteList,
+			Quota:             resourceconv.ToResourceList(quota, names),
+			OverQuota:         gp.overQuotaGroups[group],
+			DeprioritizedJobs: gp.deprioritizedJobs[group],
+			RejectedJobs:      gp.rejectedJobs[group].Len(),
+			RejectedJobNames:  rejectedJobNames(jobs, gp.rejectedJobs[group]),
+		}
+	}
+	return report
+}
+
+// debugState is the state of the plugin served on the debug endpoint of the scheduler.
+type debugState struct {
+	*StatusReport
+	Ratios          map[string]float64 `json:"ratios"`
+	Shares          map[string]float64 `json:"shares,omitempty"`
+	InqueueJobs     map[string]int     `json:"inqueueJobs"`
+	ExhaustedGroups map[string]bool    `json:"exhaustedGroups,omitempty"`
+}
+
+// DebugState returns the usage table of the groups in the session.
+func (gp *groupquotaPlugin) DebugState(ssn *framework.Session) interface{} {
+	if gp.args == nil {
+		return nil
+	}
+	return &debugState{
+		StatusReport:    gp.buildStatusReport(ssn.Jobs),
+		Ratios:          gp.groupRatios,
+		Shares:          gp.groupShares,
+		InqueueJobs:     gp.inqueueJobs,
+		ExhaustedGroups: gp.exhaustedGroups,
+	}
+}
+
+// rejectedJobNames returns the sorted names of the rejected jobs of one group.
+func rejectedJobNames(jobs map[api.JobID]*api.JobInfo, rejected sets.Set[api.JobID]) []string {
+	if rejected.Len() == 0 {
+		return nil
+	}
+	names := make([]string, 0, rejected.Len())
+	for uid := range rejected {
+		if job, found := jobs[uid]; found {
+			names = append(names, job.Namespace+"/"+job.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// writeStatusReport writes the report to the ConfigMap, at most once per interval.
+func writeStatusReport(client kubernetes.Interface, sr *statusReportArguments, report *StatusReport) {
+	statusMutex.Lock()
+	defer statusMutex.Unlock()
+
+	if client == nil {
+		return
+	}
+	current := report.UpdateTime.Time
+	if current.Sub(statusLastWrite) < sr.interval {
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		klog.Errorf("groupquota: failed to encode status report: %v", err)
+		return
+	}
+
+	if err := util.UpsertConfigMapData(client, sr.namespace, sr.name, StatusReportDataKey, string(data)); err != nil {
+		klog.Errorf("groupquota: failed to write status report to ConfigMap %s/%s: %v", sr.namespace, sr.name, err)
+		return
+	}
+	statusLastWrite = current
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"fmt"
+	"sort"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// apiVersionKey is the version of the schema of the arguments. Arguments without it are
+	// the legacy flat map, whose unknown keys are ignored. Versioned arguments are decoded
+	// strictly, so that a misspelled or removed key is reported instead of silently ignored,
+	// e.g.
+	//
+	//	arguments:
+	//	  apiVersion: groupquota.scheduling.volcano.sh/v1alpha1
+	//	  kind: GroupQuotaArguments
+	//	  annotationKey: example.com/group
+	//	  resourceMap:
+	//	    cpu: "8"
+	apiVersionKey = "apiVersion"
+	// kindKey is the kind of the versioned arguments, ArgumentsKind if set.
+	kindKey = "kind"
+
+	// ArgumentsAPIVersionV1alpha1 is the current version of the arguments.
+	ArgumentsAPIVersionV1alpha1 = "groupquota.scheduling.volcano.sh/v1alpha1"
+	// ArgumentsKind is the kind of the versioned arguments.
+	ArgumentsKind = "GroupQuotaArguments"
+)
+
+// v1alpha1Keys are the keys of the v1alpha1 arguments, a new argument must be listed here.
+var v1alpha1Keys = map[string]bool{
+	annotationKeyKey:         true,
+	resourceMapKey:           true,
+	fairShareKey:             true,
+	groupWeightsKey:          true,
+	includeUnmanagedPodsKey:  true,
+	dominantResourceKey:      true,
+	preemptOverQuotaKey:      true,
+	minimizeVictimsKey:       true,
+	reclaimOverDeservedKey:   true,
+	maxInqueueJobsKey:        true,
+	defaultMaxInqueueJobsKey: true,
+	exemptPrioritiesKey:      true,
+	exemptWorkloadsKey:       true,
+	maxRunTimeKey:            true,
+	groupQuotasKey:           true,
+	decisionLogKey:           true,
+	allowedNodeSelectorsKey:  true,
+	countStatusesKey:         true,
+	intraGroupOrderKey:       true,
+	inheritNamespaceGroupKey: true,
+	windowedQuotaKey:         true,
+	statusReportKey:          true,
+	usageDecayKey:            true,
+	burstKey:                 true,
+	remoteUsageKey:           true,
+	premiumNodeSelectorKey:   true,
+	offendersReportKey:       true,
+}
+
+// convertArguments converts the arguments to the current version, without apiVersion and
+// kind, and returns whether they are versioned and so decoded strictly. A later version
+// converts the previous ones here, e.g. by renaming their keys.
+func convertArguments(arguments framework.Arguments) (framework.Arguments, bool, error) {
+	v, found := arguments[apiVersionKey]
+	if !found {
+		return arguments, false, nil
+	}
+	if kind, found := arguments[kindKey]; found && kind != ArgumentsKind {
+		return nil, false, fmt.Errorf("%s must be %s, got %v", kindKey, ArgumentsKind, kind)
+	}
+	if v != ArgumentsAPIVersionV1alpha1 {
+		return nil, false, fmt.Errorf("unsupported %s %v, expected %s", apiVersionKey, v, ArgumentsAPIVersionV1alpha1)
+	}
+
+	converted := make(framework.Arguments, len(arguments))
+	for key, value := range arguments {
+		if key != apiVersionKey && key != kindKey {
+			converted[key] = value
+		}
+	}
+	setDefaults(converted)
+	return converted, true, nil
+}
+
+// setDefaults sets the defaults of the versioned arguments that are not derived from the
+// other arguments.
+func setDefaults(arguments framework.Arguments) {
+	if _, found := arguments[annotationKeyKey]; !found {
+		arguments[annotationKeyKey] = defaultAnnotationKey
+	}
+}
+
+// unknownKeys returns the keys of the arguments that are not part of the current version.
+func unknownKeys(arguments framework.Arguments) []string {
+	var unknown []string
+	for key := range arguments {
+		if !v1alpha1Keys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
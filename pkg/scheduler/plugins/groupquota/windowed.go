@@ -0,0 +1,371 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// windowedQuotaKey is the section configuring resource-time budgets over a trailing window.
+	windowedQuotaKey = "windowedQuota"
+
+	defaultWindow             = 7 * 24 * time.Hour
+	defaultWindowNamespace    = "volcano-system"
+	defaultWindowConfigMap    = "groupquota-windowed-usage"
+	windowStateDataKey        = "state"
+	windowStatePersistPeriod  = time.Minute
+	windowBuckets             = 24
+	windowedQuotaWindowKey    = "window"
+	windowedQuotaLimitsKey    = "limits"
+	windowedQuotaEnforceKey   = "enforce"
+	windowedQuotaNamespaceKey = "configMapNamespace"
+	windowedQuotaNameKey      = "configMapName"
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+// windowedQuotaArguments configures budgets expressed as resource-time over a trailing window,
+// e.g. 500 GPU-hours over the last 7 days.
+type windowedQuotaArguments struct {
+	window time.Duration
+	// limits is the budget of each group over the trailing window, in resource-hours.
+	limits     *api.Resource
+	limitNames []v1.ResourceName
+	// enforce rejects the enqueue of jobs of groups that exhausted their budget,
+	// instead of only ordering them behind the other groups.
+	enforce bool
+
+	configMapNamespace string
+	configMapName      string
+}
+
+// windowState is the consumption of the trailing window, persisted in a ConfigMap so that it
+// survives scheduler restarts. The window slides: consumption is kept in buckets of
+// 1/windowBuckets of the window and expires with the bucket it was charged to.
+type windowState struct {
+	// Window is the length of the window the state was accumulated over.
+	Window     time.Duration `json:"window"`
+	LastUpdate time.Time     `json:"lastUpdate"`
+	// Buckets are ordered by start, oldest first.
+	Buckets []*windowBucket `json:"buckets"`
+}
+
+// windowBucket is the consumption charged between Start and Start plus the bucket length.
+type windowBucket struct {
+	Start time.Time `json:"start"`
+	// Consumption is the resource-hours consumed by each group, in api.Resource units.
+	Consumption map[string]map[v1.ResourceName]float64 `json:"consumption"`
+}
+
+// windowTracker is the state of one windowed quota configuration.
+type windowTracker struct {
+	state       *windowState
+	lastPersist time.Time
+}
+
+var (
+	// windowMutex guards windowTrackers, which outlive the plugin instance of one session.
+	windowMutex sync.Mutex
+	// windowTrackers holds the state of each windowed quota, by windowKey.
+	windowTrackers = map[string]*windowTracker{}
+)
+
+func parseWindowedQuota(arg interface{}) *windowedQuotaArguments {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		klog.Warningf("groupquota plugin: windowedQuota is not a map, got %T", arg)
+		return nil
+	}
+
+	wq := &windowedQuotaArguments{
+		window:             defaultWindow,
+		configMapNamespace: defaultWindowNamespace,
+		configMapName:      defaultWindowConfigMap,
+	}
+	if v, ok := m[windowedQuotaWindowKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			klog.Errorf("groupquota plugin: invalid windowedQuota window %q, using default %v", v, defaultWindow)
+		} else {
+			wq.window = d
+		}
+	}
+	if v, ok := m[windowedQuotaEnforceKey].(bool); ok {
+		wq.enforce = v
+	}
+	if v, ok := m[windowedQuotaNamespaceKey].(string); ok && v != "" {
+		wq.configMapNamespace = v
+	}
+	if v, ok := m[windowedQuotaNameKey].(string); ok && v != "" {
+		wq.configMapName = v
+	}
+
+	limits := parseResourceMap(m[windowedQuotaLimitsKey])
+	if len(limits) == 0 {
+		klog.Warningf("groupquota plugin: windowedQuota has no limits, ignoring it")
+		return nil
+	}
+	wq.limits = api.NewResource(limits)
+	for name := range limits {
+		wq.limitNames = append(wq.limitNames, name)
+	}
+	return wq
+}
+
+// validateWindowedQuota reports the settings that parseWindowedQuota ignores or falls back on.
+func validateWindowedQuota(arg interface{}) []error {
+	if arg == nil {
+		return nil
+	}
+	m, ok := toStringMap(arg)
+	if !ok {
+		return []error{fmt.Errorf("%s is not a map, got %T", windowedQuotaKey, arg)}
+	}
+
+	var errs []error
+	if v, found := m[windowedQuotaWindowKey]; found {
+		if d, err := parseNonNegativeDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %v", windowedQuotaKey, windowedQuotaWindowKey, err))
+		} else if d == 0 {
+			errs = append(errs, fmt.Errorf("%s %s must be positive", windowedQuotaKey, windowedQuotaWindowKey))
+		}
+	}
+	if v, found := m[windowedQuotaEnforceKey]; found {
+		if _, ok := v.(bool); !ok {
+			errs = append(errs, fmt.Errorf("%s %s must be a bool, got %v", windowedQuotaKey, windowedQuotaEnforceKey, v))
+		}
+	}
+	limits, found := m[windowedQuotaLimitsKey]
+	if !found {
+		errs = append(errs, fmt.Errorf("%s has no %s", windowedQuotaKey, windowedQuotaLimitsKey))
+	} else {
+		errs = append(errs, validateResourceMap(windowedQuotaKey+" "+windowedQuotaLimitsKey, limits)...)
+	}
+	return errs
+}
+
+// parseNonNegativeDuration parses a duration string, negative durations are rejected.
+func parseNonNegativeDuration(v interface{}) (time.Duration, error) {
+	str, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected a duration string, got %v", v)
+	}
+	d, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration %s", str)
+	}
+	return d, nil
+}
+
+// windowKey identifies the state of a windowed quota by its ConfigMap and window length, so
+// that scheduler configurations persisting to different ConfigMaps, or a changed window, do
+// not share state.
+func windowKey(wq *windowedQuotaArguments) string {
+	return fmt.Sprintf("%s/%s/%v", wq.configMapNamespace, wq.configMapName, wq.window)
+}
+
+// bucketLength is the length of the buckets of the window.
+func bucketLength(window time.Duration) time.Duration {
+	if length := window / windowBuckets; length > 0 {
+		return length
+	}
+	return 1
+}
+
+// bucketAt returns the bucket starting at start, appending it if it does not exist yet.
+func (s *windowState) bucketAt(start time.Time) *windowBucket {
+	if n := len(s.Buckets); n > 0 && s.Buckets[n-1].Start.Equal(start) {
+		return s.Buckets[n-1]
+	}
+	bucket := &windowBucket{Start: start, Consumption: map[string]map[v1.ResourceName]float64{}}
+	s.Buckets = append(s.Buckets, bucket)
+	return bucket
+}
+
+// charge adds the usage of every group between from and to, split across the buckets the
+// interval spans.
+func (s *windowState) charge(groupUsage map[string]*api.Resource, names []v1.ResourceName, from, to time.Time) {
+	length := bucketLength(s.Window)
+	for from.Before(to) {
+		start := from.Truncate(length)
+		end := start.Add(length)
+		if end.After(to) {
+			end = to
+		}
+		hours := end.Sub(from).Hours()
+		bucket := s.bucketAt(start)
+		for group, usage := range groupUsage {
+			consumed, found := bucket.Consumption[group]
+			if !found {
+				consumed = map[v1.ResourceName]float64{}
+				bucket.Consumption[group] = consumed
+			}
+			for _, name := range names {
+				consumed[name] += usage.Get(name) * hours
+			}
+		}
+		from = end
+	}
+}
+
+// consumption drops the buckets that left the window ending at current, and returns the
+// consumption of every group over the window. The oldest bucket, partly in the window, is
+// prorated as if its consumption were spread evenly over it.
+func (s *windowState) consumption(current time.Time) map[string]map[v1.ResourceName]float64 {
+	length := bucketLength(s.Window)
+	windowStart := current.Add(-s.Window)
+	kept := s.Buckets[:0]
+	for _, bucket := range s.Buckets {
+		if bucket.Start.Add(length).After(windowStart) {
+			kept = append(kept, bucket)
+		}
+	}
+	s.Buckets = kept
+
+	total := map[string]map[v1.ResourceName]float64{}
+	for _, bucket := range s.Buckets {
+		fraction := 1.0
+		if bucket.Start.Before(windowStart) {
+			fraction = float64(bucket.Start.Add(length).Sub(windowStart)) / float64(length)
+		}
+		for group, consumed := range bucket.Consumption {
+			if total[group] == nil {
+				total[group] = map[v1.ResourceName]float64{}
+			}
+			for name, value := range consumed {
+				total[group][name] += value * fraction
+			}
+		}
+	}
+	return total
+}
+
+// accumulateWindowedUsage charges the current usage of every group for the time elapsed
+// since the last session, and returns the groups that exhausted their budget over the
+// trailing window.
+func accumulateWindowedUsage(client kubernetes.Interface, wq *windowedQuotaArguments, groupUsage map[string]*api.Resource) map[string]bool {
+	windowMutex.Lock()
+	defer windowMutex.Unlock()
+
+	current := now()
+	key := windowKey(wq)
+	tracker, found := windowTrackers[key]
+	if !found {
+		tracker = &windowTracker{state: loadWindowState(client, wq, current)}
+		windowTrackers[key] = tracker
+	}
+	state := tracker.state
+
+	// Usage older than the window would expire at once, so it is not charged.
+	from := state.LastUpdate
+	if windowStart := current.Add(-wq.window); from.Before(windowStart) {
+		from = windowStart
+	}
+	state.charge(groupUsage, wq.limitNames, from, current)
+	if current.After(state.LastUpdate) {
+		state.LastUpdate = current
+	}
+
+	exhausted := make(map[string]bool)
+	for group, consumed := range state.consumption(current) {
+		for _, name := range wq.limitNames {
+			if consumed[name] >= wq.limits.Get(name) {
+				exhausted[group] = true
+				klog.V(4).Infof("groupquota: group %s exhausted its windowed budget of %s", group, name)
+				break
+			}
+		}
+	}
+	return exhausted
+}
+
+// loadWindowState reads the persisted state, or starts an empty one if there is none or it
+// was accumulated over another window length.
+func loadWindowState(client kubernetes.Interface, wq *windowedQuotaArguments, current time.Time) *windowState {
+	state := &windowState{Window: wq.window, LastUpdate: current}
+	if client == nil {
+		return state
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(wq.configMapNamespace).Get(context.TODO(), wq.configMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("groupquota: failed to get ConfigMap %s/%s: %v", wq.configMapNamespace, wq.configMapName, err)
+		}
+		return state
+	}
+
+	persisted := &windowState{}
+	if err := json.Unmarshal([]byte(cm.Data[windowStateDataKey]), persisted); err != nil {
+		klog.Errorf("groupquota: failed to decode windowed usage from ConfigMap %s/%s: %v", wq.configMapNamespace, wq.configMapName, err)
+		return state
+	}
+	if persisted.Window != wq.window {
+		klog.Warningf("groupquota: windowed usage in ConfigMap %s/%s was accumulated over a window of %v, not %v, starting over",
+			wq.configMapNamespace, wq.configMapName, persisted.Window, wq.window)
+		return state
+	}
+	return persisted
+}
+
+// persistWindowState writes the state to the ConfigMap, at most once per persist period.
+func persistWindowState(client kubernetes.Interface, wq *windowedQuotaArguments) {
+	windowMutex.Lock()
+	defer windowMutex.Unlock()
+
+	tracker, found := windowTrackers[windowKey(wq)]
+	if !found || client == nil {
+		return
+	}
+	current := now()
+	if current.Sub(tracker.lastPersist) < windowStatePersistPeriod {
+		return
+	}
+
+	data, err := json.Marshal(tracker.state)
+	if err != nil {
+		klog.Errorf("groupquota: failed to encode windowed usage: %v", err)
+		return
+	}
+
+	if err := util.UpsertConfigMapData(client, wq.configMapNamespace, wq.configMapName, windowStateDataKey, string(data)); err != nil {
+		klog.Errorf("groupquota: failed to persist windowed usage to ConfigMap %s/%s: %v", wq.configMapNamespace, wq.configMapName, err)
+		return
+	}
+	tracker.lastPersist = current
+}
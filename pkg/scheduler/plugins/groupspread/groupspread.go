@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package groupspread scores nodes by the group of the job, as found in the groupquota
+// annotation, so that the jobs of one group are packed in the same topology domains, e.g.
+// NVLink or InfiniBand islands, away from other groups, or spread across failure domains.
+package groupspread
+
+import (
+	"k8s.io/klog/v2"
+	k8sFramework "k8s.io/kubernetes/pkg/scheduler/framework"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "groupspread"
+
+	// ModePack places the jobs of a group in the domains already hosting the group and
+	// the fewest other groups.
+	ModePack = "pack"
+	// ModeSpread places the jobs of a group in the domains hosting the fewest of its tasks.
+	ModeSpread = "spread"
+
+	// defaultAnnotationKey is the default group annotation of the groupquota plugin.
+	defaultAnnotationKey = "example.com/group"
+	defaultTopologyKey   = "kubernetes.io/hostname"
+
+	// annotationKeyKey is the PodGroup annotation holding the group name of a job.
+	annotationKeyKey = "annotationKey"
+	// topologyKeyKey is the node label delimiting the domains, nodes without it are
+	// a domain of their own.
+	topologyKeyKey = "topologyKey"
+	// modeKey is either pack or spread.
+	modeKey = "mode"
+	// weightKey is the weight of the group score.
+	weightKey = "groupspread.weight"
+)
+
+type groupSpreadPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	annotationKey string
+	topologyKey   string
+	mode          string
+	weight        int
+
+	// domainGroups counts the allocated tasks of each group in each domain.
+	domainGroups map[string]map[string]int
+}
+
+// New return groupspread plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &groupSpreadPlugin{
+		pluginArguments: arguments,
+		annotationKey:   defaultAnnotationKey,
+		topologyKey:     defaultTopologyKey,
+		mode:            ModePack,
+		weight:          1,
+	}
+}
+
+func (gp *groupSpreadPlugin) Name() string {
+	return PluginName
+}
+
+func (gp *groupSpreadPlugin) parseArguments() {
+	if v, ok := gp.pluginArguments[annotationKeyKey].(string); ok && v != "" {
+		gp.annotationKey = v
+	}
+	if v, ok := gp.pluginArguments[topologyKeyKey].(string); ok && v != "" {
+		gp.topologyKey = v
+	}
+	if arg, ok := gp.pluginArguments[modeKey]; ok {
+		switch val, _ := arg.(string); val {
+		case ModePack, ModeSpread:
+			gp.mode = val
+		default:
+			klog.Errorf("groupspread plugin: invalid %s %v, using default %s", modeKey, arg, gp.mode)
+		}
+	}
+	gp.pluginArguments.GetInt(&gp.weight, weightKey)
+}
+
+func (gp *groupSpreadPlugin) OnSessionOpen(ssn *framework.Session) {
+	gp.parseArguments()
+
+	gp.domainGroups = make(map[string]map[string]int)
+	for _, job := range ssn.Jobs {
+		group := gp.jobGroup(job)
+		if group == "" {
+			continue
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				gp.count(gp.domainOf(ssn.Nodes[task.NodeName]), group, 1)
+			}
+		}
+	}
+
+	nodeOrderFn := func(task *api.TaskInfo, node *api.NodeInfo) (float64, error) {
+		job, found := ssn.Jobs[task.Job]
+		if !found {
+			return 0, nil
+		}
+		group := gp.jobGroup(job)
+		if group == "" {
+			return 0, nil
+		}
+		score := gp.score(group, gp.domainOf(node)) * float64(k8sFramework.MaxNodeScore*int64(gp.weight))
+		klog.V(5).Infof("groupspread score for Task %s/%s of group %s on node %s is: %v", task.Namespace, task.Name, group, node.Name, score)
+		return score, nil
+	}
+	ssn.AddNodeOrderFn(gp.Name(), nodeOrderFn)
+
+	handle := func(event *framework.Event, delta int) {
+		job, found := ssn.Jobs[event.Task.Job]
+		if !found {
+			return
+		}
+		if group := gp.jobGroup(job); group != "" {
+			gp.count(gp.domainOf(ssn.Nodes[event.Task.NodeName]), group, delta)
+		}
+	}
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			handle(event, 1)
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			handle(event, -1)
+		},
+	})
+}
+
+func (gp *groupSpreadPlugin) OnSessionClose(ssn *framework.Session) {
+	gp.domainGroups = nil
+}
+
+// score returns the preference of the group for the domain, in [0, 1].
+func (gp *groupSpreadPlugin) score(group, domain string) float64 {
+	groups := gp.domainGroups[domain]
+	if gp.mode == ModeSpread {
+		most := 0
+		for _, counts := range gp.domainGroups {
+			if counts[group] > most {
+				most = counts[group]
+			}
+		}
+		if most == 0 {
+			return 1
+		}
+		return 1 - float64(groups[group])/float64(most)
+	}
+
+	total := 0
+	for _, count := range groups {
+		total += count
+	}
+	// An empty domain is better than one shared with other groups, worse than one of the group.
+	if total == 0 {
+		return 0.5
+	}
+	return float64(groups[group]) / float64(total)
+}
+
+func (gp *groupSpreadPlugin) count(domain, group string, delta int) {
+	if domain == "" {
+		return
+	}
+	groups, found := gp.domainGroups[domain]
+	if !found {
+		groups = make(map[string]int)
+		gp.domainGroups[domain] = groups
+	}
+	groups[group] += delta
+}
+
+// domainOf returns the topology domain of the node, the node itself if it has no domain label.
+func (gp *groupSpreadPlugin) domainOf(node *api.NodeInfo) string {
+	if node == nil {
+		return ""
+	}
+	if node.Node != nil {
+		if domain, found := node.Node.Labels[gp.topologyKey]; found {
+			return domain
+		}
+	}
+	return node.Name
+}
+
+func (gp *groupSpreadPlugin) jobGroup(job *api.JobInfo) string {
+	if job.PodGroup == nil || job.PodGroup.Annotations == nil {
+		return ""
+	}
+	return job.PodGroup.Annotations[gp.annotationKey]
+}
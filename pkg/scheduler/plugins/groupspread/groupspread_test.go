@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupspread
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestGroupSpread(t *testing.T) {
+	const islandKey = "example.com/island"
+	group := func(name string) map[string]string {
+		return map[string]string{defaultAnnotationKey: name}
+	}
+	buildNode := func(name, island string) *v1.Node {
+		var labels map[string]string
+		if island != "" {
+			labels = map[string]string{islandKey: island}
+		}
+		return util.BuildNode(name, api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), labels)
+	}
+
+	tests := []struct {
+		mode string
+		// expected is the order of the nodes for a pending task of group a, best first.
+		expected []string
+	}{
+		{mode: ModePack, expected: []string{"n2", "n4", "n3"}},
+		{mode: ModeSpread, expected: []string{"n3", "n2"}},
+	}
+	for _, test := range tests {
+		t.Run(test.mode, func(t *testing.T) {
+			tc := &uthelper.TestCommonStruct{
+				Name:    test.mode,
+				Plugins: map[string]framework.PluginBuilder{PluginName: New},
+				PodGroups: []*vcapisv1.PodGroup{
+					util.BuildPodGroupWithAnno("pg-a-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, group("a")),
+					util.BuildPodGroupWithAnno("pg-b-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, group("b")),
+					util.BuildPodGroupWithAnno("pg-a-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, group("a")),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "a-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+					util.BuildPod("ns1", "a-2", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-a-running", nil, nil),
+					util.BuildPod("ns1", "b-1", "n3", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-b-running", nil, nil),
+					util.BuildPod("ns1", "a-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-a-pending", nil, nil),
+				},
+				// n4 has no island label and is a domain of its own.
+				Nodes:  []*v1.Node{buildNode("n1", "island-a"), buildNode("n2", "island-a"), buildNode("n3", "island-b"), buildNode("n4", "")},
+				Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			}
+			trueValue := true
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{{
+					Name:             PluginName,
+					EnabledNodeOrder: &trueValue,
+					Arguments:        framework.Arguments{topologyKeyKey: islandKey, modeKey: test.mode},
+				}},
+			}}
+			ssn := tc.RegisterSession(tiers, nil)
+			defer tc.Close()
+
+			var task *api.TaskInfo
+			for _, t := range ssn.Jobs["ns1/pg-a-pending"].Tasks {
+				task = t
+			}
+			for i := 0; i < len(test.expected)-1; i++ {
+				better, _ := ssn.NodeOrderFn(task, ssn.Nodes[test.expected[i]])
+				worse, _ := ssn.NodeOrderFn(task, ssn.Nodes[test.expected[i+1]])
+				if better <= worse {
+					t.Errorf("expected %s to score higher than %s, got %v and %v", test.expected[i], test.expected[i+1], better, worse)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jobdeps holds back the jobs whose dependencies are not Running or Completed yet,
+// and orders jobs after the jobs they depend on, directly or not, whatever their priority.
+// With priorityInheritance, the dependencies also run at the priority of their dependents.
+//
+// To order jobs after their dependencies and still be a total order once combined with the
+// job order of the other plugins, the plugin ranks every job: first by the highest priority
+// of the job and of its dependents not completed yet, highest first, then by the number of
+// jobs it depends on, directly or not, itself included, fewest first. A dependency always
+// ranks before its dependents, and the jobs of a dependency cycle rank alike.
+package jobdeps
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "jobdeps"
+
+	// DependsOnAnnotation is the PodGroup annotation listing the jobs a job depends on, as a
+	// comma separated list of PodGroup names, in the namespace of the job unless given as
+	// namespace/name.
+	DependsOnAnnotation = "scheduling.volcano.sh/depends-on"
+
+	// allowMissingKey treats the dependencies not found in the session, e.g. jobs completed
+	// and deleted, as satisfied.
+	allowMissingKey = "allowMissing"
+	// priorityInheritanceKey raises, for the session, the priority of the jobs not completed
+	// yet to the highest priority of the jobs depending on them, directly or not, so that
+	// they are neither preempted nor ordered behind lower priority jobs while a higher
+	// priority job waits for them.
+	priorityInheritanceKey = "priorityInheritance"
+
+	// DependenciesNotReadyReason is the reason of the event recorded for jobs held back.
+	DependenciesNotReadyReason = "DependenciesNotReady"
+)
+
+type jobDepsPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	allowMissing        bool
+	priorityInheritance bool
+	// dependencies are the direct dependencies of each job with the annotation.
+	dependencies map[api.JobID][]api.JobID
+	// ancestors are the direct and indirect dependencies of each job with the annotation.
+	ancestors map[api.JobID]map[api.JobID]bool
+	// descendants are the jobs depending on each job, directly or not.
+	descendants map[api.JobID][]api.JobID
+}
+
+// New return jobdeps plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &jobDepsPlugin{pluginArguments: arguments}
+}
+
+func (jp *jobDepsPlugin) Name() string {
+	return PluginName
+}
+
+func (jp *jobDepsPlugin) OnSessionOpen(ssn *framework.Session) {
+	jp.pluginArguments.GetBool(&jp.allowMissing, allowMissingKey)
+	jp.pluginArguments.GetBool(&jp.priorityInheritance, priorityInheritanceKey)
+
+	jp.dependencies = make(map[api.JobID][]api.JobID)
+	for _, job := range ssn.Jobs {
+		if deps := dependenciesOf(job); len(deps) > 0 {
+			jp.dependencies[job.UID] = deps
+		}
+	}
+	jp.ancestors = make(map[api.JobID]map[api.JobID]bool, len(jp.dependencies))
+	jp.descendants = make(map[api.JobID][]api.JobID)
+	for jobID := range jp.dependencies {
+		jp.ancestors[jobID] = jp.ancestorsOf(jobID)
+		if jp.ancestors[jobID][jobID] {
+			klog.Warningf("jobdeps: job %s is in a dependency cycle", jobID)
+		}
+		for ancestor := range jp.ancestors[jobID] {
+			jp.descendants[ancestor] = append(jp.descendants[ancestor], jobID)
+		}
+	}
+	if jp.priorityInheritance {
+		// The priorities are raised by the framework once every plugin opened the session,
+		// on the jobs of the session only.
+		inherited := jp.inheritedPriorities(ssn.Jobs)
+		ssn.AddJobMutateFn(jp.Name(), func(job *api.JobInfo) *api.JobMutation {
+			priority, found := inherited[job.UID]
+			if !found {
+				return nil
+			}
+			return &api.JobMutation{Priority: &priority, Reason: "inherited from its dependents"}
+		})
+	}
+
+	ssn.AddJobEnqueueableFn(jp.Name(), func(obj interface{}) int {
+		job := obj.(*api.JobInfo)
+		for _, dep := range jp.dependencies[job.UID] {
+			if ready, reason := jp.isReady(ssn, dep); !ready {
+				msg := fmt.Sprintf("dependency %s is %s", dep, reason)
+				klog.V(3).Infof("jobdeps: job <%s/%s> is not enqueued, %s", job.Namespace, job.Name, msg)
+				ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, DependenciesNotReadyReason, msg)
+				return util.Reject
+			}
+		}
+		return util.Abstain
+	})
+
+	ssn.AddJobOrderFn(jp.Name(), func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		// The priorities are read at each comparison, as the job mutate functions only
+		// apply once every plugin opened the session.
+		lPriority, rPriority := jp.rankPriority(ssn.Jobs, lv), jp.rankPriority(ssn.Jobs, rv)
+		if lPriority != rPriority {
+			if lPriority > rPriority {
+				return -1
+			}
+			return 1
+		}
+		lDepth, rDepth := jp.depth(lv.UID), jp.depth(rv.UID)
+		if lDepth < rDepth {
+			return -1
+		}
+		if lDepth > rDepth {
+			return 1
+		}
+		return 0
+	})
+}
+
+// rankPriority returns the highest priority of the job and of its dependents not completed
+// yet, so that a dependency never ranks behind one of its dependents.
+func (jp *jobDepsPlugin) rankPriority(jobs map[api.JobID]*api.JobInfo, job *api.JobInfo) int32 {
+	priority := job.Priority
+	for _, jobID := range jp.descendants[job.UID] {
+		if dependent, found := jobs[jobID]; found && !isCompleted(dependent) && dependent.Priority > priority {
+			priority = dependent.Priority
+		}
+	}
+	return priority
+}
+
+// depth returns the number of jobs the job depends on, directly or not, itself included.
+// A job depends on every dependency of its dependencies and on them, so it is deeper than
+// each of them unless they are in the same dependency cycle.
+func (jp *jobDepsPlugin) depth(jobID api.JobID) int {
+	ancestors := jp.ancestors[jobID]
+	if ancestors[jobID] {
+		return len(ancestors)
+	}
+	return len(ancestors) + 1
+}
+
+func (jp *jobDepsPlugin) OnSessionClose(ssn *framework.Session) {
+	jp.dependencies = nil
+	jp.ancestors = nil
+	jp.descendants = nil
+}
+
+// isReady returns whether the dependency is Running or Completed, or else the reason why not.
+func (jp *jobDepsPlugin) isReady(ssn *framework.Session, dep api.JobID) (bool, string) {
+	job, found := ssn.Jobs[dep]
+	if !found || job.PodGroup == nil {
+		return jp.allowMissing, "not found"
+	}
+	switch phase := job.PodGroup.Status.Phase; phase {
+	case scheduling.PodGroupRunning, scheduling.PodGroupCompleted:
+		return true, ""
+	default:
+		return false, string(phase)
+	}
+}
+
+// inheritedPriorities returns the priority the dependencies not completed yet inherit: the
+// highest priority of their dependents not completed yet, if above their own. As the
+// ancestors are transitive, a job inherits the priority of its indirect dependents too,
+// and jobs in a dependency cycle end up with the highest priority of the cycle.
+func (jp *jobDepsPlugin) inheritedPriorities(jobs map[api.JobID]*api.JobInfo) map[api.JobID]int32 {
+	inherited := make(map[api.JobID]int32)
+	for jobID, ancestors := range jp.ancestors {
+		dependent, found := jobs[jobID]
+		if !found || isCompleted(dependent) {
+			continue
+		}
+		for ancestor := range ancestors {
+			dep, found := jobs[ancestor]
+			if !found || isCompleted(dep) || dep.Priority >= dependent.Priority {
+				continue
+			}
+			if priority, found := inherited[ancestor]; !found || priority < dependent.Priority {
+				inherited[ancestor] = dependent.Priority
+			}
+		}
+	}
+	return inherited
+}
+
+// isCompleted returns whether the PodGroup of the job is Completed.
+func isCompleted(job *api.JobInfo) bool {
+	return job.PodGroup != nil && job.PodGroup.Status.Phase == scheduling.PodGroupCompleted
+}
+
+// ancestorsOf collects the direct and indirect dependencies of the job. The job is among
+// them if it is in a dependency cycle.
+func (jp *jobDepsPlugin) ancestorsOf(jobID api.JobID) map[api.JobID]bool {
+	ancestors := make(map[api.JobID]bool)
+	stack := append([]api.JobID(nil), jp.dependencies[jobID]...)
+	for len(stack) > 0 {
+		dep := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if ancestors[dep] {
+			continue
+		}
+		ancestors[dep] = true
+		stack = append(stack, jp.dependencies[dep]...)
+	}
+	return ancestors
+}
+
+// dependenciesOf parses the dependencies annotation of the job.
+func dependenciesOf(job *api.JobInfo) []api.JobID {
+	if job.PodGroup == nil {
+		return nil
+	}
+	value, found := job.PodGroup.Annotations[DependsOnAnnotation]
+	if !found {
+		return nil
+	}
+	var deps []api.JobID
+	for _, ref := range strings.Split(value, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		if !strings.Contains(ref, "/") {
+			ref = job.Namespace + "/" + ref
+		}
+		deps = append(deps, api.JobID(ref))
+	}
+	return deps
+}
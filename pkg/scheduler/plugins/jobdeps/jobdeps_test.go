@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobdeps
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestJobDeps(t *testing.T) {
+	dependsOn := func(deps string) map[string]string {
+		return map[string]string{DependsOnAnnotation: deps}
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroup("parent", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroupWithAnno("child", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dependsOn("parent")),
+		util.BuildPodGroupWithAnno("grandchild", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dependsOn(" ns1/child, parent")),
+		util.BuildPodGroupWithAnno("orphan", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dependsOn("gone")),
+		util.BuildPodGroupWithAnno("cycle-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dependsOn("cycle-b")),
+		util.BuildPodGroupWithAnno("cycle-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, dependsOn("cycle-a")),
+	}
+	var pods []*v1.Pod
+	for _, pg := range podGroups {
+		pods = append(pods, util.BuildPod("ns1", pg.Name, "", v1.PodPending, api.BuildResourceList("1", "1Gi"), pg.Name, nil, nil))
+	}
+
+	for _, allowMissing := range []bool{false, true} {
+		tc := &uthelper.TestCommonStruct{
+			Name:      "jobdeps",
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: podGroups,
+			Pods:      pods,
+			Nodes: []*v1.Node{
+				util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		}
+		trueValue := true
+		tiers := []conf.Tier{{
+			Plugins: []conf.PluginOption{{
+				Name:               PluginName,
+				EnabledJobOrder:    &trueValue,
+				EnabledJobEnqueued: &trueValue,
+				Arguments:          framework.Arguments{allowMissingKey: allowMissing},
+			}},
+		}}
+		ssn := tc.RegisterSession(tiers, nil)
+
+		for name, expected := range map[string]bool{
+			"child":      true,
+			"grandchild": false,
+			"orphan":     allowMissing,
+			"cycle-a":    false,
+		} {
+			if got := ssn.JobEnqueueable(ssn.Jobs[api.JobID("ns1/"+name)]); got != expected {
+				t.Errorf("allowMissing %v: expected job %s enqueueable %v, got %v", allowMissing, name, expected, got)
+			}
+		}
+
+		for _, pair := range [][2]string{{"parent", "child"}, {"child", "grandchild"}, {"parent", "grandchild"}} {
+			first, then := ssn.Jobs[api.JobID("ns1/"+pair[0])], ssn.Jobs[api.JobID("ns1/"+pair[1])]
+			if !ssn.JobOrderFn(first, then) || ssn.JobOrderFn(then, first) {
+				t.Errorf("expected job %s to be ordered before %s", pair[0], pair[1])
+			}
+		}
+		tc.Close()
+	}
+}
+
+func TestPriorityInheritance(t *testing.T) {
+	withDeps := func(pg *vcapisv1.PodGroup, deps string) *vcapisv1.PodGroup {
+		pg.Annotations = map[string]string{DependsOnAnnotation: deps}
+		return pg
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		withDeps(util.BuildPodGroupWithPrio("urgent", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "high"), "base, done"),
+		withDeps(util.BuildPodGroupWithPrio("base", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low"), "root"),
+		util.BuildPodGroupWithPrio("root", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low"),
+		util.BuildPodGroupWithPrio("done", "ns1", "q1", 1, nil, vcapisv1.PodGroupCompleted, "low"),
+		util.BuildPodGroupWithPrio("other", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"),
+	}
+	var pods []*v1.Pod
+	for _, pg := range podGroups {
+		pods = append(pods, util.BuildPod("ns1", pg.Name, "", v1.PodPending, api.BuildResourceList("1", "1Gi"), pg.Name, nil, nil))
+	}
+
+	for _, inheritance := range []bool{false, true} {
+		tc := &uthelper.TestCommonStruct{
+			Name:      "priority inheritance",
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: podGroups,
+			Pods:      pods,
+			Nodes: []*v1.Node{
+				util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues:   []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			PriClass: []*schedulingv1.PriorityClass{util.BuildPriorityClass("low", 1), util.BuildPriorityClass("high", 100)},
+		}
+		tiers := []conf.Tier{{
+			Plugins: []conf.PluginOption{{
+				Name:      PluginName,
+				Arguments: framework.Arguments{priorityInheritanceKey: inheritance},
+			}},
+		}}
+		ssn := tc.RegisterSession(tiers, nil)
+
+		inherited := int32(1)
+		if inheritance {
+			inherited = 100
+		}
+		for name, expected := range map[string]int32{
+			"urgent": 100,
+			"base":   inherited,
+			"root":   inherited,
+			"done":   1,
+			"other":  1,
+		} {
+			if got := ssn.Jobs[api.JobID("ns1/"+name)].Priority; got != expected {
+				t.Errorf("priorityInheritance %v: expected job %s to have priority %d, got %d", inheritance, name, expected, got)
+			}
+		}
+		tc.Close()
+	}
+}
+
+func TestJobOrderRank(t *testing.T) {
+	withDeps := func(pg *vcapisv1.PodGroup, deps string) *vcapisv1.PodGroup {
+		pg.Annotations = map[string]string{DependsOnAnnotation: deps}
+		return pg
+	}
+	podGroups := []*vcapisv1.PodGroup{
+		withDeps(util.BuildPodGroupWithPrio("dependent", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "high"), "dependency"),
+		util.BuildPodGroupWithPrio("dependency", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"),
+		util.BuildPodGroupWithPrio("mid", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "mid"),
+		util.BuildPodGroupWithPrio("low", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"),
+		withDeps(util.BuildPodGroupWithPrio("cycle-a", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"), "cycle-b"),
+		withDeps(util.BuildPodGroupWithPrio("cycle-b", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "mid"), "cycle-c"),
+		withDeps(util.BuildPodGroupWithPrio("cycle-c", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"), "cycle-a"),
+		withDeps(util.BuildPodGroupWithPrio("after-cycle", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending, "low"), "cycle-c"),
+	}
+	var pods []*v1.Pod
+	for _, pg := range podGroups {
+		pods = append(pods, util.BuildPod("ns1", pg.Name, "", v1.PodPending, api.BuildResourceList("1", "1Gi"), pg.Name, nil, nil))
+	}
+
+	for _, inheritance := range []bool{false, true} {
+		tc := &uthelper.TestCommonStruct{
+			Name:      "job order rank",
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: podGroups,
+			Pods:      pods,
+			Nodes: []*v1.Node{
+				util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			PriClass: []*schedulingv1.PriorityClass{
+				util.BuildPriorityClass("low", 1), util.BuildPriorityClass("mid", 10), util.BuildPriorityClass("high", 100),
+			},
+		}
+		trueValue := true
+		tiers := []conf.Tier{{
+			Plugins: []conf.PluginOption{{
+				Name:            PluginName,
+				EnabledJobOrder: &trueValue,
+				Arguments:       framework.Arguments{priorityInheritanceKey: inheritance},
+			}},
+		}}
+		ssn := tc.RegisterSession(tiers, nil)
+
+		jobs := make([]*api.JobInfo, 0, len(ssn.Jobs))
+		for _, job := range ssn.Jobs {
+			jobs = append(jobs, job)
+		}
+		sort.Slice(jobs, func(i, j int) bool { return ssn.JobOrderFn(jobs[i], jobs[j]) })
+		var order []string
+		for _, job := range jobs {
+			order = append(order, job.Name)
+		}
+		// The cycle ranks at its highest priority behind the jobs of the same priority without
+		// dependencies, its jobs alike and so ordered by creation then UID.
+		expected := []string{"dependency", "dependent", "mid", "cycle-a", "cycle-b", "cycle-c", "low", "after-cycle"}
+		if !reflect.DeepEqual(order, expected) {
+			t.Errorf("priorityInheritance %v: expected order %v, got %v", inheritance, expected, order)
+		}
+
+		if inheritance {
+			for _, name := range []string{"cycle-a", "cycle-b", "cycle-c"} {
+				if got := ssn.Jobs[api.JobID("ns1/"+name)].Priority; got != 10 {
+					t.Errorf("expected job %s of the cycle to inherit priority 10, got %d", name, got)
+				}
+			}
+		}
+		tc.Close()
+	}
+}
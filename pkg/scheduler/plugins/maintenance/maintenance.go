@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenance keeps tasks off the nodes about to enter a maintenance window unless
+// their max run time guarantees they finish before it, and makes the running tasks that
+// would overlap the window the preferred victims of preemption.
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "maintenance"
+
+	// WindowAnnotation is the node annotation holding its maintenance windows, as a comma
+	// separated list of RFC3339 start/end intervals, e.g. 2026-10-16T00:00:00Z/2026-10-16T04:00:00Z.
+	WindowAnnotation = "maintenance.volcano.sh/window"
+
+	// maxRunTimeKey configures the max run time of tasks without max run time annotation.
+	maxRunTimeKey = "maxRunTime"
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+// window is a maintenance window, from Start included to End excluded.
+type window struct {
+	Start time.Time
+	End   time.Time
+}
+
+type maintenancePlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	maxRunTime *runtime.Limits
+
+	// windows are the maintenance windows of each node not over yet.
+	windows map[string][]window
+	// overlappingTasks are the running tasks that would overlap the maintenance window of their node.
+	overlappingTasks map[api.TaskID]bool
+}
+
+// New return maintenance plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &maintenancePlugin{pluginArguments: arguments}
+}
+
+func (mp *maintenancePlugin) Name() string {
+	return PluginName
+}
+
+func (mp *maintenancePlugin) OnSessionOpen(ssn *framework.Session) {
+	if limits, err := runtime.Parse(mp.pluginArguments[maxRunTimeKey]); err != nil {
+		klog.Errorf("maintenance plugin: invalid %s, only the max run time annotations are used: %v", maxRunTimeKey, err)
+	} else {
+		mp.maxRunTime = limits
+	}
+	mp.maxRunTime = mp.maxRunTime.WithNamespaces(ssn.InformerFactory())
+
+	current := now()
+	mp.windows = make(map[string][]window)
+	mp.overlappingTasks = make(map[api.TaskID]bool)
+	for _, node := range ssn.Nodes {
+		if node.Node == nil {
+			continue
+		}
+		value, found := node.Node.Annotations[WindowAnnotation]
+		if !found {
+			continue
+		}
+		windows, err := parseWindows(value, current)
+		if err != nil {
+			klog.Errorf("Invalid %s annotation %q on node %s, ignoring it: %v", WindowAnnotation, value, node.Name, err)
+			continue
+		}
+		if len(windows) == 0 {
+			continue
+		}
+		mp.windows[node.Name] = windows
+
+		for _, task := range node.Tasks {
+			if !api.AllocatedStatus(task.Status) {
+				continue
+			}
+			end, found := mp.maxRunTime.Deadline(task, ssn.Jobs[task.Job])
+			if !found {
+				end = time.Time{}
+			}
+			if overlaps(windows, current, end) {
+				mp.overlappingTasks[task.UID] = true
+				klog.V(4).Infof("maintenance: task <%s/%s> would overlap the maintenance of node %s", task.Namespace, task.Name, node.Name)
+			}
+		}
+	}
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		windows, found := mp.windows[node.Name]
+		if !found {
+			return nil
+		}
+		end := time.Time{}
+		if limit, found := mp.maxRunTime.MaxRunTime(task, ssn.Jobs[task.Job]); found {
+			end = current.Add(limit)
+		}
+		if overlaps(windows, current, end) {
+			return api.NewFitError(task, node, fmt.Sprintf("task would overlap the maintenance window of node %s", node.Name))
+		}
+		return nil
+	}
+	ssn.AddPredicateFn(mp.Name(), predicateFn)
+
+	// The overlapping tasks are evicted first, whatever their job, as the maintenance would
+	// evict them anyway.
+	victimScoreFn := func(preemptor, victim *api.TaskInfo) float64 {
+		if mp.overlappingTasks[victim.UID] {
+			return 1
+		}
+		return 0
+	}
+	ssn.AddVictimScoreFn(mp.Name(), victimScoreFn)
+}
+
+func (mp *maintenancePlugin) OnSessionClose(ssn *framework.Session) {
+	mp.windows = nil
+	mp.overlappingTasks = nil
+}
+
+// overlaps returns whether a task running from start to end overlaps one of the windows.
+// A zero end means the task may run forever.
+func overlaps(windows []window, start, end time.Time) bool {
+	for _, w := range windows {
+		if !start.Before(w.End) {
+			continue
+		}
+		if end.IsZero() || end.After(w.Start) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWindows parses the windows of the annotation, skipping those over at current.
+func parseWindows(value string, current time.Time) ([]window, error) {
+	var windows []window
+	for _, interval := range strings.Split(value, ",") {
+		bounds := strings.Split(strings.TrimSpace(interval), "/")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("window %q is not a start/end interval", interval)
+		}
+		start, err := time.Parse(time.RFC3339, bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(time.RFC3339, bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if !start.Before(end) {
+			return nil, fmt.Errorf("window %q ends before it starts", interval)
+		}
+		if end.After(current) {
+			windows = append(windows, window{Start: start, End: end})
+		}
+	}
+	return windows, nil
+}
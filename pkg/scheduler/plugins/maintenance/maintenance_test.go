@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestMaintenance(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() { now = time.Now }()
+
+	buildPod := func(name, nodeName string, phase v1.PodPhase, maxRunTime string) *v1.Pod {
+		pod := util.BuildPod("ns1", name, nodeName, phase, api.BuildResourceList("1", "1Gi"), "pg-"+name, nil, nil)
+		if maxRunTime != "" {
+			pod.Annotations[runtime.MaxRunTimeAnnotation] = maxRunTime
+		}
+		if phase == v1.PodRunning {
+			startTime := metav1.NewTime(start.Add(-10 * time.Minute))
+			pod.Status.StartTime = &startTime
+		}
+		return pod
+	}
+	pods := []*v1.Pod{
+		buildPod("short", "", v1.PodPending, "30m"),
+		buildPod("long", "", v1.PodPending, "2h"),
+		buildPod("unbounded", "", v1.PodPending, ""),
+		// finishes 20m from now, before the maintenance starts
+		buildPod("running-short", "node1", v1.PodRunning, "30m"),
+		buildPod("running-unbounded", "node1", v1.PodRunning, ""),
+	}
+	var podGroups []*vcapisv1.PodGroup
+	for _, pod := range pods {
+		pg := util.BuildPodGroup("pg-"+pod.Name, "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning)
+		pg.CreationTimestamp = metav1.NewTime(start.Add(-time.Hour))
+		if pod.Name == "running-unbounded" {
+			// Without the maintenance, the victims of the older job would be evicted last.
+			pg.CreationTimestamp = metav1.NewTime(start.Add(-2 * time.Hour))
+		}
+		podGroups = append(podGroups, pg)
+	}
+
+	window := start.Add(time.Hour).Format(time.RFC3339) + "/" + start.Add(3*time.Hour).Format(time.RFC3339)
+	node1 := util.BuildNode("node1", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil)
+	node1.Annotations = map[string]string{WindowAnnotation: window}
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "maintenance",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			node1,
+			util.BuildNode("node2", api.BuildResourceList("8", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:             PluginName,
+			EnabledPredicate: &trueValue,
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	taskOf := func(name string) *api.TaskInfo {
+		for _, task := range ssn.Jobs[api.JobID("ns1/pg-"+name)].Tasks {
+			return task
+		}
+		t.Fatalf("task %s not found", name)
+		return nil
+	}
+
+	for _, tt := range []struct {
+		task     string
+		node     string
+		expected bool
+	}{
+		{task: "short", node: "node1", expected: true},
+		{task: "long", node: "node1", expected: false},
+		{task: "unbounded", node: "node1", expected: false},
+		{task: "long", node: "node2", expected: true},
+		{task: "unbounded", node: "node2", expected: true},
+	} {
+		err := ssn.PredicateFn(taskOf(tt.task), ssn.Nodes[tt.node])
+		if (err == nil) != tt.expected {
+			t.Errorf("task %s on %s: expected fit %v, got error %v", tt.task, tt.node, tt.expected, err)
+		}
+	}
+
+	// The victims belong to different jobs, the task overlapping the maintenance goes first.
+	victims := ssn.BuildVictimsPriorityQueue([]*api.TaskInfo{taskOf("running-short"), taskOf("running-unbounded")}, taskOf("short"))
+	if first := victims.Pop().(*api.TaskInfo); first.Name != "running-unbounded" {
+		t.Errorf("expected the task overlapping the maintenance to be evicted first, got %s", first.Name)
+	}
+}
+
+func TestParseWindows(t *testing.T) {
+	current := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	windows, err := parseWindows("2026-01-04T00:00:00Z/2026-01-04T02:00:00Z, 2026-01-06T00:00:00Z/2026-01-06T02:00:00Z", current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(windows) != 1 || !windows[0].Start.Equal(current.Add(24*time.Hour)) {
+		t.Errorf("expected only the upcoming window, got %v", windows)
+	}
+
+	for _, value := range []string{"2026-01-06T00:00:00Z", "2026-01-06T02:00:00Z/2026-01-06T00:00:00Z", "tomorrow/later"} {
+		if _, err := parseWindows(value, current); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota"
+	"volcano.sh/volcano/pkg/scheduler/plugins/jobdeps"
+	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const orderingGroupKey = "example.com/group"
+
+// orderingJob describes a job of the synthetic session of the ordering conformance tests.
+type orderingJob struct {
+	name      string
+	group     string
+	priority  string
+	createdAt time.Duration
+	dependsOn string
+	running   bool
+	cpu       string
+}
+
+// orderingJobs mixes priorities, groups over and under quota, creation time ties and
+// dependencies, so that every job order function of the tiers has a say.
+var orderingJobs = []orderingJob{
+	{name: "a-running", group: "team-a", priority: "mid", running: true, cpu: "6"},
+	{name: "b-running", group: "team-b", priority: "low", running: true, cpu: "1"},
+	{name: "a-high", group: "team-a", priority: "high", createdAt: 3 * time.Minute, cpu: "1"},
+	{name: "a-low-old", group: "team-a", priority: "low", createdAt: time.Minute, cpu: "2"},
+	{name: "a-low-new", group: "team-a", priority: "low", createdAt: 4 * time.Minute, cpu: "1"},
+	{name: "b-high", group: "team-b", priority: "high", createdAt: 4 * time.Minute, cpu: "3"},
+	{name: "b-mid-old", group: "team-b", priority: "mid", createdAt: time.Minute, cpu: "1"},
+	{name: "b-mid-new", group: "team-b", priority: "mid", createdAt: 2 * time.Minute, cpu: "2"},
+	{name: "b-mid-tie", group: "team-b", priority: "mid", createdAt: 2 * time.Minute, cpu: "1"},
+	{name: "c-low", group: "team-c", priority: "low", createdAt: 2 * time.Minute, cpu: "1"},
+	{name: "c-dependency", group: "team-c", priority: "low", createdAt: 5 * time.Minute, cpu: "1"},
+	{name: "c-dependent", group: "team-c", priority: "high", createdAt: 5 * time.Minute, dependsOn: "c-dependency", cpu: "1"},
+	{name: "c-chain", group: "team-c", priority: "mid", createdAt: time.Minute, dependsOn: "c-dependent", cpu: "2"},
+	{name: "ungrouped-mid", priority: "mid", createdAt: 3 * time.Minute, cpu: "1"},
+	{name: "ungrouped-low", priority: "low", createdAt: 3 * time.Minute, cpu: "1"},
+}
+
+// openOrderingSession opens a session over orderingJobs, the jobs being added to the cache
+// in the given order, with the plugins of the tiers.
+func openOrderingSession(name string, jobs []orderingJob, tiers []conf.Tier) (*framework.Session, *uthelper.TestCommonStruct) {
+	start := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	var podGroups []*vcapisv1.PodGroup
+	var pods []*v1.Pod
+	for _, job := range jobs {
+		annotations := map[string]string{}
+		if job.group != "" {
+			annotations[orderingGroupKey] = job.group
+		}
+		if job.dependsOn != "" {
+			annotations[jobdeps.DependsOnAnnotation] = job.dependsOn
+		}
+		phase, podPhase, node := vcapisv1.PodGroupInqueue, v1.PodPending, ""
+		if job.running {
+			phase, podPhase, node = vcapisv1.PodGroupRunning, v1.PodRunning, "node1"
+		}
+		pg := util.BuildPodGroupWithAnno(job.name, "ns1", "q1", 1, nil, phase, annotations)
+		pg.Spec.PriorityClassName = job.priority
+		pg.CreationTimestamp = metav1.NewTime(start.Add(job.createdAt))
+		podGroups = append(podGroups, pg)
+		pods = append(pods, util.BuildPod("ns1", job.name, node, podPhase, api.BuildResourceList(job.cpu, "1Gi"), job.name, nil, nil))
+	}
+
+	tc := &uthelper.TestCommonStruct{
+		Name: name,
+		Plugins: map[string]framework.PluginBuilder{
+			priority.PluginName:   priority.New,
+			groupquota.PluginName: groupquota.New,
+			jobdeps.PluginName:    jobdeps.New,
+		},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("16", "16Gi", []api.ScalarResource{{Name: "pods", Value: "32"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		PriClass: []*schedulingv1.PriorityClass{
+			util.BuildPriorityClass("low", 1),
+			util.BuildPriorityClass("mid", 10),
+			util.BuildPriorityClass("high", 100),
+		},
+	}
+	return tc.RegisterSession(tiers, nil), tc
+}
+
+// orderingTiers returns a tier per list of plugin options, the job order being enabled for
+// each plugin.
+func orderingTiers(tiers ...[]conf.PluginOption) []conf.Tier {
+	trueValue := true
+	var result []conf.Tier
+	for _, plugins := range tiers {
+		for i := range plugins {
+			plugins[i].EnabledJobOrder = &trueValue
+		}
+		result = append(result, conf.Tier{Plugins: plugins})
+	}
+	return result
+}
+
+func groupQuotaOrderingOption(intraGroupOrder string) conf.PluginOption {
+	arguments := framework.Arguments{
+		"annotationKey": orderingGroupKey,
+		"resourceMap":   map[string]interface{}{"cpu": "4"},
+		"fairShare":     true,
+	}
+	if intraGroupOrder != "" {
+		arguments["intraGroupOrder"] = intraGroupOrder
+	}
+	return conf.PluginOption{Name: groupquota.PluginName, Arguments: arguments}
+}
+
+// exemptingGroupQuotaOrderingOption is groupQuotaOrderingOption with the high priority jobs
+// exempt, so that the jobs of a group do not all have the share of their group.
+func exemptingGroupQuotaOrderingOption(intraGroupOrder string) conf.PluginOption {
+	option := groupQuotaOrderingOption(intraGroupOrder)
+	option.Arguments["exemptPriorities"] = map[string]interface{}{
+		"expressions": []interface{}{map[string]interface{}{"operator": "In", "values": []interface{}{100}}},
+	}
+	return option
+}
+
+// sortedJobNames returns the names of the jobs of the session sorted by its job order.
+func sortedJobNames(ssn *framework.Session) []string {
+	jobs := make([]*api.JobInfo, 0, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return ssn.JobOrderFn(jobs[i], jobs[j]) })
+	names := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		names = append(names, job.Name)
+	}
+	return names
+}
+
+// checkTotalOrder reports the pairs and triples of jobs for which the job order of the
+// session is not a strict total order: a job before itself, two jobs before each other or
+// neither before the other, or a cycle of three jobs.
+func checkTotalOrder(ssn *framework.Session) []string {
+	names := make([]string, 0, len(ssn.Jobs))
+	byName := make(map[string]*api.JobInfo, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		names = append(names, job.Name)
+		byName[job.Name] = job
+	}
+	sort.Strings(names)
+	less := func(l, r string) bool { return ssn.JobOrderFn(byName[l], byName[r]) }
+
+	var violations []string
+	for _, a := range names {
+		if less(a, a) {
+			violations = append(violations, fmt.Sprintf("%s is before itself", a))
+		}
+		for _, b := range names {
+			if a >= b {
+				continue
+			}
+			if ab, ba := less(a, b), less(b, a); ab == ba {
+				violations = append(violations, fmt.Sprintf("%s before %s is %v both ways", a, b, ab))
+			}
+			for _, c := range names {
+				if c == a || c == b {
+					continue
+				}
+				if less(a, b) && less(b, c) && !less(a, c) {
+					violations = append(violations, fmt.Sprintf("%s < %s < %s but not %s < %s", a, b, c, a, c))
+				}
+				if less(b, a) && less(a, c) && !less(b, c) {
+					violations = append(violations, fmt.Sprintf("%s < %s < %s but not %s < %s", b, a, c, b, c))
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// TestJobOrderConformance checks that the job order functions of the plugins combine into a
+// total order, stable across sessions and across the order in which the jobs are listed,
+// that keeps the jobs of equal standing in FIFO order.
+func TestJobOrderConformance(t *testing.T) {
+	tests := []struct {
+		name  string
+		tiers func() []conf.Tier
+		// fifo are the jobs expected in creation order among themselves.
+		fifo []string
+	}{
+		{
+			name: "priority then groupquota",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{{Name: priority.PluginName}, groupQuotaOrderingOption("")})
+			},
+			fifo: []string{"a-low-old", "a-low-new"},
+		},
+		{
+			name: "groupquota then priority",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{groupQuotaOrderingOption("")}, []conf.PluginOption{{Name: priority.PluginName}})
+			},
+			fifo: []string{"b-mid-old", "b-mid-new"},
+		},
+		{
+			name: "groupquota fifo within groups then priority",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{groupQuotaOrderingOption("fifo")}, []conf.PluginOption{{Name: priority.PluginName}})
+			},
+			fifo: []string{"a-low-old", "a-high", "a-low-new"},
+		},
+		{
+			name: "groupquota priority within groups",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{groupQuotaOrderingOption("priority")})
+			},
+			fifo: []string{"b-mid-old", "b-mid-new"},
+		},
+		{
+			name: "groupquota smallest first within groups",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{groupQuotaOrderingOption("smallestFirst")})
+			},
+			fifo: []string{"ungrouped-mid", "c-chain"},
+		},
+		{
+			name: "groupquota with exempt priorities then priority",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{exemptingGroupQuotaOrderingOption("")}, []conf.PluginOption{{Name: priority.PluginName}})
+			},
+			fifo: []string{"a-low-old", "a-low-new"},
+		},
+		{
+			name: "groupquota fifo within groups with exempt priorities",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{exemptingGroupQuotaOrderingOption("fifo")})
+			},
+			fifo: []string{"b-mid-old", "b-mid-new"},
+		},
+		{
+			name: "jobdeps then priority",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{{Name: jobdeps.PluginName}}, []conf.PluginOption{{Name: priority.PluginName}})
+			},
+			fifo: []string{"a-high", "b-high", "c-dependency", "c-dependent", "c-chain"},
+		},
+		{
+			name: "jobdeps then groupquota",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{{Name: jobdeps.PluginName}, groupQuotaOrderingOption("")})
+			},
+			fifo: []string{"c-dependency", "c-dependent", "c-chain"},
+		},
+		{
+			name: "jobdeps with priority inheritance then priority",
+			tiers: func() []conf.Tier {
+				return orderingTiers([]conf.PluginOption{
+					{Name: jobdeps.PluginName, Arguments: framework.Arguments{"priorityInheritance": true}},
+					{Name: priority.PluginName},
+				})
+			},
+			fifo: []string{"a-high", "b-high", "c-dependency", "c-dependent"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ssn, tc := openOrderingSession(test.name, orderingJobs, test.tiers())
+			for _, violation := range checkTotalOrder(ssn) {
+				t.Errorf("job order is not a total order: %s", violation)
+			}
+			order := sortedJobNames(ssn)
+			tc.Close()
+
+			position := make(map[string]int, len(order))
+			for i, name := range order {
+				position[name] = i
+			}
+			for i := 1; i < len(test.fifo); i++ {
+				if position[test.fifo[i-1]] > position[test.fifo[i]] {
+					t.Errorf("expected %s before %s, got order %v", test.fifo[i-1], test.fifo[i], order)
+				}
+			}
+
+			reversed := make([]orderingJob, len(orderingJobs))
+			for i, job := range orderingJobs {
+				reversed[len(orderingJobs)-1-i] = job
+			}
+			for i, jobs := range [][]orderingJob{orderingJobs, reversed} {
+				ssn, tc := openOrderingSession(test.name, jobs, test.tiers())
+				if again := sortedJobNames(ssn); !reflect.DeepEqual(again, order) {
+					t.Errorf("expected the same order in session %d, got %v instead of %v", i+2, again, order)
+				}
+				tc.Close()
+			}
+		})
+	}
+}
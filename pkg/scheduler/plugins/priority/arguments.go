@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	prioritysel "volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+const (
+	// starvingPolicyKey selects when a job is starving, i.e. may preempt.
+	starvingPolicyKey = "starvingPolicy"
+	// starvingThresholdKey is the fraction of the replicas below which a job is starving
+	// with the threshold starving policy.
+	starvingThresholdKey = "starvingThreshold"
+	// queuePriorityAwareKey only lets a task reclaim the tasks of queues of a strictly lower
+	// priority than its own queue, so that queue tiering dominates job priority for reclaim.
+	queuePriorityAwareKey = "queuePriorityAware"
+	// preferredVictimsKey lists soft preferences between the victims of preempt and reclaim,
+	// e.g.
+	//
+	//	preferredVictims:
+	//	- runningLongerThan: 24h
+	//	  weight: 100
+	//	- priorities:
+	//	    expressions:
+	//	    - operator: LessThan
+	//	      values: [100]
+	//	  weight: 10
+	//
+	// A victim scores the sum of the weights of the preferences it matches, and the victims
+	// with the highest score are evicted first. A preference never excludes a victim.
+	preferredVictimsKey = "preferredVictims"
+
+	// StarvingPolicyReplicas makes a job starving until all of its replicas are ready.
+	StarvingPolicyReplicas = "replicas"
+	// StarvingPolicyMinAvailable makes a job starving until its minAvailable and the min
+	// members of each of its tasks are ready, so that elastic jobs stop preempting once
+	// they can run.
+	StarvingPolicyMinAvailable = "minAvailable"
+	// StarvingPolicyThreshold makes a job starving until starvingThreshold of its replicas
+	// are ready.
+	StarvingPolicyThreshold = "threshold"
+)
+
+type pluginArguments struct {
+	starvingPolicy     string
+	starvingThreshold  float64
+	queuePriorityAware bool
+	preferredVictims   []preferredVictim
+}
+
+// preferredVictim is a soft preference for the victims matching all of its conditions.
+type preferredVictim struct {
+	// Priorities selects the victims by the priority of their job.
+	Priorities *prioritysel.PrioritySelector `json:"priorities"`
+	// RunningLongerThan selects the victims started at least this long ago.
+	RunningLongerThan time.Duration `json:"runningLongerThan"`
+	// Weight is added to the score of the victims matching the preference.
+	Weight float64 `json:"weight"`
+}
+
+func parseArguments(arguments framework.Arguments) *pluginArguments {
+	args := readArguments(arguments)
+	if err := args.validate(); err != nil {
+		klog.Errorf("priority plugin: %v, using starving policy %s", err, StarvingPolicyReplicas)
+		args.starvingPolicy = StarvingPolicyReplicas
+	}
+	preferred, err := parsePreferredVictims(arguments)
+	if err != nil {
+		klog.Errorf("priority plugin: %v, no victim is preferred", err)
+	}
+	args.preferredVictims = preferred
+	return args
+}
+
+// readArguments reads the arguments over the defaults, without validating them.
+func readArguments(arguments framework.Arguments) *pluginArguments {
+	args := &pluginArguments{
+		starvingPolicy:    StarvingPolicyReplicas,
+		starvingThreshold: 1,
+	}
+	arguments.GetString(&args.starvingPolicy, starvingPolicyKey)
+	arguments.GetFloat64(&args.starvingThreshold, starvingThresholdKey)
+	arguments.GetBool(&args.queuePriorityAware, queuePriorityAwareKey)
+	return args
+}
+
+func (args *pluginArguments) validate() error {
+	switch args.starvingPolicy {
+	case StarvingPolicyReplicas, StarvingPolicyMinAvailable:
+	case StarvingPolicyThreshold:
+		if args.starvingThreshold <= 0 || args.starvingThreshold > 1 {
+			return fmt.Errorf("%s must be in (0, 1], got %v", starvingThresholdKey, args.starvingThreshold)
+		}
+	default:
+		return fmt.Errorf("unknown %s %q", starvingPolicyKey, args.starvingPolicy)
+	}
+	return nil
+}
+
+// parsePreferredVictims decodes and validates the preferred victims, nil if none is configured.
+func parsePreferredVictims(arguments framework.Arguments) ([]preferredVictim, error) {
+	preferred, err := framework.GetStrict[[]preferredVictim](arguments, preferredVictimsKey)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for i, p := range preferred {
+		if p.Weight <= 0 {
+			errs = append(errs, fmt.Errorf("%s[%d]: weight must be positive, got %v", preferredVictimsKey, i, p.Weight))
+		}
+		if p.RunningLongerThan < 0 {
+			errs = append(errs, fmt.Errorf("%s[%d]: runningLongerThan must not be negative, got %v", preferredVictimsKey, i, p.RunningLongerThan))
+		}
+		if p.Priorities == nil && p.RunningLongerThan == 0 {
+			errs = append(errs, fmt.Errorf("%s[%d]: neither priorities nor runningLongerThan is set", preferredVictimsKey, i))
+		}
+		if p.Priorities != nil {
+			if err := p.Priorities.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("%s[%d]: %v", preferredVictimsKey, i, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return preferred, nil
+}
+
+// matches returns whether the victim, of a job of the given priority, matches the preference.
+func (p *preferredVictim) matches(victim *api.TaskInfo, priority int32, current time.Time) bool {
+	if p.Priorities != nil && !p.Priorities.Matches(priority) {
+		return false
+	}
+	if p.RunningLongerThan > 0 {
+		if victim.Pod == nil || victim.Pod.Status.StartTime == nil {
+			return false
+		}
+		if current.Sub(victim.Pod.Status.StartTime.Time) < p.RunningLongerThan {
+			return false
+		}
+	}
+	return true
+}
+
+// config is the shape of the arguments of the plugin, which ValidateArguments decodes them
+// into so that unknown arguments and arguments of the wrong type are reported.
+type config struct {
+	StarvingPolicy     string            `json:"starvingPolicy"`
+	StarvingThreshold  float64           `json:"starvingThreshold"`
+	QueuePriorityAware bool              `json:"queuePriorityAware"`
+	PreferredVictims   []preferredVictim `json:"preferredVictims"`
+}
+
+// ValidateArguments reports the arguments that parseArguments would ignore or fall back on.
+func ValidateArguments(arguments framework.Arguments) error {
+	if err := framework.ParseConfig(arguments, &config{}); err != nil {
+		return err
+	}
+	if _, err := parsePreferredVictims(arguments); err != nil {
+		return err
+	}
+	return readArguments(arguments).validate()
+}
+
+// isStarving returns whether the job still needs resources according to the starving policy.
+func (args *pluginArguments) isStarving(ji *api.JobInfo) bool {
+	occupied := ji.ReadyTaskNum() + ji.WaitingTaskNum()
+	switch args.starvingPolicy {
+	case StarvingPolicyMinAvailable:
+		return occupied < ji.MinAvailable || !ji.CheckTaskPipelined()
+	case StarvingPolicyThreshold:
+		return occupied < int32(math.Ceil(args.starvingThreshold*float64(len(ji.Tasks))))
+	default:
+		return occupied < int32(len(ji.Tasks))
+	}
+}
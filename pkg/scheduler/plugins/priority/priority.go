@@ -22,6 +22,8 @@ limitations under the License.
 package priority
 
 import (
+	"time"
+
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -35,6 +37,8 @@ const PluginName = "priority"
 type priorityPlugin struct {
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
+
+	args *pluginArguments
 }
 
 // New return priority plugin
@@ -47,6 +51,8 @@ func (pp *priorityPlugin) Name() string {
 }
 
 func (pp *priorityPlugin) OnSessionOpen(ssn *framework.Session) {
+	pp.args = parseArguments(pp.pluginArguments)
+
 	taskOrderFn := func(l interface{}, r interface{}) int {
 		lv := l.(*api.TaskInfo)
 		rv := r.(*api.TaskInfo)
@@ -138,11 +144,68 @@ func (pp *priorityPlugin) OnSessionOpen(ssn *framework.Session) {
 	}
 	ssn.AddPreemptableFn(pp.Name(), preemptableFn)
 
+	if pp.args.queuePriorityAware {
+		reclaimableFn := func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			reclaimerPriority := queuePriority(ssn, reclaimer)
+
+			var victims []*api.TaskInfo
+			for _, reclaimee := range reclaimees {
+				if reclaimeePriority := queuePriority(ssn, reclaimee); reclaimeePriority >= reclaimerPriority {
+					klog.V(4).Infof("Can not reclaim task <%v/%v> "+
+						"because its queue has greater or equal priority (%d) than the queue of the reclaimer (%d)",
+						reclaimee.Namespace, reclaimee.Name, reclaimeePriority, reclaimerPriority)
+					continue
+				}
+				victims = append(victims, reclaimee)
+			}
+
+			klog.V(4).Infof("Victims from Priority plugins are %+v", victims)
+			return victims, util.Permit
+		}
+		ssn.AddReclaimableFn(pp.Name(), reclaimableFn)
+	}
+
+	if len(pp.args.preferredVictims) > 0 {
+		preferred := make([]preferredVictim, len(pp.args.preferredVictims))
+		for i, p := range pp.args.preferredVictims {
+			p.Priorities = p.Priorities.Resolve(ssn.PriorityClasses)
+			preferred[i] = p
+		}
+		current := time.Now()
+		victimScoreFn := func(preemptor, victim *api.TaskInfo) float64 {
+			priority := victim.Priority
+			if job, found := ssn.Jobs[victim.Job]; found {
+				priority = job.Priority
+			}
+			score := 0.0
+			for i := range preferred {
+				if preferred[i].matches(victim, priority, current) {
+					score += preferred[i].Weight
+				}
+			}
+			return score
+		}
+		ssn.AddVictimScoreFn(pp.Name(), victimScoreFn)
+	}
+
 	jobStarvingFn := func(obj interface{}) bool {
 		ji := obj.(*api.JobInfo)
-		return ji.ReadyTaskNum()+ji.WaitingTaskNum() < int32(len(ji.Tasks))
+		return pp.args.isStarving(ji)
 	}
 	ssn.AddJobStarvingFns(pp.Name(), jobStarvingFn)
 }
 
 func (pp *priorityPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// queuePriority returns the priority of the queue of the job of the task, 0 if unknown.
+func queuePriority(ssn *framework.Session, task *api.TaskInfo) int32 {
+	job, found := ssn.Jobs[task.Job]
+	if !found {
+		return 0
+	}
+	queue, found := ssn.Queues[job.Queue]
+	if !found || queue.Queue == nil {
+		return 0
+	}
+	return queue.Queue.Spec.Priority
+}
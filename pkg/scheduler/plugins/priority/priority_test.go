@@ -18,17 +18,21 @@ package priority
 
 import (
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
 	"volcano.sh/volcano/pkg/scheduler/actions/preempt"
+	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
 	"volcano.sh/volcano/pkg/scheduler/uthelper"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
@@ -151,3 +155,226 @@ func TestPreempt(t *testing.T) {
 		})
 	}
 }
+
+func TestStarvingPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		arguments      framework.Arguments
+		expectStarving bool
+	}{
+		{
+			name:           "replicas: elastic job starves until all replicas are ready",
+			expectStarving: true,
+		},
+		{
+			name:           "minAvailable: elastic job with minAvailable ready does not starve",
+			arguments:      framework.Arguments{starvingPolicyKey: StarvingPolicyMinAvailable},
+			expectStarving: false,
+		},
+		{
+			name:           "threshold: job below the threshold of its replicas starves",
+			arguments:      framework.Arguments{starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: 0.75},
+			expectStarving: true,
+		},
+		{
+			name:           "threshold: job at the threshold of its replicas does not starve",
+			arguments:      framework.Arguments{starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: 0.5},
+			expectStarving: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc := uthelper.TestCommonStruct{
+				Name:    test.name,
+				Plugins: map[string]framework.PluginBuilder{PluginName: New},
+				PodGroups: []*vcapisv1.PodGroup{
+					util.BuildPodGroup("pg1", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("ns1", "worker-0", "node1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+					util.BuildPod("ns1", "worker-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("node1", api.BuildResourceList("2", "2G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			}
+			option := pluginEnableEvict
+			option.Arguments = test.arguments
+			ssn := tc.RegisterSession([]conf.Tier{{Plugins: []conf.PluginOption{option}}}, nil)
+			defer tc.Close()
+
+			if got := ssn.JobStarving(ssn.Jobs["ns1/pg1"]); got != test.expectStarving {
+				t.Errorf("expected starving %v, got %v", test.expectStarving, got)
+			}
+		})
+	}
+}
+
+func TestQueuePriorityAware(t *testing.T) {
+	tc := uthelper.TestCommonStruct{
+		Name:    "queue priority aware reclaim",
+		Plugins: map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: []*vcapisv1.PodGroup{
+			util.BuildPodGroup("pg-high", "ns1", "q-high", 1, nil, vcapisv1.PodGroupInqueue),
+			util.BuildPodGroup("pg-low", "ns1", "q-low", 1, nil, vcapisv1.PodGroupRunning),
+			util.BuildPodGroup("pg-peer", "ns1", "q-peer", 1, nil, vcapisv1.PodGroupRunning),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("ns1", "high", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg-high", nil, nil),
+			util.BuildPod("ns1", "low", "node1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg-low", nil, nil),
+			util.BuildPod("ns1", "peer", "node1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg-peer", nil, nil),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("2", "2G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{
+			util.BuildQueueWithPriorityAndResourcesQuantity("q-high", 10, nil, nil),
+			util.BuildQueueWithPriorityAndResourcesQuantity("q-low", 1, nil, nil),
+			util.BuildQueueWithPriorityAndResourcesQuantity("q-peer", 10, nil, nil),
+		},
+	}
+	option := pluginEnableEvict
+	option.Arguments = framework.Arguments{queuePriorityAwareKey: true}
+	ssn := tc.RegisterSession([]conf.Tier{{Plugins: []conf.PluginOption{option}}}, nil)
+	defer tc.Close()
+
+	task := func(job, name string) *api.TaskInfo {
+		for _, task := range ssn.Jobs[api.JobID("ns1/"+job)].Tasks {
+			if task.Name == name {
+				return task
+			}
+		}
+		t.Fatalf("task %s of job %s not found", name, job)
+		return nil
+	}
+	high, low, peer := task("pg-high", "high"), task("pg-low", "low"), task("pg-peer", "peer")
+
+	victims := ssn.Reclaimable(high, []*api.TaskInfo{low, peer})
+	if len(victims) != 1 || victims[0] != low {
+		t.Errorf("expected only the task of the lower priority queue to be reclaimable, got %v", victims)
+	}
+	if victims := ssn.Reclaimable(low, []*api.TaskInfo{peer}); len(victims) != 0 {
+		t.Errorf("expected no task of a higher priority queue to be reclaimable, got %v", victims)
+	}
+}
+
+// startedAgo returns a running pod of the job started the given time ago.
+func startedAgo(name, group string, ago time.Duration) *v1.Pod {
+	pod := util.BuildPod("ns1", name, "node1", v1.PodRunning, api.BuildResourceList("1", "1G"), group, map[string]string{vcapisv1.PodPreemptable: "true"}, nil)
+	pod.Status.StartTime = &metav1.Time{Time: time.Now().Add(-ago)}
+	return pod
+}
+
+func TestPreferredVictims(t *testing.T) {
+	longRunning := []interface{}{map[string]interface{}{"runningLongerThan": "1h", "weight": 100}}
+	midPriority := []interface{}{map[string]interface{}{
+		"priorities": map[string]interface{}{
+			"expressions": []interface{}{map[string]interface{}{"operator": "In", "values": []interface{}{500}}},
+		},
+		"weight": 10,
+	}}
+
+	tests := []struct {
+		name             string
+		action           framework.Action
+		preferredVictims []interface{}
+		expectEvicted    string
+	}{
+		{name: "preempt without preference", action: preempt.New(), expectEvicted: "ns1/recent-low"},
+		{name: "preempt the long running task", action: preempt.New(), preferredVictims: longRunning, expectEvicted: "ns1/old-mid"},
+		{name: "preempt the preferred priority", action: preempt.New(), preferredVictims: midPriority, expectEvicted: "ns1/old-mid"},
+		{name: "reclaim without preference", action: reclaim.New(), expectEvicted: "ns1/recent-low"},
+		{name: "reclaim the long running task", action: reclaim.New(), preferredVictims: longRunning, expectEvicted: "ns1/old-mid"},
+		{name: "reclaim the preferred priority", action: reclaim.New(), preferredVictims: midPriority, expectEvicted: "ns1/old-mid"},
+	}
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			option := pluginEnableEvict
+			if test.preferredVictims != nil {
+				option.Arguments = framework.Arguments{preferredVictimsKey: test.preferredVictims}
+			}
+			tiers := []conf.Tier{{
+				Plugins: []conf.PluginOption{
+					{Name: conformance.PluginName, EnabledPreemptable: &trueValue, EnabledReclaimable: &trueValue},
+					option,
+				},
+			}}
+			// the preemptor is in the queue of the victims for preempt, in another queue for reclaim
+			preemptorQueue := "q1"
+			if test.action.Name() == reclaim.New().Name() {
+				preemptorQueue = "q2"
+			}
+			tc := uthelper.TestCommonStruct{
+				Name: test.name,
+				Plugins: map[string]framework.PluginBuilder{
+					PluginName:             New,
+					conformance.PluginName: conformance.New,
+				},
+				PriClass: []*schedulingv1.PriorityClass{
+					util.BuildPriorityClass("low-priority", 100),
+					util.BuildPriorityClass("mid-priority", 500),
+					util.BuildPriorityClass("high-priority", 1000),
+				},
+				PodGroups: []*vcapisv1.PodGroup{
+					util.BuildPodGroupWithPrio("pg-old-mid", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "mid-priority"),
+					util.BuildPodGroupWithPrio("pg-recent-low", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, "low-priority"),
+					util.BuildPodGroupWithPrio("pg-preemptor", "ns1", preemptorQueue, 1, nil, vcapisv1.PodGroupInqueue, "high-priority"),
+				},
+				Pods: []*v1.Pod{
+					startedAgo("old-mid", "pg-old-mid", 2*time.Hour),
+					startedAgo("recent-low", "pg-recent-low", time.Minute),
+					util.BuildPod("ns1", "preemptor", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg-preemptor", nil, nil),
+				},
+				Nodes: []*v1.Node{
+					util.BuildNode("node1", api.BuildResourceList("2", "2G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+				},
+				Queues: []*vcapisv1.Queue{
+					util.BuildQueue("q1", 1, nil),
+					util.BuildQueue("q2", 1, nil),
+				},
+				ExpectEvicted:  []string{test.expectEvicted},
+				ExpectEvictNum: 1,
+			}
+			tc.RegisterSession(tiers, nil)
+			defer tc.Close()
+			tc.Run([]framework.Action{test.action})
+			if err := tc.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	for _, arguments := range []framework.Arguments{
+		nil,
+		{starvingPolicyKey: StarvingPolicyMinAvailable},
+		{starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: 0.8},
+		{starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: 1},
+		{preferredVictimsKey: []interface{}{map[string]interface{}{"runningLongerThan": "24h", "weight": 100}}},
+	} {
+		if err := ValidateArguments(arguments); err != nil {
+			t.Errorf("expected %v to be valid, got %v", arguments, err)
+		}
+	}
+	for name, arguments := range map[string]framework.Arguments{
+		"unknown policy":               {starvingPolicyKey: "always"},
+		"policy not a string":          {starvingPolicyKey: 1},
+		"threshold above one":          {starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: 2},
+		"threshold not number":         {starvingPolicyKey: StarvingPolicyThreshold, starvingThresholdKey: "half"},
+		"queue aware not bool":         {queuePriorityAwareKey: "yes"},
+		"unknown argument":             {"starvingPolcy": StarvingPolicyMinAvailable},
+		"preference without condition": {preferredVictimsKey: []interface{}{map[string]interface{}{"weight": 100}}},
+		"preference without weight":    {preferredVictimsKey: []interface{}{map[string]interface{}{"runningLongerThan": "24h"}}},
+		"preference unknown field":     {preferredVictimsKey: []interface{}{map[string]interface{}{"runningFor": "24h", "weight": 1}}},
+		"preference bad selector": {preferredVictimsKey: []interface{}{map[string]interface{}{
+			"priorities": map[string]interface{}{"expressions": []interface{}{map[string]interface{}{"operator": "Near"}}},
+			"weight":     1,
+		}}},
+	} {
+		if err := ValidateArguments(arguments); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
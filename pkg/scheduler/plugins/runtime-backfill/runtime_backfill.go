@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runtimebackfill reserves nodes for a large job starving for resources, and only
+// lets other jobs onto the reserved nodes when their max run time guarantees they finish
+// before the running tasks of those nodes, and so before the reservation is needed.
+package runtimebackfill
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "runtime-backfill"
+
+	// maxRunTimeKey configures the max run time of tasks without max run time annotation.
+	maxRunTimeKey = "maxRunTime"
+	// reserveAfterKey is how long a starving job waits before nodes are reserved for it.
+	reserveAfterKey = "reserveAfter"
+
+	defaultReserveAfter = 10 * time.Minute
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+// reservation is the set of nodes reserved for the target job.
+type reservation struct {
+	target *api.JobInfo
+	nodes  map[string]bool
+	// neededAt is the time at which the running tasks of the reserved nodes are expected to
+	// have finished, and so the time at which backfilled tasks must have finished too.
+	neededAt time.Time
+	// fittingJobs are the jobs whose pending tasks all finish before neededAt.
+	fittingJobs map[api.JobID]bool
+}
+
+type runtimeBackfillPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	maxRunTime   *runtime.Limits
+	reserveAfter time.Duration
+
+	// reservation is nil unless a job is starving for resources in the current session.
+	reservation *reservation
+}
+
+// New return runtime-backfill plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &runtimeBackfillPlugin{pluginArguments: arguments}
+}
+
+func (rp *runtimeBackfillPlugin) Name() string {
+	return PluginName
+}
+
+func (rp *runtimeBackfillPlugin) OnSessionOpen(ssn *framework.Session) {
+	rp.parseArguments()
+	rp.maxRunTime = rp.maxRunTime.WithNamespaces(ssn.InformerFactory())
+
+	current := now()
+	rp.reservation = rp.reserve(ssn, current)
+	if rp.reservation == nil {
+		return
+	}
+	klog.V(3).Infof("runtime-backfill: reserved %d nodes for job <%s/%s> until %v",
+		len(rp.reservation.nodes), rp.reservation.target.Namespace, rp.reservation.target.Name, rp.reservation.neededAt)
+
+	ssn.AddTargetJobFn(rp.Name(), func(jobs []*api.JobInfo) *api.JobInfo {
+		for _, job := range jobs {
+			if job.UID == rp.reservation.target.UID {
+				return job
+			}
+		}
+		return nil
+	})
+
+	// The target goes first, then the jobs short enough to be backfilled on the reserved nodes.
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+
+		target := rp.reservation.target.UID
+		if lv.UID == target || rv.UID == target {
+			if lv.UID == rv.UID {
+				return 0
+			}
+			if lv.UID == target {
+				return -1
+			}
+			return 1
+		}
+
+		lFits, rFits := rp.reservation.fittingJobs[lv.UID], rp.reservation.fittingJobs[rv.UID]
+		if lFits && !rFits {
+			return -1
+		}
+		if !lFits && rFits {
+			return 1
+		}
+		return 0
+	}
+	ssn.AddJobOrderFn(rp.Name(), jobOrderFn)
+
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if !rp.reservation.nodes[node.Name] || task.Job == rp.reservation.target.UID {
+			return nil
+		}
+		if rp.finishesBefore(task, ssn.Jobs[task.Job], current, rp.reservation.neededAt) {
+			return nil
+		}
+		return api.NewFitError(task, node, fmt.Sprintf("node is reserved for job %s until %s",
+			rp.reservation.target.UID, rp.reservation.neededAt.Format(time.RFC3339)))
+	}
+	ssn.AddPredicateFn(rp.Name(), predicateFn)
+}
+
+func (rp *runtimeBackfillPlugin) OnSessionClose(ssn *framework.Session) {
+	rp.reservation = nil
+}
+
+func (rp *runtimeBackfillPlugin) parseArguments() {
+	rp.reserveAfter = defaultReserveAfter
+
+	if limits, err := runtime.Parse(rp.pluginArguments[maxRunTimeKey]); err != nil {
+		klog.Errorf("runtime-backfill plugin: invalid %s, only the max run time annotations are used: %v", maxRunTimeKey, err)
+	} else {
+		rp.maxRunTime = limits
+	}
+	if v, ok := rp.pluginArguments[reserveAfterKey].(string); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			klog.Errorf("runtime-backfill plugin: invalid %s %q, using default %v", reserveAfterKey, v, defaultReserveAfter)
+		} else {
+			rp.reserveAfter = d
+		}
+	}
+}
+
+// finishesBefore returns whether the task, started at current, is guaranteed to finish by deadline.
+func (rp *runtimeBackfillPlugin) finishesBefore(task *api.TaskInfo, job *api.JobInfo, current, deadline time.Time) bool {
+	if job == nil {
+		return false
+	}
+	limit, found := rp.maxRunTime.MaxRunTime(task, job)
+	return found && !current.Add(limit).After(deadline)
+}
+
+// reserve picks as target the first job in job order which is starving since reserveAfter,
+// and reserves for it the nodes freed the earliest until their allocatable resources cover
+// its pending tasks.
+func (rp *runtimeBackfillPlugin) reserve(ssn *framework.Session, current time.Time) *reservation {
+	var candidates []*api.JobInfo
+	for _, job := range ssn.Jobs {
+		if job.IsPending() || !job.IsStarving() || !job.HasPendingTasks() {
+			continue
+		}
+		if current.Sub(job.CreationTimestamp.Time) < rp.reserveAfter {
+			continue
+		}
+		candidates = append(candidates, job)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return ssn.JobOrderFn(candidates[i], candidates[j])
+	})
+	target := candidates[0]
+
+	need := api.EmptyResource()
+	for _, task := range target.TaskStatusIndex[api.Pending] {
+		need.Add(task.Resreq)
+	}
+
+	// Nodes whose running tasks may run forever are never reserved.
+	type freeNode struct {
+		node   *api.NodeInfo
+		freeAt time.Time
+	}
+	var nodes []freeNode
+	for _, node := range ssn.Nodes {
+		if freeAt, found := rp.nodeFreeAt(ssn, node, current); found {
+			nodes = append(nodes, freeNode{node: node, freeAt: freeAt})
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].freeAt.Equal(nodes[j].freeAt) {
+			return nodes[i].freeAt.Before(nodes[j].freeAt)
+		}
+		return nodes[i].node.Name < nodes[j].node.Name
+	})
+
+	r := &reservation{target: target, nodes: map[string]bool{}}
+	reserved := api.EmptyResource()
+	for _, n := range nodes {
+		if need.LessEqual(reserved, api.Zero) {
+			break
+		}
+		r.nodes[n.node.Name] = true
+		reserved.Add(n.node.Allocatable)
+		r.neededAt = n.freeAt
+	}
+	if !need.LessEqual(reserved, api.Zero) {
+		klog.V(4).Infof("runtime-backfill: not enough nodes with a known free time for job <%s/%s>", target.Namespace, target.Name)
+		return nil
+	}
+
+	r.fittingJobs = make(map[api.JobID]bool)
+	for _, job := range ssn.Jobs {
+		if job.UID == target.UID || !job.HasPendingTasks() {
+			continue
+		}
+		fits := true
+		for _, task := range job.TaskStatusIndex[api.Pending] {
+			if !rp.finishesBefore(task, job, current, r.neededAt) {
+				fits = false
+				break
+			}
+		}
+		r.fittingJobs[job.UID] = fits
+	}
+	return r
+}
+
+// nodeFreeAt returns the time at which every task running on the node overruns its max
+// run time, and false if one of them may run forever.
+func (rp *runtimeBackfillPlugin) nodeFreeAt(ssn *framework.Session, node *api.NodeInfo, current time.Time) (time.Time, bool) {
+	freeAt := current
+	for _, task := range node.Tasks {
+		if !api.AllocatedStatus(task.Status) && task.Status != api.Releasing {
+			continue
+		}
+		deadline, found := rp.maxRunTime.Deadline(task, ssn.Jobs[task.Job])
+		if !found {
+			return time.Time{}, false
+		}
+		if deadline.After(freeAt) {
+			freeAt = deadline
+		}
+	}
+	return freeAt, true
+}
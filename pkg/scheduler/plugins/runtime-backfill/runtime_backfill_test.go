@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimebackfill
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestRuntimeBackfill(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return start }
+	defer func() { now = time.Now }()
+
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroup("pg-running1", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroup("pg-running2", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+		util.BuildPodGroup("pg-big", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue),
+		util.BuildPodGroup("pg-short", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending),
+		util.BuildPodGroup("pg-long", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending),
+		util.BuildPodGroup("pg-unknown", "ns1", "q1", 1, nil, vcapisv1.PodGroupPending),
+	}
+	podGroups[2].CreationTimestamp = metav1.NewTime(start.Add(-30 * time.Minute))
+
+	withMaxRunTime := func(pod *v1.Pod, maxRunTime string) *v1.Pod {
+		pod.Annotations[runtime.MaxRunTimeAnnotation] = maxRunTime
+		return pod
+	}
+	started := metav1.NewTime(start.Add(-30 * time.Minute))
+	// running1 frees node1 at start+30m, running2 frees node2 at start+2h30m
+	running1 := withMaxRunTime(util.BuildPod("ns1", "running1", "node1", v1.PodRunning, api.BuildResourceList("4", "4Gi"), "pg-running1", nil, nil), "1h")
+	running2 := withMaxRunTime(util.BuildPod("ns1", "running2", "node2", v1.PodRunning, api.BuildResourceList("4", "4Gi"), "pg-running2", nil, nil), "3h")
+	running1.Status.StartTime = &started
+	running2.Status.StartTime = &started
+	pods := []*v1.Pod{
+		running1,
+		running2,
+		util.BuildPod("ns1", "big", "", v1.PodPending, api.BuildResourceList("4", "4Gi"), "pg-big", nil, nil),
+		withMaxRunTime(util.BuildPod("ns1", "short", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-short", nil, nil), "20m"),
+		withMaxRunTime(util.BuildPod("ns1", "long", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-long", nil, nil), "1h"),
+		util.BuildPod("ns1", "unknown", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-unknown", nil, nil),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "runtime backfill",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			util.BuildNode("node2", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:             PluginName,
+			EnabledJobOrder:  &trueValue,
+			EnabledPredicate: &trueValue,
+			EnabledTargetJob: &trueValue,
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	big := ssn.Jobs["ns1/pg-big"]
+	jobs := []*api.JobInfo{ssn.Jobs["ns1/pg-short"], big}
+	if target := ssn.TargetJob(jobs); target != big {
+		t.Fatalf("expected pg-big to be the target job, got %v", target)
+	}
+
+	taskOf := func(job api.JobID) *api.TaskInfo {
+		for _, task := range ssn.Jobs[job].Tasks {
+			return task
+		}
+		return nil
+	}
+	tests := []struct {
+		job     api.JobID
+		node    string
+		wantErr bool
+	}{
+		{job: "ns1/pg-big", node: "node1"},
+		{job: "ns1/pg-short", node: "node1"},
+		{job: "ns1/pg-long", node: "node1", wantErr: true},
+		{job: "ns1/pg-unknown", node: "node1", wantErr: true},
+		{job: "ns1/pg-long", node: "node2"},
+	}
+	for _, test := range tests {
+		err := ssn.PredicateFn(taskOf(test.job), ssn.Nodes[test.node])
+		if (err != nil) != test.wantErr {
+			t.Errorf("job %s on %s: expected error %v, got %v", test.job, test.node, test.wantErr, err)
+		}
+	}
+
+	order := []api.JobID{"ns1/pg-big", "ns1/pg-short", "ns1/pg-long"}
+	for i := 0; i < len(order)-1; i++ {
+		l, r := ssn.Jobs[order[i]], ssn.Jobs[order[i+1]]
+		if !ssn.JobOrderFn(l, r) || ssn.JobOrderFn(r, l) {
+			t.Errorf("expected %s to be ordered before %s", order[i], order[i+1])
+		}
+	}
+}
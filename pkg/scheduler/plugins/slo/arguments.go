@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+// targetsKey is the list of time-to-schedule targets, in order of precedence.
+const targetsKey = "targets"
+
+// targetConfig is the configuration of the time-to-schedule target of one band, e.g.
+//
+//	targets:
+//	- band: critical
+//	  timeToSchedule: 5m
+//	  priorities:
+//	    expressions:
+//	    - operator: GreaterThan
+//	      values: [999]
+//	- band: batch
+//	  timeToSchedule: 2h
+//	  queues: [batch]
+type targetConfig struct {
+	// Band labels the metrics and events of the jobs of the target.
+	Band string `json:"band"`
+	// TimeToSchedule is the longest time a job may wait from its creation until it is scheduled.
+	TimeToSchedule string `json:"timeToSchedule"`
+	// Priorities selects the job priorities of the target, all priorities if unset.
+	Priorities interface{} `json:"priorities"`
+	// Queues selects the queues of the target, all queues if empty.
+	Queues []string `json:"queues"`
+}
+
+// ValidateArguments rejects the targets which would not be tracked.
+func ValidateArguments(arguments framework.Arguments) error {
+	_, err := parseTargets(arguments[targetsKey])
+	return err
+}
+
+// parseTargets decodes and validates the targets, every error is reported.
+func parseTargets(raw interface{}) ([]*target, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var configs []targetConfig
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &configs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode targets: %v", err)
+	}
+
+	var errs []error
+	var targets []*target
+	for i, config := range configs {
+		t, err := config.parse()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %v", targetsKey, i, err))
+			continue
+		}
+		targets = append(targets, t)
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return targets, nil
+}
+
+func (c targetConfig) parse() (*target, error) {
+	if c.Band == "" {
+		return nil, fmt.Errorf("band is required")
+	}
+	d, err := time.ParseDuration(c.TimeToSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("timeToSchedule of band %s: %v", c.Band, err)
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("timeToSchedule of band %s must be positive", c.Band)
+	}
+	selector, err := priority.ParseSelector(c.Priorities)
+	if err != nil {
+		return nil, fmt.Errorf("priorities of band %s: %v", c.Band, err)
+	}
+	t := &target{band: c.Band, timeToSchedule: d, priorities: selector}
+	if len(c.Queues) > 0 {
+		t.queues = make(map[string]bool, len(c.Queues))
+		for _, queue := range c.Queues {
+			t.queues[queue] = true
+		}
+	}
+	return t, nil
+}
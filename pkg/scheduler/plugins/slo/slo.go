@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package slo tracks the time-to-schedule of the jobs against the targets of their priority
+// band and queue. A job waiting longer than its target is counted in slo_breach_total and
+// gets a warning event on its PodGroup, once. The plugin only observes the scheduling, the
+// jobs in breach are published as plugin data for the plugins which act upon them.
+package slo
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "slo"
+
+	// BreachedJobsDataKey is the session plugin data holding the jobs still waiting after
+	// their time-to-schedule target, as a map[api.JobID]bool.
+	BreachedJobsDataKey = "slo/breachedJobs"
+
+	// BreachReason is the reason of the PodGroup event of a job breaching its target.
+	BreachReason = "SLOBreach"
+)
+
+// now is replaced in tests to simulate the passing of time.
+var now = time.Now
+
+// target is the time-to-schedule target of the jobs of one band.
+type target struct {
+	band           string
+	timeToSchedule time.Duration
+	// priorities is nil when the target selects all priorities.
+	priorities *priority.PrioritySelector
+	// queues is nil when the target selects all queues.
+	queues map[string]bool
+}
+
+// jobRecord is the tracking of one job seen waiting to be scheduled.
+type jobRecord struct {
+	breached  bool
+	scheduled bool
+}
+
+var (
+	// recordsMutex guards records, which outlive the plugin instance of one session.
+	recordsMutex sync.Mutex
+	records      = map[api.JobID]*jobRecord{}
+)
+
+type sloPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	// targets are in order of precedence.
+	targets []*target
+}
+
+// New return slo plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &sloPlugin{pluginArguments: arguments}
+}
+
+func (sp *sloPlugin) Name() string {
+	return PluginName
+}
+
+func (sp *sloPlugin) OnSessionOpen(ssn *framework.Session) {
+	targets, err := parseTargets(sp.pluginArguments[targetsKey])
+	if err != nil {
+		klog.Errorf("slo plugin: invalid %s, no job is tracked: %v", targetsKey, err)
+		return
+	}
+	for _, t := range targets {
+		t.priorities = t.priorities.Resolve(ssn.PriorityClasses)
+	}
+	sp.targets = targets
+
+	recordsMutex.Lock()
+	defer recordsMutex.Unlock()
+
+	for uid := range records {
+		if _, found := ssn.Jobs[uid]; !found {
+			delete(records, uid)
+		}
+	}
+
+	current := now()
+	breachedJobs := map[api.JobID]bool{}
+	for _, job := range ssn.Jobs {
+		t := sp.targetOf(ssn, job)
+		if t == nil || job.IsReady() {
+			continue
+		}
+		record, found := records[job.UID]
+		if !found {
+			record = &jobRecord{}
+			records[job.UID] = record
+		}
+		if record.scheduled {
+			continue
+		}
+		waited := current.Sub(job.CreationTimestamp.Time)
+		if !record.breached && waited > t.timeToSchedule {
+			record.breached = true
+			queue := queueName(ssn, job)
+			msg := fmt.Sprintf("job waited %v to be scheduled, more than the target of %v of band %s",
+				waited.Round(time.Second), t.timeToSchedule, t.band)
+			klog.V(3).Infof("slo: job <%s/%s> in queue %s breached its target: %s", job.Namespace, job.Name, queue, msg)
+			metrics.RegisterSLOBreach(t.band, queue)
+			ssn.RecordPodGroupEvent(job.PodGroup, v1.EventTypeWarning, BreachReason, msg)
+		}
+		if record.breached {
+			breachedJobs[job.UID] = true
+		}
+	}
+
+	if err := ssn.SetPluginData(sp.Name(), BreachedJobsDataKey, breachedJobs); err != nil {
+		klog.Errorf("slo: failed to publish %s: %v", BreachedJobsDataKey, err)
+	}
+}
+
+// OnSessionClose records the time-to-schedule of the jobs scheduled in the session.
+func (sp *sloPlugin) OnSessionClose(ssn *framework.Session) {
+	if len(sp.targets) == 0 {
+		return
+	}
+
+	recordsMutex.Lock()
+	defer recordsMutex.Unlock()
+
+	current := now()
+	for uid, record := range records {
+		job, found := ssn.Jobs[uid]
+		if !found || record.scheduled || !job.IsReady() {
+			continue
+		}
+		record.scheduled = true
+		if t := sp.targetOf(ssn, job); t != nil {
+			metrics.UpdateSLOTimeToSchedule(t.band, queueName(ssn, job), current.Sub(job.CreationTimestamp.Time).Seconds())
+		}
+	}
+	sp.targets = nil
+}
+
+// targetOf returns the first target selecting the job, nil if there is none.
+func (sp *sloPlugin) targetOf(ssn *framework.Session, job *api.JobInfo) *target {
+	queue := queueName(ssn, job)
+	for _, t := range sp.targets {
+		if t.queues != nil && !t.queues[queue] {
+			continue
+		}
+		if t.priorities != nil && !t.priorities.Matches(job.Priority) {
+			continue
+		}
+		return t
+	}
+	return nil
+}
+
+func queueName(ssn *framework.Session, job *api.JobInfo) string {
+	if queue, found := ssn.Queues[job.Queue]; found {
+		return queue.Name
+	}
+	return string(job.Queue)
+}
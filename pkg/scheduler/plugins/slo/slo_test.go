@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slo
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func targetsArgument() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"band":           "critical",
+			"timeToSchedule": "5m",
+			"priorities": map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{999}},
+				},
+			},
+		},
+		map[string]interface{}{
+			"band":           "batch",
+			"timeToSchedule": "2h",
+			"queues":         []interface{}{"q1"},
+		},
+	}
+}
+
+func TestSLOBreach(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	defer func() {
+		now = time.Now
+		records = map[api.JobID]*jobRecord{}
+	}()
+
+	podGroup := func(name, priorityClass string) *vcapisv1.PodGroup {
+		pg := util.BuildPodGroupWithPrio(name, "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, priorityClass)
+		pg.CreationTimestamp = metav1.NewTime(start)
+		return pg
+	}
+	podGroups := []*vcapisv1.PodGroup{podGroup("pg-critical", "high"), podGroup("pg-batch", "low")}
+	openSession := func(name string, critical v1.PodPhase, node string) (*framework.Session, *uthelper.TestCommonStruct) {
+		trueValue := true
+		tc := &uthelper.TestCommonStruct{
+			Name:      name,
+			Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+			PodGroups: podGroups,
+			PriClass: []*schedulingv1.PriorityClass{
+				util.BuildPriorityClass("low", 100),
+				util.BuildPriorityClass("high", 1000),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("ns1", "critical", node, critical, api.BuildResourceList("1", "1Gi"), "pg-critical", nil, nil),
+				util.BuildPod("ns1", "batch", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-batch", nil, nil),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("node1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+			},
+			Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+		}
+		tiers := []conf.Tier{{Plugins: []conf.PluginOption{{
+			Name:            PluginName,
+			EnabledJobOrder: &trueValue,
+			Arguments:       framework.Arguments{targetsKey: targetsArgument()},
+		}}}}
+		return tc.RegisterSession(tiers, nil), tc
+	}
+
+	now = func() time.Time { return start.Add(10 * time.Minute) }
+	ssn, tc := openSession("critical job over its target", v1.PodPending, "")
+	breached, _ := framework.GetPluginData[map[api.JobID]bool](ssn, BreachedJobsDataKey)
+	if !breached["ns1/pg-critical"] || breached["ns1/pg-batch"] {
+		t.Errorf("expected only the critical job in breach, got %v", breached)
+	}
+	tc.Close()
+
+	now = func() time.Time { return start.Add(3 * time.Hour) }
+	ssn, tc = openSession("both jobs over their target", v1.PodPending, "")
+	breached, _ = framework.GetPluginData[map[api.JobID]bool](ssn, BreachedJobsDataKey)
+	if !breached["ns1/pg-critical"] || !breached["ns1/pg-batch"] {
+		t.Errorf("expected both jobs in breach, got %v", breached)
+	}
+	tc.Close()
+
+	ssn, tc = openSession("critical job scheduled", v1.PodRunning, "node1")
+	breached, _ = framework.GetPluginData[map[api.JobID]bool](ssn, BreachedJobsDataKey)
+	if breached["ns1/pg-critical"] {
+		t.Errorf("expected the scheduled critical job not to be in breach, got %v", breached)
+	}
+	tc.Close()
+	if !records["ns1/pg-critical"].scheduled {
+		t.Errorf("expected the critical job to be recorded as scheduled")
+	}
+}
+
+func TestParseTargets(t *testing.T) {
+	targets, err := parseTargets(targetsArgument())
+	if err != nil {
+		t.Fatalf("expected valid targets, got %v", err)
+	}
+	if len(targets) != 2 || targets[0].timeToSchedule != 5*time.Minute || !targets[1].queues["q1"] {
+		t.Errorf("unexpected targets %+v", targets)
+	}
+
+	for name, raw := range map[string]interface{}{
+		"no band":          []interface{}{map[string]interface{}{"timeToSchedule": "5m"}},
+		"no target":        []interface{}{map[string]interface{}{"band": "critical"}},
+		"negative target":  []interface{}{map[string]interface{}{"band": "critical", "timeToSchedule": "-5m"}},
+		"unknown field":    []interface{}{map[string]interface{}{"band": "critical", "timeToSchedule": "5m", "share": 1}},
+		"invalid selector": []interface{}{map[string]interface{}{"band": "critical", "timeToSchedule": "5m", "priorities": "high"}},
+	} {
+		if _, err := parseTargets(raw); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
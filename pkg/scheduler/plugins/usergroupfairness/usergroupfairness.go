@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usergroupfairness orders the jobs of a queue by the usage of their submitting
+// user, so that the least served user of the queue goes first. Users may be gathered in
+// user groups sharing one usage.
+package usergroupfairness
+
+import (
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin
+	PluginName = "usergroupfairness"
+
+	// userKeyKey is the PodGroup or pod annotation, or label, holding the submitting user of a job.
+	userKeyKey = "userKey"
+	// userGroupsKey maps users to the user group they share their usage with.
+	userGroupsKey = "userGroups"
+
+	defaultUserKey = "volcano.sh/user"
+)
+
+// userKey identifies the usage of one user, or user group, inside one queue.
+type userKey struct {
+	queue api.QueueID
+	user  string
+}
+
+type userGroupFairnessPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+
+	userKey    string
+	userGroups map[string]string
+
+	totalResource *api.Resource
+	// jobUsers is the user, or user group, of each job with one.
+	jobUsers map[api.JobID]string
+	// allocated is the resource allocated to each user of each queue.
+	allocated map[userKey]*api.Resource
+}
+
+// New return usergroupfairness plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &userGroupFairnessPlugin{pluginArguments: arguments}
+}
+
+func (up *userGroupFairnessPlugin) Name() string {
+	return PluginName
+}
+
+func (up *userGroupFairnessPlugin) OnSessionOpen(ssn *framework.Session) {
+	up.parseArguments()
+	up.totalResource = ssn.TotalResource
+	up.jobUsers = make(map[api.JobID]string)
+	up.allocated = make(map[userKey]*api.Resource)
+
+	for _, job := range ssn.Jobs {
+		user := up.getJobUser(job)
+		if user == "" {
+			continue
+		}
+		up.jobUsers[job.UID] = user
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				up.allocatedOf(job.Queue, user).Add(task.Resreq)
+			}
+		}
+	}
+
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+		if lv.Queue != rv.Queue {
+			return 0
+		}
+
+		lUser, lFound := up.jobUsers[lv.UID]
+		rUser, rFound := up.jobUsers[rv.UID]
+		if !lFound || !rFound || lUser == rUser {
+			return 0
+		}
+
+		lShare, rShare := up.share(lv.Queue, lUser), up.share(rv.Queue, rUser)
+		if lShare < rShare {
+			return -1
+		}
+		if lShare > rShare {
+			return 1
+		}
+		return 0
+	}
+	ssn.AddJobOrderFn(up.Name(), jobOrderFn)
+
+	ssn.AddEventHandler(&framework.EventHandler{
+		AllocateFunc: func(event *framework.Event) {
+			job, found := ssn.Jobs[event.Task.Job]
+			if !found {
+				return
+			}
+			if user, found := up.jobUsers[job.UID]; found {
+				up.allocatedOf(job.Queue, user).Add(event.Task.Resreq)
+			}
+		},
+		DeallocateFunc: func(event *framework.Event) {
+			job, found := ssn.Jobs[event.Task.Job]
+			if !found {
+				return
+			}
+			if user, found := up.jobUsers[job.UID]; found {
+				up.allocatedOf(job.Queue, user).Sub(event.Task.Resreq)
+			}
+		},
+	})
+}
+
+func (up *userGroupFairnessPlugin) OnSessionClose(ssn *framework.Session) {
+	up.totalResource = nil
+	up.jobUsers = nil
+	up.allocated = nil
+}
+
+// ValidateArguments rejects userGroups which is not a map of user to group.
+func ValidateArguments(arguments framework.Arguments) error {
+	_, err := framework.GetStrict[map[string]string](arguments, userGroupsKey)
+	return err
+}
+
+func (up *userGroupFairnessPlugin) parseArguments() {
+	up.userKey = defaultUserKey
+	if v, ok := up.pluginArguments[userKeyKey].(string); ok && v != "" {
+		up.userKey = v
+	}
+
+	up.userGroups = make(map[string]string)
+	groups, err := framework.GetStrict[map[string]string](up.pluginArguments, userGroupsKey)
+	if err != nil {
+		klog.Errorf("usergroupfairness plugin: every user is a group of its own: %v", err)
+	} else if groups != nil {
+		up.userGroups = groups
+	}
+}
+
+// allocatedOf returns the allocated resource of the user in the queue, initializing it if absent.
+func (up *userGroupFairnessPlugin) allocatedOf(queue api.QueueID, user string) *api.Resource {
+	key := userKey{queue: queue, user: user}
+	allocated, found := up.allocated[key]
+	if !found {
+		allocated = api.EmptyResource()
+		up.allocated[key] = allocated
+	}
+	return allocated
+}
+
+// share returns the dominant share of the cluster allocated to the user in the queue.
+func (up *userGroupFairnessPlugin) share(queue api.QueueID, user string) float64 {
+	allocated, found := up.allocated[userKey{queue: queue, user: user}]
+	if !found {
+		return 0
+	}
+	share := 0.0
+	for _, name := range allocated.ResourceNames() {
+		total := up.totalResource.Get(name)
+		if total <= 0 {
+			continue
+		}
+		if s := allocated.Get(name) / total; s > share {
+			share = s
+		}
+	}
+	return share
+}
+
+// getJobUser returns the user group, or user, of the job from the annotations or labels
+// of its PodGroup, falling back to those of its pods.
+func (up *userGroupFairnessPlugin) getJobUser(job *api.JobInfo) string {
+	user := ""
+	if job.PodGroup != nil {
+		user = job.PodGroup.Annotations[up.userKey]
+		if user == "" {
+			user = job.PodGroup.Labels[up.userKey]
+		}
+	}
+	for _, task := range job.Tasks {
+		if user != "" || task.Pod == nil {
+			break
+		}
+		user = task.Pod.Annotations[up.userKey]
+		if user == "" {
+			user = task.Pod.Labels[up.userKey]
+		}
+	}
+	if group, found := up.userGroups[user]; found {
+		return group
+	}
+	return user
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usergroupfairness
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func userAnno(user string) map[string]string {
+	return map[string]string{defaultUserKey: user}
+}
+
+func TestUserGroupFairness(t *testing.T) {
+	podGroups := []*vcapisv1.PodGroup{
+		util.BuildPodGroupWithAnno("pg-alice-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, userAnno("alice")),
+		util.BuildPodGroupWithAnno("pg-bob-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, userAnno("bob")),
+		util.BuildPodGroupWithAnno("pg-carol-running", "ns1", "q1", 1, nil, vcapisv1.PodGroupRunning, userAnno("carol")),
+		util.BuildPodGroupWithAnno("pg-alice-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, userAnno("alice")),
+		util.BuildPodGroupWithAnno("pg-bob-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, userAnno("bob")),
+		util.BuildPodGroupWithAnno("pg-dave-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue, userAnno("dave")),
+		util.BuildPodGroup("pg-erin-pending", "ns1", "q1", 1, nil, vcapisv1.PodGroupInqueue),
+	}
+	pods := []*v1.Pod{
+		util.BuildPod("ns1", "alice-running", "node1", v1.PodRunning, api.BuildResourceList("3", "1Gi"), "pg-alice-running", nil, nil),
+		util.BuildPod("ns1", "bob-running", "node1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg-bob-running", nil, nil),
+		util.BuildPod("ns1", "carol-running", "node1", v1.PodRunning, api.BuildResourceList("2", "1Gi"), "pg-carol-running", nil, nil),
+		util.BuildPod("ns1", "alice-pending", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-alice-pending", nil, nil),
+		util.BuildPod("ns1", "bob-pending", "", v1.PodPending, api.BuildResourceList("2", "1Gi"), "pg-bob-pending", nil, nil),
+		util.BuildPod("ns1", "dave-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-dave-pending", nil, nil),
+		// the user of erin is only known from the labels of her pod
+		util.BuildPod("ns1", "erin-pending", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg-erin-pending", userAnno("erin"), nil),
+	}
+
+	trueValue := true
+	tc := &uthelper.TestCommonStruct{
+		Name:      "user group fairness",
+		Plugins:   map[string]framework.PluginBuilder{PluginName: New},
+		PodGroups: podGroups,
+		Pods:      pods,
+		Nodes: []*v1.Node{
+			util.BuildNode("node1", api.BuildResourceList("10", "10Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+	}
+	tiers := []conf.Tier{{
+		Plugins: []conf.PluginOption{{
+			Name:            PluginName,
+			EnabledJobOrder: &trueValue,
+			Arguments: framework.Arguments{
+				// carol and dave share the usage of the ml group
+				userGroupsKey: map[interface{}]interface{}{"carol": "ml", "dave": "ml"},
+			},
+		}},
+	}}
+	ssn := tc.RegisterSession(tiers, nil)
+	defer tc.Close()
+
+	before := func(l, r api.JobID) bool {
+		return ssn.JobOrderFn(ssn.Jobs[l], ssn.Jobs[r])
+	}
+
+	// usage is erin: 0, bob: 1, ml: 2, alice: 3 CPU
+	order := []api.JobID{"ns1/pg-erin-pending", "ns1/pg-bob-pending", "ns1/pg-dave-pending", "ns1/pg-alice-pending"}
+	for i := 0; i < len(order)-1; i++ {
+		if !before(order[i], order[i+1]) || before(order[i+1], order[i]) {
+			t.Errorf("expected %s to be ordered before %s", order[i], order[i+1])
+		}
+	}
+
+	// once bob gets 2 more CPU, he is more served than the ml group
+	stmt := framework.NewStatement(ssn)
+	for _, task := range ssn.Jobs["ns1/pg-bob-pending"].Tasks {
+		if err := stmt.Allocate(task, ssn.Nodes["node1"]); err != nil {
+			t.Fatalf("failed to allocate: %v", err)
+		}
+	}
+	if !before("ns1/pg-dave-pending", "ns1/pg-bob-running") {
+		t.Errorf("expected the ml group to be ordered before bob after his allocation")
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decisionlog writes machine-readable records of the decisions of the scheduler
+// plugins, one JSON object per line, for offline analysis. It is opt-in per plugin.
+package decisionlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Config is the decision log of one plugin, decoded from its arguments by Parse, e.g.
+//
+//	decisionLog: true
+//
+// writes the records to the klog stream of the decisionlog logger, while
+//
+//	decisionLog:
+//	  file: /var/log/volcano/decisions.jsonl
+//
+// appends them to the file, shared by every plugin configured with the same file.
+type Config struct {
+	// File is the file the records are appended to, the klog stream if empty.
+	File string `json:"file"`
+}
+
+// Record is one decision of a plugin about a job.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Plugin    string    `json:"plugin"`
+	Namespace string    `json:"namespace"`
+	Job       string    `json:"job"`
+	Queue     string    `json:"queue"`
+	// Rule is the rule of the plugin which made the decision.
+	Rule string `json:"rule"`
+	// Action is the decision, e.g. reject or preempt.
+	Action string `json:"action"`
+	// Inputs are the values the decision was made on.
+	Inputs map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// Parse decodes the decision log configuration of a plugin, nil if the log is disabled.
+func Parse(raw interface{}) (*Config, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		if !v {
+			return nil, nil
+		}
+		return &Config{}, nil
+	}
+
+	config := &Config{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode decision log: %v", err)
+	}
+	return config, nil
+}
+
+// Logger writes the decision records of one plugin. A nil Logger writes nothing, so that
+// plugins log their decisions unconditionally.
+type Logger struct {
+	plugin string
+	file   *sharedFile
+}
+
+// now is replaced in tests to get stable records.
+var now = time.Now
+
+// New returns the logger of the plugin, nil if config is nil.
+func New(plugin string, config *Config) *Logger {
+	if config == nil {
+		return nil
+	}
+	l := &Logger{plugin: plugin}
+	if config.File != "" {
+		l.file = openShared(config.File)
+	}
+	return l
+}
+
+// Log records a decision of the plugin about the job.
+func (l *Logger) Log(job *api.JobInfo, rule, action string, inputs map[string]interface{}) {
+	if l == nil {
+		return
+	}
+	record := &Record{
+		Time:      now(),
+		Plugin:    l.plugin,
+		Namespace: job.Namespace,
+		Job:       job.Name,
+		Queue:     string(job.Queue),
+		Rule:      rule,
+		Action:    action,
+		Inputs:    inputs,
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		klog.Errorf("decisionlog: failed to encode the decision of plugin %s on job <%s/%s>: %v", l.plugin, job.Namespace, job.Name, err)
+		return
+	}
+	if l.file == nil {
+		klog.LoggerWithName(klog.Background(), "decisionlog").Info(string(data))
+		return
+	}
+	l.file.writeLine(data)
+}
+
+// sharedFile is a decision log file, written by every plugin logging to it.
+type sharedFile struct {
+	path string
+	mu   sync.Mutex
+	f    *os.File
+}
+
+var (
+	// filesMutex guards files, which outlive the plugin instances of one session.
+	filesMutex sync.Mutex
+	files      = map[string]*sharedFile{}
+)
+
+func openShared(path string) *sharedFile {
+	filesMutex.Lock()
+	defer filesMutex.Unlock()
+	if file, found := files[path]; found {
+		return file
+	}
+	file := &sharedFile{path: path}
+	files[path] = file
+	return file
+}
+
+// writeLine appends the line to the file, opening it on first use. A record which cannot be
+// written is dropped, the decisions are not held back by the log.
+func (s *sharedFile) writeLine(data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			klog.Errorf("decisionlog: failed to open %s: %v", s.path, err)
+			return
+		}
+		s.f = f
+	}
+	if _, err := s.f.Write(append(data, '\n')); err != nil {
+		klog.Errorf("decisionlog: failed to write to %s: %v", s.path, err)
+		s.f.Close()
+		s.f = nil
+	}
+}
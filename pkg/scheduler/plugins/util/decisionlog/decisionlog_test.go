@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decisionlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestParse(t *testing.T) {
+	for name, test := range map[string]struct {
+		raw       interface{}
+		expect    *Config
+		expectErr bool
+	}{
+		"unset":         {raw: nil},
+		"disabled":      {raw: false},
+		"klog stream":   {raw: true, expect: &Config{}},
+		"file":          {raw: map[string]interface{}{"file": "/tmp/decisions.jsonl"}, expect: &Config{File: "/tmp/decisions.jsonl"}},
+		"unknown field": {raw: map[string]interface{}{"path": "/tmp/decisions.jsonl"}, expectErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config, err := Parse(test.raw)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expect, config)
+		})
+	}
+}
+
+func TestLogToFile(t *testing.T) {
+	current := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	job := &api.JobInfo{Namespace: "ns1", Name: "job1", Queue: "q1"}
+	New("a", &Config{File: path}).Log(job, "overQuota", "reject", map[string]interface{}{"group": "team-a"})
+	New("b", &Config{File: path}).Log(job, "maxInqueueJobs", "reject", nil)
+	var disabled *Logger
+	disabled.Log(job, "ignored", "reject", nil)
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid record %s: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	assert.Equal(t, []Record{
+		{Time: current, Plugin: "a", Namespace: "ns1", Job: "job1", Queue: "q1", Rule: "overQuota", Action: "reject", Inputs: map[string]interface{}{"group": "team-a"}},
+		{Time: current, Plugin: "b", Namespace: "ns1", Job: "job1", Queue: "q1", Rule: "maxInqueueJobs", Action: "reject"},
+	}, records)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+)
+
+// benchmarkSelector mixes every kind of expression of a typical exemption selector.
+func benchmarkSelector(b *testing.B) *PrioritySelector {
+	b.Helper()
+	selector := &PrioritySelector{
+		Expressions: []PriorityExpression{
+			{Operator: OperatorIn, Values: []int32{1, 5, 9, 13}},
+			{Operator: OperatorStepRange, Values: []int32{1000, 900, 999}},
+		},
+		AllExpressions: []PriorityExpression{
+			{Operator: OperatorBetween, Values: []int32{0, 100000}},
+		},
+		NoneExpressions: []PriorityExpression{
+			{Operator: OperatorMod, Values: []int32{7, 3}},
+		},
+	}
+	if err := selector.Validate(); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	return selector
+}
+
+func BenchmarkSelectorMatches(b *testing.B) {
+	selector := benchmarkSelector(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		selector.Matches(int32(i % 100000))
+	}
+}
+
+func BenchmarkSelectorMatchesWorkloadCEL(b *testing.B) {
+	selector := &PrioritySelector{CELExpression: "job.priority > 100 && job.labels['tier'] == 'prod'"}
+	if err := selector.Validate(); err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	workload := Workload{Queue: "q1", Namespace: "ns1", Labels: map[string]string{"tier": "prod"}}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		workload.Priority = int32(i % 1000)
+		selector.MatchesWorkload(workload)
+	}
+}
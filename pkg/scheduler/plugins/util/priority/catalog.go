@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"sort"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// SelectorRefKey is the key of a plugin argument referencing a selector of the catalog by
+// name instead of repeating its expressions, e.g.
+//
+//	prioritySelectors:
+//	  prodBand:
+//	    expressions:
+//	    - operator: GreaterThan
+//	      values: [1000]
+//	tiers:
+//	- plugins:
+//	  - name: groupquota
+//	    arguments:
+//	      exemptPriorities:
+//	        selectorRef: prodBand
+const SelectorRefKey = "selectorRef"
+
+// SelectorCatalog holds the named selectors of the scheduler configuration, as raw
+// arguments so that each plugin still decodes them itself.
+type SelectorCatalog map[string]interface{}
+
+// NewSelectorCatalog validates every selector of the catalog, whether referenced or not.
+func NewSelectorCatalog(raw map[string]interface{}) (SelectorCatalog, error) {
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if _, err := ParseSelector(raw[name]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return SelectorCatalog(raw), nil
+}
+
+// ResolveRefs replaces, in place, every map of the arguments holding only a selectorRef by
+// the selector of the catalog it names, at any depth. A reference to a selector missing from
+// the catalog, or a selectorRef next to other keys, is an error.
+func (c SelectorCatalog) ResolveRefs(arguments map[string]interface{}) error {
+	var errs []error
+	for key, value := range arguments {
+		resolved, err := c.resolve(value, key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		arguments[key] = resolved
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (c SelectorCatalog) resolve(value interface{}, path string) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, found := v[SelectorRefKey]; found {
+			return c.lookup(ref, len(v), path)
+		}
+		for key, item := range v {
+			resolved, err := c.resolve(item, path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+	case map[interface{}]interface{}:
+		if ref, found := v[SelectorRefKey]; found {
+			return c.lookup(ref, len(v), path)
+		}
+		for key, item := range v {
+			resolved, err := c.resolve(item, fmt.Sprintf("%s.%v", path, key))
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := c.resolve(item, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+	}
+	return value, nil
+}
+
+func (c SelectorCatalog) lookup(ref interface{}, keys int, path string) (interface{}, error) {
+	if keys != 1 {
+		return nil, fmt.Errorf("%s: %s cannot be combined with other fields", path, SelectorRefKey)
+	}
+	name, ok := ref.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a string, got %T", path, SelectorRefKey, ref)
+	}
+	selector, found := c[name]
+	if !found {
+		return nil, fmt.Errorf("%s: priority selector %q is not defined in prioritySelectors", path, name)
+	}
+	return selector, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSelectorCatalog(t *testing.T) {
+	prodBand := map[string]interface{}{
+		"expressions": []interface{}{map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{1000}}},
+	}
+	batchBand := map[interface{}]interface{}{
+		"expressions": []interface{}{map[interface{}]interface{}{"operator": "LessThan", "values": []interface{}{100}}},
+	}
+	catalog, err := NewSelectorCatalog(map[string]interface{}{"prodBand": prodBand, "batchBand": batchBand})
+	if err != nil {
+		t.Fatalf("failed to build the catalog: %v", err)
+	}
+
+	arguments := map[string]interface{}{
+		"exemptPriorities": map[string]interface{}{SelectorRefKey: "prodBand"},
+		"classes": []interface{}{
+			map[interface{}]interface{}{"name": "batch", "priorities": map[interface{}]interface{}{SelectorRefKey: "batchBand"}},
+		},
+		"resourceMap": map[string]interface{}{"cpu": "8"},
+	}
+	if err := catalog.ResolveRefs(arguments); err != nil {
+		t.Fatalf("failed to resolve the references: %v", err)
+	}
+	if !reflect.DeepEqual(arguments["exemptPriorities"], prodBand) {
+		t.Errorf("expected exemptPriorities to be prodBand, got %v", arguments["exemptPriorities"])
+	}
+	class := arguments["classes"].([]interface{})[0].(map[interface{}]interface{})
+	if !reflect.DeepEqual(class["priorities"], batchBand) {
+		t.Errorf("expected the priorities of the class to be batchBand, got %v", class["priorities"])
+	}
+	if !reflect.DeepEqual(arguments["resourceMap"], map[string]interface{}{"cpu": "8"}) {
+		t.Errorf("expected the other arguments to be kept, got %v", arguments["resourceMap"])
+	}
+
+	errTests := []struct {
+		name      string
+		arguments map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "undefined selector",
+			arguments: map[string]interface{}{"exemptPriorities": map[string]interface{}{SelectorRefKey: "devBand"}},
+			expected:  `exemptPriorities: priority selector "devBand" is not defined`,
+		},
+		{
+			name:      "reference with other fields",
+			arguments: map[string]interface{}{"exemptPriorities": map[string]interface{}{SelectorRefKey: "prodBand", "celExpression": "true"}},
+			expected:  "exemptPriorities: selectorRef cannot be combined with other fields",
+		},
+		{
+			name:      "reference not a string",
+			arguments: map[string]interface{}{"slos": []interface{}{map[string]interface{}{SelectorRefKey: 1}}},
+			expected:  "slos[0]: selectorRef must be a string",
+		},
+	}
+	for _, test := range errTests {
+		t.Run(test.name, func(t *testing.T) {
+			err := catalog.ResolveRefs(test.arguments)
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("expected error containing %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestNewSelectorCatalogValidatesSelectors(t *testing.T) {
+	_, err := NewSelectorCatalog(map[string]interface{}{
+		"prodBand": map[string]interface{}{"expressions": []interface{}{map[string]interface{}{"operator": "Around", "values": []interface{}{1}}}},
+		"unused":   map[string]interface{}{},
+	})
+	if err == nil || !strings.Contains(err.Error(), "prodBand: ") || !strings.Contains(err.Error(), "unused: ") {
+		t.Errorf("expected both invalid selectors to be reported, got %v", err)
+	}
+	if catalog, err := NewSelectorCatalog(nil); err != nil || len(catalog) != 0 {
+		t.Errorf("expected an empty catalog without prioritySelectors, got %v, %v", catalog, err)
+	}
+}
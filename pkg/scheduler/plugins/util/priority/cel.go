@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/klog/v2"
+)
+
+// celJobVar is the variable holding the workload in CEL expressions. The attributes are
+// fields of one object because namespace is a reserved identifier in CEL.
+const celJobVar = "job"
+
+// Workload is the part of a job visible to the CEL expression of a selector.
+type Workload struct {
+	Priority  int32
+	Queue     string
+	Namespace string
+	// Labels are the labels of the PodGroup.
+	Labels map[string]string
+}
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+
+	// celPrograms caches the compiled programs by expression, so that selectors parsed
+	// again in every session compile each expression only once.
+	celMutex    sync.Mutex
+	celPrograms = map[string]cel.Program{}
+)
+
+func getCELEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable(celJobVar, cel.MapType(cel.StringType, cel.DynType)),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+// compileCEL compiles a boolean CEL expression over job.priority, job.queue, job.namespace and job.labels.
+func compileCEL(expression string) (cel.Program, error) {
+	celMutex.Lock()
+	defer celMutex.Unlock()
+
+	if program, found := celPrograms[expression]; found {
+		return program, nil
+	}
+
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %v", ast.OutputType())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	celPrograms[expression] = program
+	return program, nil
+}
+
+// evalCEL returns whether the program evaluates to true for the workload.
+// Evaluation errors, e.g. a missing label key, are treated as no match.
+func evalCEL(program cel.Program, expression string, w Workload) bool {
+	labels := w.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		celJobVar: map[string]interface{}{
+			"priority":  int64(w.Priority),
+			"queue":     w.Queue,
+			"namespace": w.Namespace,
+			"labels":    labels,
+		},
+	})
+	if err != nil {
+		klog.V(4).Infof("Failed to evaluate CEL expression %q: %v", expression, err)
+		return false
+	}
+	matched, ok := out.Value().(bool)
+	return ok && matched
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Interval is a closed range of priorities.
+type Interval struct {
+	Low  int32
+	High int32
+}
+
+// IntervalSet is the canonical form of a set of priorities: sorted, disjoint and
+// non-adjacent closed intervals.
+type IntervalSet []Interval
+
+// allPriorities is the set of every priority.
+var allPriorities = IntervalSet{{Low: math.MinInt32, High: math.MaxInt32}}
+
+// NewIntervalSet returns the canonical set of the given intervals. Intervals with
+// a lower bound above their upper bound are empty and skipped.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if i.Low <= i.High {
+			sorted = append(sorted, i)
+		}
+	}
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Low < sorted[b].Low
+	})
+
+	set := IntervalSet{}
+	for _, i := range sorted {
+		last := len(set) - 1
+		// merge overlapping and adjacent intervals, minding the overflow of High+1
+		if last >= 0 && int64(i.Low) <= int64(set[last].High)+1 {
+			if i.High > set[last].High {
+				set[last].High = i.High
+			}
+			continue
+		}
+		set = append(set, i)
+	}
+	return set
+}
+
+// Matches returns whether the priority is in the set, in logarithmic time.
+func (set IntervalSet) Matches(priority int32) bool {
+	idx := sort.Search(len(set), func(i int) bool {
+		return set[i].High >= priority
+	})
+	return idx < len(set) && set[idx].Low <= priority
+}
+
+// Union returns the priorities in either set.
+func (set IntervalSet) Union(other IntervalSet) IntervalSet {
+	intervals := make([]Interval, 0, len(set)+len(other))
+	intervals = append(intervals, set...)
+	intervals = append(intervals, other...)
+	return NewIntervalSet(intervals...)
+}
+
+// Intersect returns the priorities in both sets.
+func (set IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	result := IntervalSet{}
+	for i, j := 0, 0; i < len(set) && j < len(other); {
+		low := max(set[i].Low, other[j].Low)
+		high := min(set[i].High, other[j].High)
+		if low <= high {
+			result = append(result, Interval{Low: low, High: high})
+		}
+		if set[i].High < other[j].High {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Complement returns the priorities not in the set.
+func (set IntervalSet) Complement() IntervalSet {
+	result := IntervalSet{}
+	next := int64(math.MinInt32)
+	for _, i := range set {
+		if int64(i.Low) > next {
+			result = append(result, Interval{Low: int32(next), High: i.Low - 1})
+		}
+		next = int64(i.High) + 1
+	}
+	if next <= math.MaxInt32 {
+		result = append(result, Interval{Low: int32(next), High: math.MaxInt32})
+	}
+	return result
+}
+
+// Selector returns a selector of Between expressions matching the set.
+func (set IntervalSet) Selector() *PrioritySelector {
+	selector := &PrioritySelector{}
+	for _, i := range set {
+		selector.Expressions = append(selector.Expressions, PriorityExpression{
+			Operator: OperatorBetween,
+			Values:   []int32{i.Low, i.High},
+		})
+	}
+	selector.intervals, selector.normalized = set, true
+	return selector
+}
+
+// Normalize returns the set of priorities matched by the selector. Selectors using a CEL
+// expression, the Mod or StepRange operators, or unresolved PriorityClass operators cannot
+// be normalized. A nil or empty selector matches nothing.
+func Normalize(s *PrioritySelector) (IntervalSet, error) {
+	if s == nil || s.isEmpty() {
+		return IntervalSet{}, nil
+	}
+	if s.CELExpression != "" {
+		return nil, fmt.Errorf("a selector with a CEL expression cannot be normalized")
+	}
+
+	result := allPriorities
+	if len(s.Expressions) > 0 {
+		union, err := unionOf(s.Expressions)
+		if err != nil {
+			return nil, err
+		}
+		result = union
+	}
+	for _, e := range s.AllExpressions {
+		set, err := e.intervals()
+		if err != nil {
+			return nil, err
+		}
+		result = result.Intersect(set)
+	}
+	if len(s.NoneExpressions) > 0 {
+		union, err := unionOf(s.NoneExpressions)
+		if err != nil {
+			return nil, err
+		}
+		result = result.Intersect(union.Complement())
+	}
+	return result, nil
+}
+
+// SelectorUnion returns a selector matching the priorities matched by any of the selectors.
+func SelectorUnion(selectors ...*PrioritySelector) (*PrioritySelector, error) {
+	result := IntervalSet{}
+	for _, s := range selectors {
+		set, err := Normalize(s)
+		if err != nil {
+			return nil, err
+		}
+		result = result.Union(set)
+	}
+	return result.Selector(), nil
+}
+
+// SelectorIntersect returns a selector matching the priorities matched by all of the selectors.
+func SelectorIntersect(selectors ...*PrioritySelector) (*PrioritySelector, error) {
+	if len(selectors) == 0 {
+		return IntervalSet{}.Selector(), nil
+	}
+	result := allPriorities
+	for _, s := range selectors {
+		set, err := Normalize(s)
+		if err != nil {
+			return nil, err
+		}
+		result = result.Intersect(set)
+	}
+	return result.Selector(), nil
+}
+
+func unionOf(expressions []PriorityExpression) (IntervalSet, error) {
+	result := IntervalSet{}
+	for _, e := range expressions {
+		set, err := e.intervals()
+		if err != nil {
+			return nil, err
+		}
+		result = result.Union(set)
+	}
+	return result, nil
+}
+
+// intervals returns the set of priorities matched by the expression. Like Matches,
+// a malformed expression matches nothing.
+func (e PriorityExpression) intervals() (IntervalSet, error) {
+	switch e.Operator {
+	case OperatorIn, OperatorNotIn:
+		points := make([]Interval, 0, len(e.Values))
+		for _, v := range e.Values {
+			points = append(points, Interval{Low: v, High: v})
+		}
+		set := NewIntervalSet(points...)
+		if e.Operator == OperatorNotIn {
+			return set.Complement(), nil
+		}
+		return set, nil
+	case OperatorGreaterThan:
+		if len(e.Values) != 1 || e.Values[0] == math.MaxInt32 {
+			return IntervalSet{}, nil
+		}
+		return IntervalSet{{Low: e.Values[0] + 1, High: math.MaxInt32}}, nil
+	case OperatorLessThan:
+		if len(e.Values) != 1 || e.Values[0] == math.MinInt32 {
+			return IntervalSet{}, nil
+		}
+		return IntervalSet{{Low: math.MinInt32, High: e.Values[0] - 1}}, nil
+	case OperatorBetween:
+		if len(e.Values) != 2 {
+			return IntervalSet{}, nil
+		}
+		return NewIntervalSet(Interval{Low: e.Values[0], High: e.Values[1]}), nil
+	case OperatorMod, OperatorStepRange, OperatorInClasses, OperatorNotInClasses:
+		return nil, fmt.Errorf("operator %s cannot be normalized", e.Operator)
+	default:
+		return IntervalSet{}, nil
+	}
+}
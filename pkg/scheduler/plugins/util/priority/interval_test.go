@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNewIntervalSet(t *testing.T) {
+	got := NewIntervalSet(
+		Interval{Low: 50, High: 60},
+		Interval{Low: 1, High: 10},
+		Interval{Low: 5, High: 20},
+		Interval{Low: 21, High: 30},
+		Interval{Low: 100, High: 90},
+		Interval{Low: math.MaxInt32, High: math.MaxInt32},
+	)
+	expected := IntervalSet{{Low: 1, High: 30}, {Low: 50, High: 60}, {Low: math.MaxInt32, High: math.MaxInt32}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	for p, match := range map[int32]bool{0: false, 1: true, 30: true, 31: false, 55: true, 61: false, math.MaxInt32: true} {
+		if got.Matches(p) != match {
+			t.Errorf("priority %d: expected %v", p, match)
+		}
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	a := NewIntervalSet(Interval{Low: 1, High: 10}, Interval{Low: 20, High: 30})
+	b := NewIntervalSet(Interval{Low: 5, High: 25})
+
+	if got, expected := a.Union(b), (IntervalSet{{Low: 1, High: 30}}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("union: expected %v, got %v", expected, got)
+	}
+	if got, expected := a.Intersect(b), (IntervalSet{{Low: 5, High: 10}, {Low: 20, High: 25}}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("intersect: expected %v, got %v", expected, got)
+	}
+	expected := IntervalSet{{Low: math.MinInt32, High: 0}, {Low: 11, High: 19}, {Low: 31, High: math.MaxInt32}}
+	if got := a.Complement(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("complement: expected %v, got %v", expected, got)
+	}
+	if got := allPriorities.Complement(); len(got) != 0 {
+		t.Errorf("complement of all priorities: expected empty, got %v", got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *PrioritySelector
+		expected IntervalSet
+		wantErr  bool
+	}{
+		{
+			name:     "nil selector matches nothing",
+			expected: IntervalSet{},
+		},
+		{
+			name: "overlapping between ranges collapse",
+			selector: &PrioritySelector{Expressions: []PriorityExpression{
+				{Operator: OperatorBetween, Values: []int32{100, 200}},
+				{Operator: OperatorBetween, Values: []int32{150, 300}},
+				{Operator: OperatorIn, Values: []int32{301, 500}},
+			}},
+			expected: IntervalSet{{Low: 100, High: 301}, {Low: 500, High: 500}},
+		},
+		{
+			name: "all and none expressions narrow the set",
+			selector: &PrioritySelector{
+				AllExpressions: []PriorityExpression{
+					{Operator: OperatorGreaterThan, Values: []int32{0}},
+					{Operator: OperatorLessThan, Values: []int32{1000}},
+				},
+				NoneExpressions: []PriorityExpression{{Operator: OperatorNotIn, Values: []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}},
+			},
+			expected: IntervalSet{{Low: 1, High: 10}},
+		},
+		{
+			name:     "greater than max priority matches nothing",
+			selector: &PrioritySelector{Expressions: []PriorityExpression{{Operator: OperatorGreaterThan, Values: []int32{math.MaxInt32}}}},
+			expected: IntervalSet{},
+		},
+		{
+			name:     "mod cannot be normalized",
+			selector: &PrioritySelector{Expressions: []PriorityExpression{{Operator: OperatorMod, Values: []int32{1000, 0}}}},
+			wantErr:  true,
+		},
+		{
+			name:     "cel cannot be normalized",
+			selector: &PrioritySelector{CELExpression: "job.priority > 10"},
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Normalize(test.selector)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !test.wantErr && !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSelectorUnionAndIntersect(t *testing.T) {
+	low := &PrioritySelector{Expressions: []PriorityExpression{{Operator: OperatorBetween, Values: []int32{0, 100}}}}
+	high := &PrioritySelector{Expressions: []PriorityExpression{{Operator: OperatorBetween, Values: []int32{50, 200}}}}
+
+	union, err := SelectorUnion(low, high)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []PriorityExpression{{Operator: OperatorBetween, Values: []int32{0, 200}}}
+	if !reflect.DeepEqual(union.Expressions, expected) {
+		t.Errorf("union: expected %v, got %v", expected, union.Expressions)
+	}
+	if err := union.Validate(); err != nil {
+		t.Errorf("union: unexpected validation error: %v", err)
+	}
+
+	intersect, err := SelectorIntersect(low, high)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for p, match := range map[int32]bool{49: false, 50: true, 100: true, 101: false} {
+		if intersect.Matches(p) != match {
+			t.Errorf("intersect: priority %d: expected %v", p, match)
+		}
+	}
+
+	mod := &PrioritySelector{Expressions: []PriorityExpression{{Operator: OperatorMod, Values: []int32{10, 0}}}}
+	if _, err := SelectorUnion(low, mod); err == nil {
+		t.Errorf("expected an error for a selector that cannot be normalized")
+	}
+}
+
+func TestValidatedSelectorUsesIntervals(t *testing.T) {
+	s := &PrioritySelector{
+		Expressions:     []PriorityExpression{{Operator: OperatorBetween, Values: []int32{0, 100}}},
+		NoneExpressions: []PriorityExpression{{Operator: OperatorIn, Values: []int32{50}}},
+	}
+	if err := s.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !s.normalized {
+		t.Fatalf("expected the selector to be normalized")
+	}
+	for p, match := range map[int32]bool{0: true, 49: true, 50: false, 100: true, 101: false} {
+		if s.Matches(p) != match {
+			t.Errorf("priority %d: expected %v", p, match)
+		}
+	}
+}
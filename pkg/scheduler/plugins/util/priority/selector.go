@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/mitchellh/mapstructure"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+// Operator is the relation between a priority and the values of an expression.
+type Operator string
+
+const (
+	// OperatorIn matches priorities equal to one of the values.
+	OperatorIn Operator = "In"
+	// OperatorNotIn matches priorities equal to none of the values.
+	OperatorNotIn Operator = "NotIn"
+	// OperatorGreaterThan matches priorities greater than the single value.
+	OperatorGreaterThan Operator = "GreaterThan"
+	// OperatorLessThan matches priorities less than the single value.
+	OperatorLessThan Operator = "LessThan"
+	// OperatorBetween matches priorities within the two values, both inclusive.
+	OperatorBetween Operator = "Between"
+	// OperatorMod matches priorities whose remainder modulo the first value equals the second value.
+	OperatorMod Operator = "Mod"
+	// OperatorStepRange matches priorities whose offset within their band, of the size of the
+	// first value, is within the second and third values, both inclusive. E.g. with bands of
+	// 1000, values [1000, 0, 99] match 0..99, 1000..1099, 2000..2099 and so on.
+	OperatorStepRange Operator = "StepRange"
+	// OperatorInClasses matches priorities equal to the value of one of the PriorityClasses.
+	OperatorInClasses Operator = "InClasses"
+	// OperatorNotInClasses matches priorities equal to the value of none of the PriorityClasses.
+	OperatorNotInClasses Operator = "NotInClasses"
+)
+
+// PriorityExpression matches a priority against a set of values.
+type PriorityExpression struct {
+	Operator Operator `json:"operator"`
+	Values   []int32  `json:"values"`
+	// Classes are PriorityClass names, used by the InClasses and NotInClasses operators.
+	Classes []string `json:"classes"`
+}
+
+// PrioritySelector selects priorities matched by any of its expressions, all of its
+// allExpressions and none of its noneExpressions. Empty lists are ignored.
+//
+// It is meant to be decoded from plugin arguments, e.g. priorities between 10 and 100
+// except 50 are selected by
+//
+//	exemptPriorities:
+//	  allExpressions:
+//	  - operator: Between
+//	    values: [10, 100]
+//	  noneExpressions:
+//	  - operator: In
+//	    values: [50]
+type PrioritySelector struct {
+	// Expressions are ORed.
+	Expressions []PriorityExpression `json:"expressions"`
+	// AllExpressions are ANDed.
+	AllExpressions []PriorityExpression `json:"allExpressions"`
+	// NoneExpressions must all fail to match.
+	NoneExpressions []PriorityExpression `json:"noneExpressions"`
+	// CELExpression is a boolean CEL predicate over job.priority, job.queue, job.namespace and
+	// job.labels, e.g. "job.priority > 100 && job.labels['tier'] == 'prod'". It is evaluated by
+	// MatchesWorkload; Matches evaluates it with only the priority set.
+	CELExpression string `json:"celExpression"`
+
+	celProgram cel.Program
+	// intervals is the normalized form of the selector, valid if normalized is set.
+	intervals  IntervalSet
+	normalized bool
+}
+
+// ParseSelector decodes a selector from a plugin argument and validates it. Unlike
+// framework.Get, unknown fields and malformed expressions are reported as errors.
+// A nil argument returns a nil selector.
+func ParseSelector(raw interface{}) (*PrioritySelector, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	selector := &PrioritySelector{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode priority selector: %v", err)
+	}
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// Validate returns an error describing every malformed expression of the selector,
+// and compiles the CEL expression.
+func (s *PrioritySelector) Validate() error {
+	if s == nil || s.isEmpty() {
+		return fmt.Errorf("priority selector has no expressions")
+	}
+
+	var errs []error
+	errs = append(errs, validateExpressions("expressions", s.Expressions)...)
+	errs = append(errs, validateExpressions("allExpressions", s.AllExpressions)...)
+	errs = append(errs, validateExpressions("noneExpressions", s.NoneExpressions)...)
+	if s.CELExpression != "" {
+		program, err := compileCEL(s.CELExpression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("celExpression: %v", err))
+		} else {
+			s.celProgram = program
+		}
+	}
+	if len(errs) == 0 {
+		s.normalize()
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// normalize precompiles the selector into an IntervalSet if possible, so that Matches
+// runs in logarithmic time regardless of the number of expressions.
+func (s *PrioritySelector) normalize() {
+	if set, err := Normalize(s); err == nil {
+		s.intervals, s.normalized = set, true
+	}
+}
+
+func (s *PrioritySelector) isEmpty() bool {
+	return len(s.Expressions)+len(s.AllExpressions)+len(s.NoneExpressions) == 0 && s.CELExpression == ""
+}
+
+func validateExpressions(field string, expressions []PriorityExpression) []error {
+	var errs []error
+	for i, e := range expressions {
+		if err := e.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %v", field, i, err))
+		}
+	}
+	return errs
+}
+
+// Validate returns an error if the operator is unknown or the number of values does not fit it.
+func (e PriorityExpression) Validate() error {
+	switch e.Operator {
+	case OperatorInClasses, OperatorNotInClasses:
+		if len(e.Classes) == 0 {
+			return fmt.Errorf("operator %s requires at least one class", e.Operator)
+		}
+		if len(e.Values) != 0 {
+			return fmt.Errorf("operator %s does not take values", e.Operator)
+		}
+		return nil
+	}
+
+	if len(e.Classes) != 0 {
+		return fmt.Errorf("operator %s does not take classes", e.Operator)
+	}
+	switch e.Operator {
+	case OperatorIn, OperatorNotIn:
+		if len(e.Values) == 0 {
+			return fmt.Errorf("operator %s requires at least one value", e.Operator)
+		}
+	case OperatorGreaterThan, OperatorLessThan:
+		if len(e.Values) != 1 {
+			return fmt.Errorf("operator %s requires exactly one value, got %d", e.Operator, len(e.Values))
+		}
+	case OperatorBetween:
+		if len(e.Values) != 2 {
+			return fmt.Errorf("operator %s requires exactly two values, got %d", e.Operator, len(e.Values))
+		}
+		if e.Values[0] > e.Values[1] {
+			return fmt.Errorf("operator %s requires the lower bound %d not to exceed the upper bound %d", e.Operator, e.Values[0], e.Values[1])
+		}
+	case OperatorMod:
+		if len(e.Values) != 2 {
+			return fmt.Errorf("operator %s requires a divisor and a remainder, got %d values", e.Operator, len(e.Values))
+		}
+		if e.Values[0] <= 0 {
+			return fmt.Errorf("operator %s requires a positive divisor, got %d", e.Operator, e.Values[0])
+		}
+		if e.Values[1] < 0 || e.Values[1] >= e.Values[0] {
+			return fmt.Errorf("operator %s requires the remainder %d to be within [0, %d)", e.Operator, e.Values[1], e.Values[0])
+		}
+	case OperatorStepRange:
+		if len(e.Values) != 3 {
+			return fmt.Errorf("operator %s requires a step and two bounds, got %d values", e.Operator, len(e.Values))
+		}
+		if e.Values[0] <= 0 {
+			return fmt.Errorf("operator %s requires a positive step, got %d", e.Operator, e.Values[0])
+		}
+		if e.Values[1] < 0 || e.Values[1] > e.Values[2] || e.Values[2] >= e.Values[0] {
+			return fmt.Errorf("operator %s requires 0 <= %d <= %d < %d", e.Operator, e.Values[1], e.Values[2], e.Values[0])
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", e.Operator)
+	}
+	return nil
+}
+
+// Matches returns whether the priority satisfies the expression. A malformed
+// expression, i.e. an unknown operator or a wrong number of values, matches nothing,
+// and so does a PriorityClass expression which was not resolved.
+func (e PriorityExpression) Matches(priority int32) bool {
+	switch e.Operator {
+	case OperatorIn:
+		for _, v := range e.Values {
+			if priority == v {
+				return true
+			}
+		}
+		return false
+	case OperatorNotIn:
+		for _, v := range e.Values {
+			if priority == v {
+				return false
+			}
+		}
+		return true
+	case OperatorGreaterThan:
+		return len(e.Values) == 1 && priority > e.Values[0]
+	case OperatorLessThan:
+		return len(e.Values) == 1 && priority < e.Values[0]
+	case OperatorBetween:
+		return len(e.Values) == 2 && priority >= e.Values[0] && priority <= e.Values[1]
+	case OperatorMod:
+		return len(e.Values) == 2 && e.Values[0] > 0 && mod(priority, e.Values[0]) == e.Values[1]
+	case OperatorStepRange:
+		if len(e.Values) != 3 || e.Values[0] <= 0 {
+			return false
+		}
+		offset := mod(priority, e.Values[0])
+		return offset >= e.Values[1] && offset <= e.Values[2]
+	default:
+		return false
+	}
+}
+
+// mod returns the non-negative remainder of p divided by the positive divisor d,
+// so that negative priorities fall into bands the same way as positive ones.
+func mod(p, d int32) int32 {
+	return ((p % d) + d) % d
+}
+
+// Matches returns whether the priority is selected. A nil or empty selector matches nothing.
+func (s *PrioritySelector) Matches(priority int32) bool {
+	return s.MatchesWorkload(Workload{Priority: priority})
+}
+
+// MatchesWorkload returns whether the workload is selected. A nil or empty selector matches nothing.
+// A CEL expression which was not compiled by Validate matches nothing.
+func (s *PrioritySelector) MatchesWorkload(w Workload) bool {
+	if s == nil || s.isEmpty() {
+		return false
+	}
+	if s.normalized {
+		return s.intervals.Matches(w.Priority)
+	}
+	if len(s.Expressions) > 0 && !anyMatches(s.Expressions, w.Priority) {
+		return false
+	}
+	for _, e := range s.AllExpressions {
+		if !e.Matches(w.Priority) {
+			return false
+		}
+	}
+	if anyMatches(s.NoneExpressions, w.Priority) {
+		return false
+	}
+	if s.CELExpression != "" {
+		return s.celProgram != nil && evalCEL(s.celProgram, s.CELExpression, w)
+	}
+	return true
+}
+
+func anyMatches(expressions []PriorityExpression, priority int32) bool {
+	for _, e := range expressions {
+		if e.Matches(priority) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns a copy of the selector in which the PriorityClass expressions are replaced by
+// the values of the classes, e.g. taken from the PriorityClasses of the session. Classes which
+// do not exist are skipped. Jobs are matched by priority value, so a class also selects the
+// jobs of other classes sharing its value.
+func (s *PrioritySelector) Resolve(classes map[string]int32) *PrioritySelector {
+	if s == nil {
+		return nil
+	}
+	resolved := &PrioritySelector{
+		Expressions:     resolveExpressions(s.Expressions, classes),
+		AllExpressions:  resolveExpressions(s.AllExpressions, classes),
+		NoneExpressions: resolveExpressions(s.NoneExpressions, classes),
+		CELExpression:   s.CELExpression,
+		celProgram:      s.celProgram,
+	}
+	resolved.normalize()
+	return resolved
+}
+
+func resolveExpressions(expressions []PriorityExpression, classes map[string]int32) []PriorityExpression {
+	if expressions == nil {
+		return nil
+	}
+
+	resolved := make([]PriorityExpression, 0, len(expressions))
+	for _, e := range expressions {
+		switch e.Operator {
+		case OperatorInClasses:
+			resolved = append(resolved, PriorityExpression{Operator: OperatorIn, Values: classValues(e.Classes, classes)})
+		case OperatorNotInClasses:
+			resolved = append(resolved, PriorityExpression{Operator: OperatorNotIn, Values: classValues(e.Classes, classes)})
+		default:
+			resolved = append(resolved, e)
+		}
+	}
+	return resolved
+}
+
+func classValues(names []string, classes map[string]int32) []int32 {
+	values := make([]int32, 0, len(names))
+	for _, name := range names {
+		if value, found := classes[name]; found {
+			values = append(values, value)
+		} else {
+			klog.V(4).Infof("PriorityClass %s referenced by a priority selector does not exist", name)
+		}
+	}
+	return values
+}
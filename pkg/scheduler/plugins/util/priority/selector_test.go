@@ -0,0 +1,337 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"strings"
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+func TestPriorityExpressionMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     PriorityExpression
+		priority int32
+		expected bool
+	}{
+		{name: "in matches", expr: PriorityExpression{Operator: OperatorIn, Values: []int32{1, 5}}, priority: 5, expected: true},
+		{name: "in does not match", expr: PriorityExpression{Operator: OperatorIn, Values: []int32{1, 5}}, priority: 3, expected: false},
+		{name: "not in matches", expr: PriorityExpression{Operator: OperatorNotIn, Values: []int32{1, 5}}, priority: 3, expected: true},
+		{name: "not in does not match", expr: PriorityExpression{Operator: OperatorNotIn, Values: []int32{1, 5}}, priority: 1, expected: false},
+		{name: "greater than", expr: PriorityExpression{Operator: OperatorGreaterThan, Values: []int32{10}}, priority: 11, expected: true},
+		{name: "greater than is strict", expr: PriorityExpression{Operator: OperatorGreaterThan, Values: []int32{10}}, priority: 10, expected: false},
+		{name: "less than", expr: PriorityExpression{Operator: OperatorLessThan, Values: []int32{10}}, priority: 9, expected: true},
+		{name: "between is inclusive", expr: PriorityExpression{Operator: OperatorBetween, Values: []int32{10, 20}}, priority: 20, expected: true},
+		{name: "between out of range", expr: PriorityExpression{Operator: OperatorBetween, Values: []int32{10, 20}}, priority: 21, expected: false},
+		{name: "between with one value matches nothing", expr: PriorityExpression{Operator: OperatorBetween, Values: []int32{10}}, priority: 10, expected: false},
+		{name: "mod matches remainder", expr: PriorityExpression{Operator: OperatorMod, Values: []int32{1000, 42}}, priority: 3042, expected: true},
+		{name: "mod does not match other remainder", expr: PriorityExpression{Operator: OperatorMod, Values: []int32{1000, 42}}, priority: 3043, expected: false},
+		{name: "mod of negative priority", expr: PriorityExpression{Operator: OperatorMod, Values: []int32{1000, 958}}, priority: -42, expected: true},
+		{name: "step range matches offset in band", expr: PriorityExpression{Operator: OperatorStepRange, Values: []int32{1000, 0, 99}}, priority: 7050, expected: true},
+		{name: "step range excludes offset out of band range", expr: PriorityExpression{Operator: OperatorStepRange, Values: []int32{1000, 0, 99}}, priority: 7100, expected: false},
+		{name: "step range with zero step matches nothing", expr: PriorityExpression{Operator: OperatorStepRange, Values: []int32{0, 0, 99}}, priority: 50, expected: false},
+		{name: "unresolved class expression matches nothing", expr: PriorityExpression{Operator: OperatorInClasses, Classes: []string{"prod"}}, priority: 10, expected: false},
+		{name: "unknown operator matches nothing", expr: PriorityExpression{Operator: "Equals", Values: []int32{10}}, priority: 10, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.expr.Matches(test.priority); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPrioritySelectorMatches(t *testing.T) {
+	arguments := framework.Arguments{
+		"selector": map[interface{}]interface{}{
+			"expressions": []interface{}{
+				map[interface{}]interface{}{"operator": "GreaterThan", "values": []interface{}{1000}},
+				map[interface{}]interface{}{"operator": "In", "values": []interface{}{100, 200}},
+			},
+		},
+	}
+	selector, ok := framework.Get[PrioritySelector](arguments, "selector")
+	if !ok {
+		t.Fatalf("expected selector to be decoded")
+	}
+
+	for priority, expected := range map[int32]bool{1001: true, 1000: false, 100: true, 150: false} {
+		if got := selector.Matches(priority); got != expected {
+			t.Errorf("priority %d: expected %v, got %v", priority, expected, got)
+		}
+	}
+
+	var nilSelector *PrioritySelector
+	if nilSelector.Matches(1001) {
+		t.Errorf("expected nil selector to match nothing")
+	}
+}
+
+func TestPrioritySelectorCombinedMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *PrioritySelector
+		matches  map[int32]bool
+	}{
+		{
+			name: "between 10 and 100 and not 50",
+			selector: &PrioritySelector{
+				AllExpressions:  []PriorityExpression{{Operator: OperatorBetween, Values: []int32{10, 100}}},
+				NoneExpressions: []PriorityExpression{{Operator: OperatorIn, Values: []int32{50}}},
+			},
+			matches: map[int32]bool{9: false, 10: true, 50: false, 100: true, 101: false},
+		},
+		{
+			name: "any and all combined",
+			selector: &PrioritySelector{
+				Expressions: []PriorityExpression{
+					{Operator: OperatorIn, Values: []int32{5}},
+					{Operator: OperatorGreaterThan, Values: []int32{100}},
+				},
+				AllExpressions: []PriorityExpression{{Operator: OperatorLessThan, Values: []int32{1000}}},
+			},
+			matches: map[int32]bool{5: true, 50: false, 500: true, 1000: false},
+		},
+		{
+			name: "none only",
+			selector: &PrioritySelector{
+				NoneExpressions: []PriorityExpression{{Operator: OperatorLessThan, Values: []int32{0}}},
+			},
+			matches: map[int32]bool{-1: false, 0: true},
+		},
+		{
+			name:     "empty selector",
+			selector: &PrioritySelector{},
+			matches:  map[int32]bool{0: false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for priority, expected := range test.matches {
+				if got := test.selector.Matches(priority); got != expected {
+					t.Errorf("priority %d: expected %v, got %v", priority, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         interface{}
+		expectNil   bool
+		expectedErr string
+	}{
+		{
+			name:      "nil argument",
+			raw:       nil,
+			expectNil: true,
+		},
+		{
+			name: "valid selector",
+			raw: map[interface{}]interface{}{
+				"expressions": []interface{}{
+					map[interface{}]interface{}{"operator": "Between", "values": []interface{}{10, 100}},
+				},
+			},
+		},
+		{
+			name:        "no expressions",
+			raw:         map[string]interface{}{"expressions": []interface{}{}},
+			expectedErr: "no expressions",
+		},
+		{
+			name: "unknown operator",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "Equals", "values": []interface{}{10}},
+				},
+			},
+			expectedErr: `expressions[0]: unknown operator "Equals"`,
+		},
+		{
+			name: "wrong value count",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "In", "values": []interface{}{1}},
+					map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{1, 2}},
+				},
+			},
+			expectedErr: "expressions[1]: operator GreaterThan requires exactly one value, got 2",
+		},
+		{
+			name: "inverted range",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "Between", "values": []interface{}{100, 10}},
+				},
+			},
+			expectedErr: "lower bound 100 not to exceed the upper bound 10",
+		},
+		{
+			name: "malformed none expression",
+			raw: map[string]interface{}{
+				"allExpressions": []interface{}{
+					map[string]interface{}{"operator": "Between", "values": []interface{}{10, 100}},
+				},
+				"noneExpressions": []interface{}{
+					map[string]interface{}{"operator": "Between", "values": []interface{}{50}},
+				},
+			},
+			expectedErr: "noneExpressions[0]: operator Between requires exactly two values, got 1",
+		},
+		{
+			name: "mod with zero divisor",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "Mod", "values": []interface{}{0, 0}},
+				},
+			},
+			expectedErr: "expressions[0]: operator Mod requires a positive divisor, got 0",
+		},
+		{
+			name: "step range bounds outside of step",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "StepRange", "values": []interface{}{1000, 0, 1000}},
+				},
+			},
+			expectedErr: "expressions[0]: operator StepRange requires 0 <= 0 <= 1000 < 1000",
+		},
+		{
+			name: "class operator without classes",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "InClasses", "values": []interface{}{1}},
+				},
+			},
+			expectedErr: "expressions[0]: operator InClasses requires at least one class",
+		},
+		{
+			name: "value operator with classes",
+			raw: map[string]interface{}{
+				"expressions": []interface{}{
+					map[string]interface{}{"operator": "In", "values": []interface{}{1}, "classes": []interface{}{"prod"}},
+				},
+			},
+			expectedErr: "expressions[0]: operator In does not take classes",
+		},
+		{
+			name: "unknown field",
+			raw: map[string]interface{}{
+				"expresions": []interface{}{},
+			},
+			expectedErr: "failed to decode priority selector",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := ParseSelector(test.raw)
+			if test.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (selector == nil) != test.expectNil {
+				t.Errorf("expected nil selector %v, got %v", test.expectNil, selector)
+			}
+		})
+	}
+}
+
+func TestPrioritySelectorResolve(t *testing.T) {
+	selector, err := ParseSelector(map[string]interface{}{
+		"expressions": []interface{}{
+			map[string]interface{}{"operator": "InClasses", "classes": []interface{}{"prod", "missing"}},
+		},
+		"noneExpressions": []interface{}{
+			map[string]interface{}{"operator": "NotInClasses", "classes": []interface{}{"prod", "high-batch"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolved := selector.Resolve(map[string]int32{"prod": 1000, "high-batch": 500, "low": 10})
+	for priority, expected := range map[int32]bool{1000: true, 500: false, 10: false} {
+		if got := resolved.Matches(priority); got != expected {
+			t.Errorf("priority %d: expected %v, got %v", priority, expected, got)
+		}
+	}
+	if selector.Matches(1000) {
+		t.Errorf("expected the unresolved selector to be left untouched")
+	}
+}
+
+func TestPrioritySelectorCEL(t *testing.T) {
+	selector, err := ParseSelector(map[string]interface{}{
+		"allExpressions": []interface{}{
+			map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{0}},
+		},
+		"celExpression": "job.priority % 1000 < 100 && (job.namespace == 'prod' || job.labels['tier'] == 'critical')",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		workload Workload
+		expected bool
+	}{
+		{name: "namespace matches", workload: Workload{Priority: 2050, Namespace: "prod"}, expected: true},
+		{name: "label matches", workload: Workload{Priority: 2050, Namespace: "dev", Labels: map[string]string{"tier": "critical"}}, expected: true},
+		{name: "missing label does not match", workload: Workload{Priority: 2050, Namespace: "dev"}, expected: false},
+		{name: "priority out of band", workload: Workload{Priority: 2150, Namespace: "prod"}, expected: false},
+		{name: "expressions still apply", workload: Workload{Priority: -950, Namespace: "prod"}, expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := selector.MatchesWorkload(test.workload); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+
+	if !selector.Resolve(nil).MatchesWorkload(Workload{Priority: 2050, Namespace: "prod"}) {
+		t.Errorf("expected the resolved selector to keep the CEL expression")
+	}
+}
+
+func TestParseSelectorCELErrors(t *testing.T) {
+	for expression, expectedErr := range map[string]string{
+		"job.priority >": "celExpression:",
+		"job.queue":      "expression must evaluate to bool",
+		"priority == 1":  "undeclared reference",
+	} {
+		_, err := ParseSelector(map[string]interface{}{"celExpression": expression})
+		if err == nil || !strings.Contains(err.Error(), expectedErr) {
+			t.Errorf("expression %q: expected error containing %q, got %v", expression, expectedErr, err)
+		}
+	}
+}
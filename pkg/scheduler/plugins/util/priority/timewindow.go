@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const minutesPerDay = 24 * 60
+
+// weekdays are the accepted names of the days of a TimeWindow, lowercased.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// TimeWindow is a range of hours on some days of the week.
+type TimeWindow struct {
+	// Days are the days the window starts on, as names or ranges of names, e.g. "Mon-Fri"
+	// or "Sat". Ranges may wrap around the week, e.g. "Fri-Mon". Empty means every day.
+	Days []string `json:"days"`
+	// Start is the inclusive start of the window, as HH:MM, 00:00 if empty.
+	Start string `json:"start"`
+	// End is the exclusive end of the window, as HH:MM, 00:00 if empty. An end not after
+	// the start ends on the next day, e.g. 22:00 to 06:00 is a night, and 00:00 to 00:00
+	// is a whole day.
+	End string `json:"end"`
+
+	days       [7]bool
+	start, end int
+}
+
+// TimeWindowSelector selects the times within any of its windows, in its time zone.
+//
+// It is meant to be decoded from plugin arguments, e.g. business hours in Paris are
+// selected by
+//
+//	activeWindow:
+//	  timeZone: Europe/Paris
+//	  windows:
+//	  - days: [Mon-Fri]
+//	    start: "09:00"
+//	    end: "18:00"
+type TimeWindowSelector struct {
+	// Windows are ORed.
+	Windows []TimeWindow `json:"windows"`
+	// TimeZone is the IANA name of the time zone of the windows, UTC if empty.
+	TimeZone string `json:"timeZone"`
+
+	location *time.Location
+	// validated is set by Validate, a selector not validated contains no time.
+	validated bool
+}
+
+// ParseTimeWindowSelector decodes a selector from a plugin argument and validates it.
+// Unknown fields and malformed windows are reported as errors. A nil argument returns a
+// nil selector.
+func ParseTimeWindowSelector(raw interface{}) (*TimeWindowSelector, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	selector := &TimeWindowSelector{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode time window selector: %v", err)
+	}
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// Validate returns an error describing every malformed window of the selector, and
+// loads its time zone.
+func (s *TimeWindowSelector) Validate() error {
+	if s == nil || len(s.Windows) == 0 {
+		return fmt.Errorf("time window selector has no windows")
+	}
+
+	var errs []error
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("timeZone: %v", err))
+	}
+	for i := range s.Windows {
+		if err := s.Windows[i].parse(); err != nil {
+			errs = append(errs, fmt.Errorf("windows[%d]: %v", i, err))
+		}
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	s.location, s.validated = location, true
+	return nil
+}
+
+// parse parses the days and hours of the window.
+func (w *TimeWindow) parse() error {
+	var err error
+	if w.start, err = parseClock(w.Start); err != nil {
+		return fmt.Errorf("start: %v", err)
+	}
+	if w.end, err = parseClock(w.End); err != nil {
+		return fmt.Errorf("end: %v", err)
+	}
+
+	w.days = [7]bool{}
+	if len(w.Days) == 0 {
+		for day := range w.days {
+			w.days[day] = true
+		}
+		return nil
+	}
+	for _, value := range w.Days {
+		first, last, isRange := strings.Cut(value, "-")
+		from, found := weekdays[strings.ToLower(strings.TrimSpace(first))]
+		if !found {
+			return fmt.Errorf("unknown day %q", first)
+		}
+		to := from
+		if isRange {
+			if to, found = weekdays[strings.ToLower(strings.TrimSpace(last))]; !found {
+				return fmt.Errorf("unknown day %q", last)
+			}
+		}
+		for day := from; ; day = (day + 1) % 7 {
+			w.days[day] = true
+			if day == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// parseClock parses a time of the day as HH:MM into minutes since midnight, 0 if empty.
+func parseClock(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of the day %q, expected HH:MM", value)
+	}
+	return clock.Hour()*60 + clock.Minute(), nil
+}
+
+// Contains returns whether the time is within any window of the selector. A nil selector
+// contains every time, so that an optional window left unset is always active; a selector
+// not validated contains none.
+func (s *TimeWindowSelector) Contains(t time.Time) bool {
+	if s == nil {
+		return true
+	}
+	if !s.validated {
+		return false
+	}
+	local := t.In(s.location)
+	minute := local.Hour()*60 + local.Minute()
+	for _, w := range s.Windows {
+		if w.contains(local.Weekday(), minute) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns whether the minute of the day is within the window. The part of a
+// window past midnight belongs to the day the window starts on.
+func (w TimeWindow) contains(day time.Weekday, minute int) bool {
+	if w.start < w.end {
+		return w.days[day] && minute >= w.start && minute < w.end
+	}
+	previous := (day + 6) % 7
+	return (w.days[day] && minute >= w.start) || (w.days[previous] && minute < w.end)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeWindowSelectorContains(t *testing.T) {
+	businessHours := map[string]interface{}{
+		"timeZone": "Europe/Paris",
+		"windows": []interface{}{
+			map[string]interface{}{"days": []interface{}{"Mon-Fri"}, "start": "09:00", "end": "18:00"},
+		},
+	}
+	nights := map[string]interface{}{
+		"windows": []interface{}{
+			map[string]interface{}{"days": []interface{}{"fri"}, "start": "22:00", "end": "06:00"},
+		},
+	}
+	weekend := map[string]interface{}{
+		"windows": []interface{}{
+			map[string]interface{}{"days": []interface{}{"Sat-Sun"}},
+		},
+	}
+
+	// 2026-01-09 is a Friday.
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		time     time.Time
+		expected bool
+	}{
+		{name: "within business hours", raw: businessHours, time: time.Date(2026, 1, 9, 10, 0, 0, 0, time.UTC), expected: true},
+		{name: "business hours in time zone", raw: businessHours, time: time.Date(2026, 1, 9, 17, 30, 0, 0, time.UTC), expected: false},
+		{name: "before business hours", raw: businessHours, time: time.Date(2026, 1, 9, 7, 59, 0, 0, time.UTC), expected: false},
+		{name: "business hours on saturday", raw: businessHours, time: time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC), expected: false},
+		{name: "night before midnight", raw: nights, time: time.Date(2026, 1, 9, 23, 0, 0, 0, time.UTC), expected: true},
+		{name: "night after midnight", raw: nights, time: time.Date(2026, 1, 10, 5, 59, 0, 0, time.UTC), expected: true},
+		{name: "night end is exclusive", raw: nights, time: time.Date(2026, 1, 10, 6, 0, 0, 0, time.UTC), expected: false},
+		{name: "night of another day", raw: nights, time: time.Date(2026, 1, 8, 23, 0, 0, 0, time.UTC), expected: false},
+		{name: "whole weekend day", raw: weekend, time: time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), expected: true},
+		{name: "weekend ends on monday", raw: weekend, time: time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := ParseTimeWindowSelector(test.raw)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := selector.Contains(test.time); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+
+	var unset *TimeWindowSelector
+	if !unset.Contains(time.Now()) {
+		t.Errorf("expected a nil selector to contain every time")
+	}
+	if (&TimeWindowSelector{Windows: []TimeWindow{{}}}).Contains(time.Now()) {
+		t.Errorf("expected a selector not validated to contain no time")
+	}
+}
+
+func TestParseTimeWindowSelectorErrors(t *testing.T) {
+	for name, raw := range map[string]interface{}{
+		"no windows":       map[string]interface{}{"timeZone": "UTC"},
+		"unknown field":    map[string]interface{}{"windows": []interface{}{map[string]interface{}{}}, "zone": "UTC"},
+		"unknown timeZone": map[string]interface{}{"windows": []interface{}{map[string]interface{}{}}, "timeZone": "Mars/Olympus"},
+		"unknown day":      map[string]interface{}{"windows": []interface{}{map[string]interface{}{"days": []interface{}{"Mon-Fry"}}}},
+		"invalid start":    map[string]interface{}{"windows": []interface{}{map[string]interface{}{"start": "9am"}}},
+		"invalid end":      map[string]interface{}{"windows": []interface{}{map[string]interface{}{"end": "24:00"}}},
+	} {
+		if _, err := ParseTimeWindowSelector(raw); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if selector, err := ParseTimeWindowSelector(nil); selector != nil || err != nil {
+		t.Errorf("expected a nil selector without error for a nil argument, got %v, %v", selector, err)
+	}
+}
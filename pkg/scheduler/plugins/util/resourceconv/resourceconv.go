@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourceconv converts between api.Resource and v1.ResourceList and does the
+// arithmetic of ResourceLists, for the plugins that report or configure resources as
+// Kubernetes quantities.
+//
+// api.Resource keeps cpu and the scalar resources in milli units, memory in bytes and
+// pods in whole units. The conversions round to the nearest unit instead of truncating,
+// so that a value computed in floating point, e.g. 1999.9999 milli cpu, is not reported
+// one unit short.
+package resourceconv
+
+import (
+	"math"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Quantity converts a value of the resource, in api.Resource units, into a Quantity.
+func Quantity(name v1.ResourceName, value float64) resource.Quantity {
+	rounded := int64(math.Round(value))
+	switch name {
+	case v1.ResourceMemory:
+		return *resource.NewQuantity(rounded, resource.BinarySI)
+	case v1.ResourcePods:
+		return *resource.NewQuantity(rounded, resource.DecimalSI)
+	default:
+		return *resource.NewMilliQuantity(rounded, resource.DecimalSI)
+	}
+}
+
+// Value converts a Quantity of the resource into api.Resource units.
+func Value(name v1.ResourceName, quantity resource.Quantity) float64 {
+	switch name {
+	case v1.ResourceMemory, v1.ResourcePods:
+		return float64(quantity.Value())
+	default:
+		return float64(quantity.MilliValue())
+	}
+}
+
+// MetricValue converts a value of the resource, in api.Resource units, into its natural
+// unit: cores for cpu, bytes for memory and whole units for the other resources.
+func MetricValue(name v1.ResourceName, value float64) float64 {
+	switch name {
+	case v1.ResourceMemory, v1.ResourcePods:
+		return value
+	default:
+		return value / 1000
+	}
+}
+
+// Set sets one dimension of the resource, in api.Resource units.
+func Set(r *api.Resource, name v1.ResourceName, value float64) {
+	switch name {
+	case v1.ResourceCPU:
+		r.MilliCPU = value
+	case v1.ResourceMemory:
+		r.Memory = value
+	default:
+		r.SetScalar(name, value)
+	}
+}
+
+// ToResourceList converts the given dimensions of the resource into a ResourceList.
+func ToResourceList(r *api.Resource, names []v1.ResourceName) v1.ResourceList {
+	list := make(v1.ResourceList, len(names))
+	for _, name := range names {
+		list[name] = Quantity(name, r.Get(name))
+	}
+	return list
+}
+
+// FromResourceList converts a ResourceList into a resource. Unlike api.NewResource it keeps
+// every resource of the list, including the ones the scheduler ignores.
+func FromResourceList(list v1.ResourceList) *api.Resource {
+	r := api.EmptyResource()
+	for name, quantity := range list {
+		Set(r, name, Value(name, quantity))
+	}
+	return r
+}
+
+// Add returns the sum of the lists, a resource missing from a list counts as zero.
+func Add(a, b v1.ResourceList) v1.ResourceList {
+	sum := a.DeepCopy()
+	if sum == nil {
+		sum = v1.ResourceList{}
+	}
+	for name, quantity := range b {
+		value := sum[name]
+		value.Add(quantity)
+		sum[name] = value
+	}
+	return sum
+}
+
+// Sub returns a minus b, a resource missing from a list counts as zero. The result may
+// hold negative quantities.
+func Sub(a, b v1.ResourceList) v1.ResourceList {
+	diff := a.DeepCopy()
+	if diff == nil {
+		diff = v1.ResourceList{}
+	}
+	for name, quantity := range b {
+		value := diff[name]
+		value.Sub(quantity)
+		diff[name] = value
+	}
+	return diff
+}
+
+// LessEqualPartial returns whether every resource of the limits is not exceeded by the
+// usage. The resources the limits do not list are not limited.
+func LessEqualPartial(usage, limits v1.ResourceList) bool {
+	for name, limit := range limits {
+		if used, found := usage[name]; found && used.Cmp(limit) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Names returns the resources of any of the lists, sorted.
+func Names(lists ...v1.ResourceList) []v1.ResourceName {
+	seen := map[v1.ResourceName]bool{}
+	for _, list := range lists {
+		for name := range list {
+			seen[name] = true
+		}
+	}
+	names := make([]v1.ResourceName, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceconv
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const gpu = v1.ResourceName("nvidia.com/gpu")
+
+func TestQuantity(t *testing.T) {
+	tests := []struct {
+		name     v1.ResourceName
+		value    float64
+		expected string
+	}{
+		{name: v1.ResourceCPU, value: 1500, expected: "1500m"},
+		{name: v1.ResourceCPU, value: 1999.9999, expected: "2"},
+		{name: v1.ResourceMemory, value: 1024 * 1024 * 1024, expected: "1Gi"},
+		{name: v1.ResourceMemory, value: 1023.6, expected: "1Ki"},
+		{name: v1.ResourcePods, value: 110, expected: "110"},
+		{name: gpu, value: 2000, expected: "2"},
+		{name: gpu, value: 499.9999, expected: "500m"},
+	}
+	for _, test := range tests {
+		q := Quantity(test.name, test.value)
+		if !q.Equal(resource.MustParse(test.expected)) {
+			t.Errorf("%s %v: expected %s, got %s", test.name, test.value, test.expected, q.String())
+		}
+		if got := Value(test.name, q); got != float64(int64(test.value+0.5)) {
+			t.Errorf("%s %v: expected the value to round trip, got %v", test.name, test.value, got)
+		}
+	}
+}
+
+func TestMetricValue(t *testing.T) {
+	tests := []struct {
+		name     v1.ResourceName
+		value    float64
+		expected float64
+	}{
+		{name: v1.ResourceCPU, value: 1500, expected: 1.5},
+		{name: v1.ResourceMemory, value: 1024, expected: 1024},
+		{name: v1.ResourcePods, value: 3, expected: 3},
+		{name: gpu, value: 2000, expected: 2},
+	}
+	for _, test := range tests {
+		if got := MetricValue(test.name, test.value); got != test.expected {
+			t.Errorf("%s %v: expected %v, got %v", test.name, test.value, test.expected, got)
+		}
+	}
+}
+
+func TestResourceListRoundTrip(t *testing.T) {
+	list := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("2500m"),
+		v1.ResourceMemory: resource.MustParse("4Gi"),
+		v1.ResourcePods:   resource.MustParse("10"),
+		gpu:               resource.MustParse("1"),
+	}
+	r := FromResourceList(list)
+	if r.MilliCPU != 2500 || r.Memory != 4*1024*1024*1024 || r.Get(gpu) != 1000 || r.Get(v1.ResourcePods) != 10 {
+		t.Fatalf("unexpected resource %v", r)
+	}
+	got := ToResourceList(r, Names(list))
+	for name, expected := range list {
+		if q := got[name]; !q.Equal(expected) {
+			t.Errorf("%s: expected %s, got %s", name, expected.String(), q.String())
+		}
+	}
+
+	if got := ToResourceList(api.EmptyResource(), []v1.ResourceName{gpu}); !got.Name(gpu, resource.DecimalSI).IsZero() {
+		t.Errorf("expected a zero quantity, got %v", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a := v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), gpu: resource.MustParse("1")}
+	b := v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")}
+
+	tests := []struct {
+		name     string
+		got      v1.ResourceList
+		expected v1.ResourceList
+	}{
+		{
+			name:     "add",
+			got:      Add(a, b),
+			expected: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2500m"), v1.ResourceMemory: resource.MustParse("1Gi"), gpu: resource.MustParse("1")},
+		},
+		{
+			name:     "sub",
+			got:      Sub(a, b),
+			expected: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1500m"), v1.ResourceMemory: resource.MustParse("-1Gi"), gpu: resource.MustParse("1")},
+		},
+		{
+			name:     "add to nil",
+			got:      Add(nil, a),
+			expected: a,
+		},
+	}
+	for _, test := range tests {
+		if len(test.got) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, test.got)
+			continue
+		}
+		for name, expected := range test.expected {
+			if q := test.got[name]; !q.Equal(expected) {
+				t.Errorf("%s %s: expected %s, got %s", test.name, name, expected.String(), q.String())
+			}
+		}
+	}
+	if q := a[v1.ResourceCPU]; !q.Equal(resource.MustParse("2")) {
+		t.Errorf("expected the operands to be left unchanged, got %s", q.String())
+	}
+}
+
+func TestLessEqualPartial(t *testing.T) {
+	limits := v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}
+	tests := []struct {
+		name     string
+		usage    v1.ResourceList
+		expected bool
+	}{
+		{name: "under the limit", usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("3")}, expected: true},
+		{name: "at the limit", usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}, expected: true},
+		{name: "over the limit", usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4001m")}, expected: false},
+		{name: "unlimited resource", usage: v1.ResourceList{gpu: resource.MustParse("8")}, expected: true},
+	}
+	for _, test := range tests {
+		if got := LessEqualPartial(test.usage, limits); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestNames(t *testing.T) {
+	got := Names(v1.ResourceList{gpu: resource.MustParse("1"), v1.ResourceCPU: resource.MustParse("1")},
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("1Gi")})
+	expected := []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, gpu}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runtime resolves the maximum run time of tasks, so that plugins can pick
+// the tasks that overran it as victims or order tasks by their deadline.
+package runtime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/informers"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// MaxRunTimeAnnotation is the pod, PodGroup or Namespace annotation limiting how long a task may run.
+// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+const MaxRunTimeAnnotation = "volcano.sh/max-run-time"
+
+// Limits resolves the maximum run time of a task, in order of precedence from the
+// annotation of its pod, the annotation of its PodGroup, the annotation of its namespace
+// once resolved WithNamespaces, the limit of its namespace, the limit of its queue and the
+// default limit. A nil Limits only honors the pod and PodGroup annotations.
+//
+// It is meant to be decoded from plugin arguments with Parse, e.g.
+//
+//	maxRunTime:
+//	  default: 24h
+//	  namespaces:
+//	    team-a: 12h
+//	  queues:
+//	    interactive: 2h
+type Limits struct {
+	// Default is the limit of tasks of queues not listed in Queues, 0 means unlimited.
+	Default time.Duration `json:"default"`
+	// Namespaces is the limit of tasks per namespace, 0 means unlimited. It takes
+	// precedence over the limit of the queue.
+	Namespaces map[string]time.Duration `json:"namespaces"`
+	// Queues is the limit of tasks per queue name, 0 means unlimited.
+	Queues map[string]time.Duration `json:"queues"`
+
+	// namespaceLister is nil unless the limits were resolved WithNamespaces.
+	namespaceLister corelisters.NamespaceLister
+}
+
+// Parse decodes and validates the limits. A nil input returns nil limits.
+func Parse(raw interface{}) (*Limits, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	limits := &Limits{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  mapstructure.StringToTimeDurationHookFunc(),
+		ErrorUnused: true,
+		Result:      limits,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode max run time: %v", err)
+	}
+	if err := limits.Validate(); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// Validate returns an error describing every negative limit.
+func (l *Limits) Validate() error {
+	if l == nil {
+		return nil
+	}
+
+	var errs []error
+	if l.Default < 0 {
+		errs = append(errs, fmt.Errorf("default: negative max run time %v", l.Default))
+	}
+	for namespace, limit := range l.Namespaces {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("namespaces[%s]: negative max run time %v", namespace, limit))
+		}
+	}
+	for queue, limit := range l.Queues {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("queues[%s]: negative max run time %v", queue, limit))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// WithNamespaces returns a copy of the limits which also honors the annotation of the
+// namespaces found in the informer factory, so that a namespace sets the default limit
+// of its tasks. A nil Limits returns limits honoring the annotations only.
+func (l *Limits) WithNamespaces(factory informers.SharedInformerFactory) *Limits {
+	resolved := &Limits{}
+	if l != nil {
+		*resolved = *l
+	}
+	if factory != nil {
+		resolved.namespaceLister = factory.Core().V1().Namespaces().Lister()
+	}
+	return resolved
+}
+
+// MaxRunTime returns the maximum run time of the task of the job, and false if the
+// task may run forever. Malformed annotations are logged and ignored.
+func (l *Limits) MaxRunTime(task *api.TaskInfo, job *api.JobInfo) (time.Duration, bool) {
+	if task != nil && task.Pod != nil {
+		if limit, found := parseAnnotation(task.Pod.Annotations, "pod", task.Namespace, task.Name); found {
+			return limit, limit > 0
+		}
+	}
+	if job != nil && job.PodGroup != nil {
+		if limit, found := parseAnnotation(job.PodGroup.Annotations, "podgroup", job.Namespace, job.Name); found {
+			return limit, limit > 0
+		}
+	}
+	if l == nil {
+		return 0, false
+	}
+	if namespace := namespaceOf(task, job); namespace != "" {
+		if limit, found := l.namespaceAnnotation(namespace); found {
+			return limit, limit > 0
+		}
+		if limit, found := l.Namespaces[namespace]; found {
+			return limit, limit > 0
+		}
+	}
+	if job != nil {
+		if limit, found := l.Queues[string(job.Queue)]; found {
+			return limit, limit > 0
+		}
+	}
+	return l.Default, l.Default > 0
+}
+
+// namespaceAnnotation returns the max run time annotation of the namespace, and false if
+// the limits were not resolved WithNamespaces or the annotation is absent or malformed.
+func (l *Limits) namespaceAnnotation(namespace string) (time.Duration, bool) {
+	if l.namespaceLister == nil {
+		return 0, false
+	}
+	ns, err := l.namespaceLister.Get(namespace)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to get namespace %s: %v", namespace, err)
+		}
+		return 0, false
+	}
+	return parseAnnotation(ns.Annotations, "namespace", "", namespace)
+}
+
+func namespaceOf(task *api.TaskInfo, job *api.JobInfo) string {
+	if task != nil && task.Namespace != "" {
+		return task.Namespace
+	}
+	if job != nil {
+		return job.Namespace
+	}
+	return ""
+}
+
+// Deadline returns the time at which the task overruns its maximum run time, and false
+// if the task is not running or may run forever.
+func (l *Limits) Deadline(task *api.TaskInfo, job *api.JobInfo) (time.Time, bool) {
+	if task == nil || task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return time.Time{}, false
+	}
+	limit, found := l.MaxRunTime(task, job)
+	if !found {
+		return time.Time{}, false
+	}
+	return task.Pod.Status.StartTime.Add(limit), true
+}
+
+// TimeLeft returns the run time left to the task at now, negative once the task overran
+// its maximum run time, and false if the task is not running or may run forever.
+func (l *Limits) TimeLeft(task *api.TaskInfo, job *api.JobInfo, now time.Time) (time.Duration, bool) {
+	deadline, found := l.Deadline(task, job)
+	if !found {
+		return 0, false
+	}
+	return deadline.Sub(now), true
+}
+
+// Exceeded returns whether the task overran its maximum run time at now.
+func (l *Limits) Exceeded(task *api.TaskInfo, job *api.JobInfo, now time.Time) bool {
+	left, found := l.TimeLeft(task, job, now)
+	return found && left <= 0
+}
+
+// parseAnnotation returns the max run time annotation, and false if it is absent or malformed.
+func parseAnnotation(annotations map[string]string, kind, namespace, name string) (time.Duration, bool) {
+	value, found := annotations[MaxRunTimeAnnotation]
+	if !found {
+		return 0, false
+	}
+	limit, err := time.ParseDuration(value)
+	if err != nil || limit < 0 {
+		klog.Errorf("Invalid %s annotation %q on %s <%s/%s>, ignoring it", MaxRunTimeAnnotation, value, kind, namespace, name)
+		return 0, false
+	}
+	return limit, true
+}
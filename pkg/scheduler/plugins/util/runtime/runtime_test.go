@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func buildTask(annotations map[string]string, startTime *time.Time) *api.TaskInfo {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1", Annotations: annotations}}
+	if startTime != nil {
+		start := metav1.NewTime(*startTime)
+		pod.Status.StartTime = &start
+	}
+	return &api.TaskInfo{Namespace: "ns1", Name: "p1", Pod: pod}
+}
+
+func buildJob(queue string, annotations map[string]string) *api.JobInfo {
+	return &api.JobInfo{
+		Namespace: "ns1",
+		Name:      "j1",
+		Queue:     api.QueueID(queue),
+		PodGroup: &api.PodGroup{PodGroup: scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		}},
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      interface{}
+		expected *Limits
+		wantErr  bool
+	}{
+		{
+			name: "nil input",
+		},
+		{
+			name:     "default and queues",
+			raw:      map[string]interface{}{"default": "24h", "queues": map[string]interface{}{"interactive": "2h"}},
+			expected: &Limits{Default: 24 * time.Hour, Queues: map[string]time.Duration{"interactive": 2 * time.Hour}},
+		},
+		{
+			name:    "malformed duration",
+			raw:     map[string]interface{}{"default": "one day"},
+			wantErr: true,
+		},
+		{
+			name:    "negative duration",
+			raw:     map[string]interface{}{"queues": map[string]interface{}{"q1": "-1h"}},
+			wantErr: true,
+		},
+		{
+			name:    "negative namespace duration",
+			raw:     map[string]interface{}{"namespaces": map[string]interface{}{"ns1": "-1h"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			raw:     map[string]interface{}{"defaults": "1h"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.raw)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+			if (got == nil) != (test.expected == nil) {
+				t.Fatalf("expected %v, got %v", test.expected, got)
+			}
+			if got != nil && (got.Default != test.expected.Default || len(got.Queues) != len(test.expected.Queues)) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestMaxRunTime(t *testing.T) {
+	limits := &Limits{Default: 24 * time.Hour, Queues: map[string]time.Duration{"interactive": 2 * time.Hour, "batch": 0}}
+	anno := func(v string) map[string]string {
+		return map[string]string{MaxRunTimeAnnotation: v}
+	}
+
+	tests := []struct {
+		name     string
+		limits   *Limits
+		task     *api.TaskInfo
+		job      *api.JobInfo
+		expected time.Duration
+		found    bool
+	}{
+		{name: "pod annotation first", limits: limits, task: buildTask(anno("10m"), nil), job: buildJob("interactive", anno("1h")), expected: 10 * time.Minute, found: true},
+		{name: "podgroup annotation", limits: limits, task: buildTask(nil, nil), job: buildJob("interactive", anno("1h")), expected: time.Hour, found: true},
+		{name: "malformed annotation is ignored", limits: limits, task: buildTask(anno("soon"), nil), job: buildJob("interactive", nil), expected: 2 * time.Hour, found: true},
+		{name: "queue limit", limits: limits, task: buildTask(nil, nil), job: buildJob("interactive", nil), expected: 2 * time.Hour, found: true},
+		{name: "unlimited queue", limits: limits, task: buildTask(nil, nil), job: buildJob("batch", nil), found: false},
+		{name: "default limit", limits: limits, task: buildTask(nil, nil), job: buildJob("other", nil), expected: 24 * time.Hour, found: true},
+		{name: "nil limits honor annotations", task: buildTask(anno("10m"), nil), job: buildJob("other", nil), expected: 10 * time.Minute, found: true},
+		{name: "nil limits without annotations", task: buildTask(nil, nil), job: buildJob("other", nil), found: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, found := test.limits.MaxRunTime(test.task, test.job)
+			if found != test.found || (found && got != test.expected) {
+				t.Errorf("expected %v %v, got %v %v", test.expected, test.found, got, found)
+			}
+		})
+	}
+}
+
+func TestNamespaceLimits(t *testing.T) {
+	anno := func(v string) map[string]string {
+		return map[string]string{MaxRunTimeAnnotation: v}
+	}
+	factory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	indexer := factory.Core().V1().Namespaces().Informer().GetIndexer()
+	for _, ns := range []*v1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Annotations: anno("3h")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "ns2"}},
+	} {
+		if err := indexer.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	limits := (&Limits{
+		Default:    24 * time.Hour,
+		Namespaces: map[string]time.Duration{"ns1": time.Hour, "ns2": 5 * time.Hour},
+		Queues:     map[string]time.Duration{"interactive": 2 * time.Hour},
+	}).WithNamespaces(factory)
+
+	tests := []struct {
+		name      string
+		limits    *Limits
+		namespace string
+		job       *api.JobInfo
+		expected  time.Duration
+		found     bool
+	}{
+		{name: "podgroup annotation before namespace annotation", limits: limits, namespace: "ns1", job: buildJob("interactive", anno("10m")), expected: 10 * time.Minute, found: true},
+		{name: "namespace annotation before namespace limit", limits: limits, namespace: "ns1", job: buildJob("interactive", nil), expected: 3 * time.Hour, found: true},
+		{name: "namespace limit before queue limit", limits: limits, namespace: "ns2", job: buildJob("interactive", nil), expected: 5 * time.Hour, found: true},
+		{name: "nil limits honor namespace annotations", limits: (*Limits)(nil).WithNamespaces(factory), namespace: "ns1", job: buildJob("other", nil), expected: 3 * time.Hour, found: true},
+		{name: "namespaces ignored unless resolved", limits: &Limits{}, namespace: "ns1", job: buildJob("other", nil), found: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := buildTask(nil, nil)
+			task.Namespace, test.job.Namespace = test.namespace, test.namespace
+			got, found := test.limits.MaxRunTime(task, test.job)
+			if found != test.found || (found && got != test.expected) {
+				t.Errorf("expected %v %v, got %v %v", test.expected, test.found, got, found)
+			}
+		})
+	}
+}
+
+func TestDeadlineAndTimeLeft(t *testing.T) {
+	limits := &Limits{Default: time.Hour}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := buildJob("q1", nil)
+
+	if _, found := limits.Deadline(buildTask(nil, nil), job); found {
+		t.Errorf("expected no deadline for a task not started")
+	}
+
+	task := buildTask(nil, &start)
+	deadline, found := limits.Deadline(task, job)
+	if !found || !deadline.Equal(start.Add(time.Hour)) {
+		t.Errorf("expected deadline %v, got %v %v", start.Add(time.Hour), deadline, found)
+	}
+
+	left, found := limits.TimeLeft(task, job, start.Add(20*time.Minute))
+	if !found || left != 40*time.Minute {
+		t.Errorf("expected 40m left, got %v %v", left, found)
+	}
+	if limits.Exceeded(task, job, start.Add(59*time.Minute)) {
+		t.Errorf("expected the task not to exceed its max run time")
+	}
+	if !limits.Exceeded(task, job, start.Add(time.Hour)) {
+		t.Errorf("expected the task to exceed its max run time")
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package synthetic builds large sets of jobs, tasks and their API objects for the
+// benchmarks of the scheduler plugins.
+package synthetic
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// Spec describes the jobs to build. Jobs are spread round-robin over Groups groups,
+// set in the GroupKey annotation of their PodGroup, and over Priorities priorities.
+type Spec struct {
+	Namespace   string
+	Queue       string
+	Jobs        int
+	TasksPerJob int
+	Groups      int
+	GroupKey    string
+	Priorities  int32
+	// TaskCPU and TaskMemory are the requests of every task.
+	TaskCPU    string
+	TaskMemory string
+	// Node is the node of the running tasks, tasks are pending when it is empty.
+	Node string
+}
+
+func (s Spec) jobName(i int) string {
+	return fmt.Sprintf("job-%d", i)
+}
+
+func (s Spec) group(i int) string {
+	if s.Groups <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("group-%d", i%s.Groups)
+}
+
+func (s Spec) priority(i int) int32 {
+	if s.Priorities <= 0 {
+		return 0
+	}
+	return int32(i) % s.Priorities
+}
+
+func (s Spec) annotations(i int) map[string]string {
+	if s.GroupKey == "" || s.Groups <= 0 {
+		return nil
+	}
+	return map[string]string{s.GroupKey: s.group(i)}
+}
+
+func (s Spec) phase() v1.PodPhase {
+	if s.Node == "" {
+		return v1.PodPending
+	}
+	return v1.PodRunning
+}
+
+// PodGroups builds the PodGroups of the jobs, e.g. for a session opened by uthelper.
+func PodGroups(s Spec) []*vcapisv1.PodGroup {
+	podGroups := make([]*vcapisv1.PodGroup, 0, s.Jobs)
+	for i := 0; i < s.Jobs; i++ {
+		phase := vcapisv1.PodGroupInqueue
+		if s.Node != "" {
+			phase = vcapisv1.PodGroupRunning
+		}
+		podGroups = append(podGroups, util.BuildPodGroupWithAnno(s.jobName(i), s.Namespace, s.Queue, int32(s.TasksPerJob), nil, phase, s.annotations(i)))
+	}
+	return podGroups
+}
+
+// Pods builds the pods of the jobs, e.g. for a session opened by uthelper.
+func Pods(s Spec) []*v1.Pod {
+	pods := make([]*v1.Pod, 0, s.Jobs*s.TasksPerJob)
+	for i := 0; i < s.Jobs; i++ {
+		for j := 0; j < s.TasksPerJob; j++ {
+			pod := util.BuildPod(s.Namespace, fmt.Sprintf("%s-%d", s.jobName(i), j), s.Node, s.phase(),
+				api.BuildResourceList(s.TaskCPU, s.TaskMemory), s.jobName(i), nil, nil)
+			priority := s.priority(i)
+			pod.Spec.Priority = &priority
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// Jobs builds the JobInfos of the jobs with their TaskInfos, without a session.
+func Jobs(s Spec) map[api.JobID]*api.JobInfo {
+	jobs := make(map[api.JobID]*api.JobInfo, s.Jobs)
+	for i, pg := range PodGroups(s) {
+		job := api.NewJobInfo(api.JobID(fmt.Sprintf("%s/%s", pg.Namespace, pg.Name)))
+		job.SetPodGroup(&api.PodGroup{PodGroup: scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pg.Namespace, Name: pg.Name, Annotations: pg.Annotations},
+			Spec:       scheduling.PodGroupSpec{Queue: pg.Spec.Queue, MinMember: pg.Spec.MinMember},
+		}})
+		job.Priority = s.priority(i)
+		jobs[job.UID] = job
+	}
+	for _, pod := range Pods(s) {
+		task := api.NewTaskInfo(pod)
+		jobs[task.Job].AddTaskInfo(task)
+	}
+	return jobs
+}
+
+// Tasks returns the tasks of all the jobs.
+func Tasks(jobs map[api.JobID]*api.JobInfo) []*api.TaskInfo {
+	var tasks []*api.TaskInfo
+	for _, job := range jobs {
+		for _, task := range job.Tasks {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
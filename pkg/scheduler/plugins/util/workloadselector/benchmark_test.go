@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadselector
+
+import (
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/synthetic"
+)
+
+func BenchmarkMatches(b *testing.B) {
+	selector, err := Parse(map[string]interface{}{
+		"priority": map[string]interface{}{
+			"expressions": []interface{}{
+				map[string]interface{}{"operator": "GreaterThan", "values": []interface{}{5}},
+			},
+		},
+		"queues":      []interface{}{"prod-*", "q*"},
+		"namespaces":  []interface{}{"ns1"},
+		"annotations": map[string]interface{}{"example.com/group": "group-1"},
+	})
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	jobs := synthetic.Jobs(synthetic.Spec{
+		Namespace:   "ns1",
+		Queue:       "q1",
+		Jobs:        1000,
+		TasksPerJob: 1,
+		Groups:      10,
+		GroupKey:    "example.com/group",
+		Priorities:  10,
+		TaskCPU:     "1",
+		TaskMemory:  "1Gi",
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, job := range jobs {
+			selector.Matches(job)
+		}
+	}
+}
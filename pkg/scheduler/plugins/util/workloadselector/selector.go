@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadselector
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/mitchellh/mapstructure"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
+)
+
+// WorkloadSelector selects jobs by priority, PodGroup labels and annotations, queue and
+// namespace. Every configured criterion must match; a selector without criteria matches nothing.
+//
+// It is meant to be decoded from plugin arguments with Parse, e.g.
+//
+//	exemptWorkloads:
+//	  priority:
+//	    expressions:
+//	    - operator: GreaterThan
+//	      values: [1000]
+//	  queues: ["prod-*"]
+//	  labelSelector:
+//	    matchLabels:
+//	      tier: critical
+type WorkloadSelector struct {
+	Priority *priority.PrioritySelector `json:"priority"`
+	// LabelSelector is matched against the labels of the PodGroup.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+	// Annotations must all be present on the PodGroup with the given values.
+	Annotations map[string]string `json:"annotations"`
+	// Queues are queue names or glob patterns, any of which must match.
+	Queues []string `json:"queues"`
+	// Namespaces are namespace names, any of which must match.
+	Namespaces []string `json:"namespaces"`
+
+	labelSelector labels.Selector
+}
+
+// Parse decodes a selector from a plugin argument, validates and compiles it.
+// A nil argument returns a nil selector.
+func Parse(raw interface{}) (*WorkloadSelector, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	selector := &WorkloadSelector{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode workload selector: %v", err)
+	}
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// Validate returns an error describing every malformed criterion, and compiles the label selector.
+func (s *WorkloadSelector) Validate() error {
+	if s == nil || s.isEmpty() {
+		return fmt.Errorf("workload selector has no criteria")
+	}
+
+	var errs []error
+	if s.Priority != nil {
+		if err := s.Priority.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("priority: %v", err))
+		}
+	}
+	if s.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("labelSelector: %v", err))
+		} else {
+			s.labelSelector = selector
+		}
+	}
+	for i, pattern := range s.Queues {
+		if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("queues[%d]: invalid pattern %q: %v", i, pattern, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Matches returns whether the job satisfies every configured criterion.
+func (s *WorkloadSelector) Matches(job *api.JobInfo) bool {
+	if s == nil || s.isEmpty() || job == nil {
+		return false
+	}
+
+	var pgLabels, pgAnnotations map[string]string
+	if job.PodGroup != nil {
+		pgLabels = job.PodGroup.Labels
+		pgAnnotations = job.PodGroup.Annotations
+	}
+
+	if s.Priority != nil && !s.Priority.MatchesWorkload(priority.Workload{
+		Priority:  job.Priority,
+		Queue:     string(job.Queue),
+		Namespace: job.Namespace,
+		Labels:    pgLabels,
+	}) {
+		return false
+	}
+	if len(s.Namespaces) > 0 && !contains(s.Namespaces, job.Namespace) {
+		return false
+	}
+	if len(s.Queues) > 0 && !matchesAnyPattern(s.Queues, string(job.Queue)) {
+		return false
+	}
+	for key, value := range s.Annotations {
+		if v, found := pgAnnotations[key]; !found || v != value {
+			return false
+		}
+	}
+	if s.LabelSelector != nil {
+		if s.labelSelector == nil {
+			selector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+			if err != nil {
+				return false
+			}
+			s.labelSelector = selector
+		}
+		if !s.labelSelector.Matches(labels.Set(pgLabels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve returns a copy of the selector whose priority selector has its PriorityClass
+// expressions resolved against the given classes, see PrioritySelector.Resolve.
+func (s *WorkloadSelector) Resolve(classes map[string]int32) *WorkloadSelector {
+	if s == nil {
+		return nil
+	}
+	resolved := *s
+	resolved.Priority = s.Priority.Resolve(classes)
+	return &resolved
+}
+
+func (s *WorkloadSelector) isEmpty() bool {
+	return s.Priority == nil && s.LabelSelector == nil && len(s.Annotations) == 0 &&
+		len(s.Queues) == 0 && len(s.Namespaces) == 0
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
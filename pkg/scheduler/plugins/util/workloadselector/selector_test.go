@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadselector
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func buildJob(namespace, queue string, priority int32, labels, annotations map[string]string) *api.JobInfo {
+	job := api.NewJobInfo(api.JobID(namespace + "/job"))
+	job.Namespace = namespace
+	job.Queue = api.QueueID(queue)
+	job.Priority = priority
+	job.PodGroup = &api.PodGroup{
+		PodGroup: scheduling.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        "job",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		},
+	}
+	return job
+}
+
+func TestWorkloadSelectorMatches(t *testing.T) {
+	raw := map[interface{}]interface{}{
+		"priority": map[interface{}]interface{}{
+			"expressions": []interface{}{
+				map[interface{}]interface{}{"operator": "GreaterThan", "values": []interface{}{100}},
+			},
+		},
+		"queues":     []interface{}{"prod-*"},
+		"namespaces": []interface{}{"ns1", "ns2"},
+		"labelSelector": map[interface{}]interface{}{
+			"matchLabels": map[interface{}]interface{}{"tier": "critical"},
+		},
+		"annotations": map[interface{}]interface{}{"example.com/owner": "sre"},
+	}
+	selector, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	labels := map[string]string{"tier": "critical"}
+	annotations := map[string]string{"example.com/owner": "sre"}
+	tests := []struct {
+		name     string
+		job      *api.JobInfo
+		expected bool
+	}{
+		{name: "all criteria match", job: buildJob("ns1", "prod-a", 200, labels, annotations), expected: true},
+		{name: "priority too low", job: buildJob("ns1", "prod-a", 100, labels, annotations), expected: false},
+		{name: "queue does not match glob", job: buildJob("ns1", "dev-a", 200, labels, annotations), expected: false},
+		{name: "other namespace", job: buildJob("ns3", "prod-a", 200, labels, annotations), expected: false},
+		{name: "label missing", job: buildJob("ns2", "prod-a", 200, nil, annotations), expected: false},
+		{name: "annotation differs", job: buildJob("ns2", "prod-a", 200, labels, map[string]string{"example.com/owner": "dev"}), expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := selector.Matches(test.job); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+
+	var nilSelector *WorkloadSelector
+	if nilSelector.Matches(buildJob("ns1", "prod-a", 200, labels, annotations)) {
+		t.Errorf("expected nil selector to match nothing")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         interface{}
+		expectedErr string
+	}{
+		{
+			name:        "no criteria",
+			raw:         map[string]interface{}{},
+			expectedErr: "no criteria",
+		},
+		{
+			name: "invalid priority",
+			raw: map[string]interface{}{
+				"priority": map[string]interface{}{
+					"expressions": []interface{}{
+						map[string]interface{}{"operator": "Equals", "values": []interface{}{1}},
+					},
+				},
+			},
+			expectedErr: `priority: expressions[0]: unknown operator "Equals"`,
+		},
+		{
+			name:        "invalid queue pattern",
+			raw:         map[string]interface{}{"queues": []interface{}{"prod-["}},
+			expectedErr: "queues[0]: invalid pattern",
+		},
+		{
+			name: "invalid label selector",
+			raw: map[string]interface{}{
+				"labelSelector": map[string]interface{}{
+					"matchExpressions": []interface{}{
+						map[string]interface{}{"key": "tier", "operator": "Equals"},
+					},
+				},
+			},
+			expectedErr: "labelSelector:",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.raw)
+			if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+				t.Fatalf("expected error containing %q, got %v", test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestWorkloadSelectorResolve(t *testing.T) {
+	selector, err := Parse(map[string]interface{}{
+		"namespaces": []interface{}{"ns1"},
+		"priority": map[string]interface{}{
+			"expressions": []interface{}{
+				map[string]interface{}{"operator": "InClasses", "classes": []interface{}{"prod"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resolved := selector.Resolve(map[string]int32{"prod": 1000})
+	if !resolved.Matches(buildJob("ns1", "q1", 1000, nil, nil)) {
+		t.Errorf("expected job with the priority of class prod to match")
+	}
+	if resolved.Matches(buildJob("ns2", "q1", 1000, nil, nil)) {
+		t.Errorf("expected job of another namespace not to match")
+	}
+	if selector.Matches(buildJob("ns1", "q1", 1000, nil, nil)) {
+		t.Errorf("expected the unresolved selector to be left untouched")
+	}
+}
+
+func TestWorkloadSelectorCEL(t *testing.T) {
+	selector, err := Parse(map[string]interface{}{
+		"priority": map[string]interface{}{
+			"celExpression": "job.queue.startsWith('prod-') && job.labels['tier'] == 'critical'",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !selector.Matches(buildJob("ns1", "prod-a", 0, map[string]string{"tier": "critical"}, nil)) {
+		t.Errorf("expected job in prod queue with critical tier to match")
+	}
+	if selector.Matches(buildJob("ns1", "dev-a", 0, map[string]string{"tier": "critical"}, nil)) {
+		t.Errorf("expected job in dev queue not to match")
+	}
+}
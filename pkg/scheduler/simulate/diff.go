@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Diff is the decisions which differ between two simulations of the same snapshot, e.g.
+// with the current scheduler configuration and with a modified one.
+type Diff struct {
+	Sessions []SessionDiff `json:"sessions"`
+}
+
+// SessionDiff is the decisions which differ in one session. Added are the decisions only
+// taken by the new simulation, Removed the ones only taken by the old simulation.
+type SessionDiff struct {
+	Session int `json:"session"`
+	// Reordered is the jobs whose position in the job order changed.
+	Reordered []Move `json:"reordered,omitempty"`
+	// Enqueued is the change of the jobs moved from Pending to Inqueue.
+	Enqueued Change `json:"enqueued"`
+	// Binds is the tasks placed on another node, or placed by one simulation only, in
+	// which case the node of the other simulation is empty.
+	Binds map[string]BindChange `json:"binds,omitempty"`
+	// Victims is the change of the evicted tasks.
+	Victims Change `json:"victims"`
+}
+
+// Move is the position of a job in the job order of both simulations, -1 if absent.
+type Move struct {
+	Job string `json:"job"`
+	Old int    `json:"old"`
+	New int    `json:"new"`
+}
+
+// Change is the items only found in the new and only found in the old simulation.
+type Change struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// BindChange is the node of a task in both simulations.
+type BindChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// Empty returns whether both simulations took the same decisions in the session.
+func (d *SessionDiff) Empty() bool {
+	return len(d.Reordered) == 0 && d.Enqueued.empty() && len(d.Binds) == 0 && d.Victims.empty()
+}
+
+func (c Change) empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0
+}
+
+// DiffResults compares the sessions of two simulations of the same snapshot. Sessions run
+// by one simulation only are compared to an empty session.
+func DiffResults(old, new *Result) *Diff {
+	sessions := len(old.Sessions)
+	if len(new.Sessions) > sessions {
+		sessions = len(new.Sessions)
+	}
+	diff := &Diff{}
+	for i := 0; i < sessions; i++ {
+		var o, n SessionResult
+		if i < len(old.Sessions) {
+			o = old.Sessions[i]
+		}
+		if i < len(new.Sessions) {
+			n = new.Sessions[i]
+		}
+		d := diffSessions(o, n)
+		d.Session = i
+		diff.Sessions = append(diff.Sessions, d)
+	}
+	return diff
+}
+
+func diffSessions(old, new SessionResult) SessionDiff {
+	d := SessionDiff{
+		Reordered: diffOrder(old.JobOrder, new.JobOrder),
+		Enqueued:  diffSets(old.Enqueued, new.Enqueued),
+		Binds:     map[string]BindChange{},
+	}
+	for task, node := range old.Binds {
+		if new.Binds[task] != node {
+			d.Binds[task] = BindChange{Old: node, New: new.Binds[task]}
+		}
+	}
+	for task, node := range new.Binds {
+		if _, found := old.Binds[task]; !found {
+			d.Binds[task] = BindChange{New: node}
+		}
+	}
+	if len(d.Binds) == 0 {
+		d.Binds = nil
+	}
+	d.Victims = diffSets(victimTasks(old.Victims), victimTasks(new.Victims))
+	return d
+}
+
+func diffOrder(old, new []JobStanding) []Move {
+	positions := map[string]*Move{}
+	moveOf := func(job string) *Move {
+		if m, found := positions[job]; found {
+			return m
+		}
+		m := &Move{Job: job, Old: -1, New: -1}
+		positions[job] = m
+		return m
+	}
+	for i, standing := range old {
+		moveOf(standing.Job).Old = i
+	}
+	for i, standing := range new {
+		moveOf(standing.Job).New = i
+	}
+
+	var moves []Move
+	for _, m := range positions {
+		if m.Old != m.New {
+			moves = append(moves, *m)
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].Job < moves[j].Job
+	})
+	return moves
+}
+
+func diffSets(old, new []string) Change {
+	o, n := sets.New(old...), sets.New(new...)
+	var c Change
+	if added := n.Difference(o); added.Len() > 0 {
+		c.Added = sets.List(added)
+	}
+	if removed := o.Difference(n); removed.Len() > 0 {
+		c.Removed = sets.List(removed)
+	}
+	return c
+}
+
+func victimTasks(victims []Victim) []string {
+	tasks := make([]string, 0, len(victims))
+	for _, victim := range victims {
+		tasks = append(tasks, victim.Task)
+	}
+	return tasks
+}
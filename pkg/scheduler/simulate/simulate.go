@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulate runs scheduling sessions against a snapshot of a cluster, so that a
+// scheduler configuration can be evaluated without touching the cluster. The actions and
+// plugins used by the configuration must be registered by the caller, e.g. by importing
+// the pkg/scheduler/actions and pkg/scheduler/plugins packages.
+package simulate
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const simulatorName = "volcano-simulator"
+
+func init() {
+	metrics.InitKubeSchedulerRelatedMetrics()
+}
+
+// Options configures a simulation.
+type Options struct {
+	// SchedulerConf is the scheduler configuration in the format of the scheduler ConfigMap.
+	SchedulerConf string
+	// Sessions is the number of sessions to run, each one starting from the state left by
+	// the previous one. Defaults to 1.
+	Sessions int
+}
+
+// Result is the outcome of every simulated session.
+type Result struct {
+	Sessions []SessionResult `json:"sessions"`
+}
+
+// SessionResult is the outcome of one simulated session.
+type SessionResult struct {
+	// JobOrder is the jobs of the session sorted by the job order of the configuration.
+	JobOrder []JobStanding `json:"jobOrder"`
+	// Enqueued is the jobs moved from Pending to Inqueue in the session.
+	Enqueued []string `json:"enqueued"`
+	// Pending is the jobs left in Pending at the end of the session.
+	Pending []string `json:"pending"`
+	// Binds is the tasks placed in the session, keyed by task and valued by node.
+	Binds map[string]string `json:"binds"`
+	// Victims is the running tasks evicted in the session.
+	Victims []Victim `json:"victims"`
+}
+
+// JobStanding is the position of a job in the job order.
+type JobStanding struct {
+	Job      string `json:"job"`
+	Queue    string `json:"queue"`
+	Priority int32  `json:"priority"`
+	Phase    string `json:"phase"`
+}
+
+// Victim is a task evicted in a session.
+type Victim struct {
+	Task string `json:"task"`
+	Job  string `json:"job"`
+	Node string `json:"node"`
+}
+
+// Run simulates the sessions of the configuration against the snapshot. The snapshot
+// objects are not modified.
+func Run(snapshot *Snapshot, opts Options) (*Result, error) {
+	actions, tiers, configurations, _, err := scheduler.UnmarshalSchedulerConf(opts.SchedulerConf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduler configuration: %v", err)
+	}
+	sessions := opts.Sessions
+	if sessions <= 0 {
+		sessions = 1
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	schedulerCache := newSimulatorCache(snapshot, stop)
+
+	conf.EnabledActionMap = make(map[string]bool)
+	for _, action := range actions {
+		conf.EnabledActionMap[action.Name()] = true
+	}
+
+	result := &Result{}
+	for i := 0; i < sessions; i++ {
+		ssn := framework.OpenSession(schedulerCache, tiers, configurations)
+		before := snapshotSession(ssn)
+		for _, action := range actions {
+			action.Execute(ssn)
+		}
+		result.Sessions = append(result.Sessions, before.diff(ssn))
+		framework.CloseSession(ssn)
+	}
+	return result, nil
+}
+
+// newSimulatorCache builds a cache over fake clients, with a binder and an evictor that
+// accept every decision of the sessions without calling the API server.
+func newSimulatorCache(snapshot *Snapshot, stop chan struct{}) *cache.SchedulerCache {
+	schedulerCache := cache.NewCustomMockSchedulerCache(simulatorName, &nopBinder{}, &nopEvictor{},
+		&util.FakeStatusUpdater{}, nil, &record.FakeRecorder{})
+	schedulerCache.Run(stop)
+
+	for _, node := range snapshot.Nodes {
+		schedulerCache.AddOrUpdateNode(node.DeepCopy())
+	}
+	for _, pod := range snapshot.Pods {
+		schedulerCache.AddPod(pod.DeepCopy())
+	}
+	for _, pg := range snapshot.PodGroups {
+		schedulerCache.AddPodGroupV1beta1(pg.DeepCopy())
+	}
+	for _, queue := range snapshot.Queues {
+		schedulerCache.AddQueueV1beta1(queue.DeepCopy())
+	}
+	for _, pc := range snapshot.PriorityClasses {
+		schedulerCache.AddPriorityClass(pc.DeepCopy())
+	}
+	return schedulerCache
+}
+
+// sessionState is the state of the jobs at the start of a session, before any action ran.
+type sessionState struct {
+	order  []JobStanding
+	phases map[api.JobID]scheduling.PodGroupPhase
+	tasks  map[api.TaskID]api.TaskStatus
+}
+
+func snapshotSession(ssn *framework.Session) *sessionState {
+	state := &sessionState{
+		phases: make(map[api.JobID]scheduling.PodGroupPhase, len(ssn.Jobs)),
+		tasks:  make(map[api.TaskID]api.TaskStatus),
+	}
+
+	jobs := make([]*api.JobInfo, 0, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		jobs = append(jobs, job)
+		if job.PodGroup != nil {
+			state.phases[job.UID] = job.PodGroup.Status.Phase
+		}
+		for _, task := range job.Tasks {
+			state.tasks[task.UID] = task.Status
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		// Sort by UID first so that jobs the plugins consider equal keep a stable order.
+		return jobs[i].UID < jobs[j].UID
+	})
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return ssn.JobOrderFn(jobs[i], jobs[j])
+	})
+	for _, job := range jobs {
+		standing := JobStanding{
+			Job:      string(job.UID),
+			Queue:    string(job.Queue),
+			Priority: job.Priority,
+		}
+		if job.PodGroup != nil {
+			standing.Phase = string(job.PodGroup.Status.Phase)
+		}
+		state.order = append(state.order, standing)
+	}
+	return state
+}
+
+// diff reports the decisions the actions took in the session since the state was taken.
+func (state *sessionState) diff(ssn *framework.Session) SessionResult {
+	result := SessionResult{
+		JobOrder: state.order,
+		Binds:    map[string]string{},
+	}
+	for _, job := range ssn.Jobs {
+		if job.PodGroup != nil && state.phases[job.UID] == scheduling.PodGroupPending {
+			if job.PodGroup.Status.Phase == scheduling.PodGroupPending {
+				result.Pending = append(result.Pending, string(job.UID))
+			} else {
+				result.Enqueued = append(result.Enqueued, string(job.UID))
+			}
+		}
+		for _, task := range job.Tasks {
+			previous := state.tasks[task.UID]
+			if previous == task.Status {
+				continue
+			}
+			key := task.Namespace + "/" + task.Name
+			switch task.Status {
+			case api.Allocated, api.Binding, api.Bound, api.Pipelined:
+				result.Binds[key] = task.NodeName
+			case api.Releasing:
+				if api.AllocatedStatus(previous) {
+					result.Victims = append(result.Victims, Victim{Task: key, Job: string(job.UID), Node: task.NodeName})
+				}
+			}
+		}
+	}
+	sort.Strings(result.Enqueued)
+	sort.Strings(result.Pending)
+	sort.Slice(result.Victims, func(i, j int) bool {
+		return result.Victims[i].Task < result.Victims[j].Task
+	})
+	return result
+}
+
+// nopBinder accepts every bind without calling the API server.
+type nopBinder struct{}
+
+func (b *nopBinder) Bind(kubeClient kubernetes.Interface, tasks []*api.TaskInfo) map[api.TaskID]string {
+	return nil
+}
+
+// nopEvictor accepts every eviction without calling the API server.
+type nopEvictor struct{}
+
+func (e *nopEvictor) Evict(pod *v1.Pod, reason string) error {
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const testSchedulerConf = `
+actions: "enqueue, allocate, preempt"
+tiers:
+- plugins:
+  - name: priority
+  - name: gang
+- plugins:
+  - name: proportion
+`
+
+func TestRun(t *testing.T) {
+	snapshot := &Snapshot{
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), nil),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "preemptee1", "n1", v1.PodRunning, api.BuildResourceList("3", "3G"), "pg1", map[string]string{schedulingv1beta1.PodPreemptable: "true"}, nil),
+			util.BuildPod("c1", "preemptor1", "", v1.PodPending, api.BuildResourceList("3", "3G"), "pg2", nil, nil),
+		},
+		PodGroups: []*schedulingv1beta1.PodGroup{
+			util.BuildPodGroupWithPrio("pg1", "c1", "q1", 0, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+			util.BuildPodGroupWithPrio("pg2", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupPending, "high-priority"),
+		},
+		Queues: []*schedulingv1beta1.Queue{
+			util.BuildQueue("q1", 1, nil),
+		},
+		PriorityClasses: []*schedulingv1.PriorityClass{
+			util.BuildPriorityClass("high-priority", 100000),
+			util.BuildPriorityClass("low-priority", 10),
+		},
+	}
+
+	result, err := Run(snapshot, Options{SchedulerConf: testSchedulerConf})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Sessions) != 1 {
+		t.Fatalf("expected 1 session, got %d", len(result.Sessions))
+	}
+	session := result.Sessions[0]
+
+	var order []string
+	for _, standing := range session.JobOrder {
+		order = append(order, standing.Job)
+	}
+	if expected := []string{"c1/pg2", "c1/pg1"}; !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected job order %v, got %v", expected, order)
+	}
+	if expected := []string{"c1/pg2"}; !reflect.DeepEqual(session.Enqueued, expected) {
+		t.Errorf("expected enqueued jobs %v, got %v", expected, session.Enqueued)
+	}
+	if expected := []Victim{{Task: "c1/preemptee1", Job: "c1/pg1", Node: "n1"}}; !reflect.DeepEqual(session.Victims, expected) {
+		t.Errorf("expected victims %v, got %v", expected, session.Victims)
+	}
+	if snapshot.PodGroups[1].Status.Phase != schedulingv1beta1.PodGroupPending {
+		t.Errorf("expected the snapshot to be left untouched, got phase %s", snapshot.PodGroups[1].Status.Phase)
+	}
+}
+
+func TestDecodeSnapshot(t *testing.T) {
+	doc := `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Node
+  metadata:
+    name: n1
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: ignored
+---
+apiVersion: scheduling.volcano.sh/v1beta1
+kind: Queue
+metadata:
+  name: q1
+spec:
+  weight: 1
+---
+apiVersion: scheduling.volcano.sh/v1beta1
+kind: PodGroup
+metadata:
+  name: pg1
+  namespace: c1
+spec:
+  queue: q1
+  minMember: 1
+`
+	snapshot, err := DecodeSnapshot(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(snapshot.Nodes) != 1 || snapshot.Nodes[0].Name != "n1" {
+		t.Errorf("expected node n1, got %v", snapshot.Nodes)
+	}
+	if len(snapshot.Queues) != 1 || snapshot.Queues[0].Spec.Weight != 1 {
+		t.Errorf("expected queue q1 of weight 1, got %v", snapshot.Queues)
+	}
+	if len(snapshot.PodGroups) != 1 || snapshot.PodGroups[0].Spec.Queue != "q1" {
+		t.Errorf("expected podgroup pg1 in queue q1, got %v", snapshot.PodGroups)
+	}
+}
+
+func TestDiffResults(t *testing.T) {
+	old := &Result{Sessions: []SessionResult{{
+		JobOrder: []JobStanding{{Job: "c1/pg1"}, {Job: "c1/pg2"}},
+		Enqueued: []string{"c1/pg1"},
+		Binds:    map[string]string{"c1/task1": "n1", "c1/task2": "n1"},
+		Victims:  []Victim{{Task: "c1/victim1"}},
+	}}}
+	new := &Result{Sessions: []SessionResult{
+		{
+			JobOrder: []JobStanding{{Job: "c1/pg2"}, {Job: "c1/pg1"}},
+			Enqueued: []string{"c1/pg1", "c1/pg2"},
+			Binds:    map[string]string{"c1/task1": "n1", "c1/task2": "n2", "c1/task3": "n1"},
+		},
+		{},
+	}}
+
+	diff := DiffResults(old, new)
+	if len(diff.Sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(diff.Sessions))
+	}
+	expected := SessionDiff{
+		Reordered: []Move{{Job: "c1/pg1", Old: 0, New: 1}, {Job: "c1/pg2", Old: 1, New: 0}},
+		Enqueued:  Change{Added: []string{"c1/pg2"}},
+		Binds: map[string]BindChange{
+			"c1/task2": {Old: "n1", New: "n2"},
+			"c1/task3": {New: "n1"},
+		},
+		Victims: Change{Removed: []string{"c1/victim1"}},
+	}
+	if got := diff.Sessions[0]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+	if !diff.Sessions[1].Empty() {
+		t.Errorf("expected no change in the second session, got %+v", diff.Sessions[1])
+	}
+}
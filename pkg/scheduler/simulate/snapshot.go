@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+)
+
+// Snapshot is the cluster state a simulation starts from.
+type Snapshot struct {
+	Nodes           []*v1.Node
+	Pods            []*v1.Pod
+	PodGroups       []*vcapisv1.PodGroup
+	Queues          []*vcapisv1.Queue
+	PriorityClasses []*schedulingv1.PriorityClass
+}
+
+// LoadSnapshot reads a snapshot from a multi-document YAML file, as produced by
+// `kubectl get nodes,pods,podgroups,queues,priorityclasses -A -o yaml`.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeSnapshot(f)
+}
+
+// DecodeSnapshot reads a snapshot from multi-document YAML. List documents are
+// flattened, and objects of kinds the scheduler does not use are skipped.
+func DecodeSnapshot(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return snapshot, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := snapshot.add(doc); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *Snapshot) add(doc []byte) error {
+	meta := &metav1.TypeMeta{}
+	if err := yaml.Unmarshal(doc, meta); err != nil {
+		return fmt.Errorf("failed to decode object kind: %v", err)
+	}
+
+	var err error
+	switch meta.Kind {
+	case "":
+		// Empty documents, e.g. a trailing separator.
+	case "List":
+		list := &v1.List{}
+		if err = yaml.Unmarshal(doc, list); err != nil {
+			break
+		}
+		for _, item := range list.Items {
+			if err = s.add(item.Raw); err != nil {
+				return err
+			}
+		}
+	case "Node":
+		node := &v1.Node{}
+		if err = yaml.Unmarshal(doc, node); err == nil {
+			s.Nodes = append(s.Nodes, node)
+		}
+	case "Pod":
+		pod := &v1.Pod{}
+		if err = yaml.Unmarshal(doc, pod); err == nil {
+			s.Pods = append(s.Pods, pod)
+		}
+	case "PodGroup":
+		pg := &vcapisv1.PodGroup{}
+		if err = yaml.Unmarshal(doc, pg); err == nil {
+			s.PodGroups = append(s.PodGroups, pg)
+		}
+	case "Queue":
+		queue := &vcapisv1.Queue{}
+		if err = yaml.Unmarshal(doc, queue); err == nil {
+			s.Queues = append(s.Queues, queue)
+		}
+	case "PriorityClass":
+		pc := &schedulingv1.PriorityClass{}
+		if err = yaml.Unmarshal(doc, pc); err == nil {
+			s.PriorityClasses = append(s.PriorityClasses, pc)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %v", meta.Kind, err)
+	}
+	return nil
+}
+
+// SnapshotFromCluster lists the objects of a snapshot from a live cluster. The cluster
+// is only read, the simulation never writes to it.
+func SnapshotFromCluster(ctx context.Context, kubeClient kubernetes.Interface, vcClient vcclientset.Interface) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %v", err)
+	}
+	for i := range nodes.Items {
+		snapshot.Nodes = append(snapshot.Nodes, &nodes.Items[i])
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %v", err)
+	}
+	for i := range pods.Items {
+		snapshot.Pods = append(snapshot.Pods, &pods.Items[i])
+	}
+
+	pcs, err := kubeClient.SchedulingV1().PriorityClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list priority classes: %v", err)
+	}
+	for i := range pcs.Items {
+		snapshot.PriorityClasses = append(snapshot.PriorityClasses, &pcs.Items[i])
+	}
+
+	pgs, err := vcClient.SchedulingV1beta1().PodGroups(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list podgroups: %v", err)
+	}
+	for i := range pgs.Items {
+		snapshot.PodGroups = append(snapshot.PodGroups, &pgs.Items[i])
+	}
+
+	queues, err := vcClient.SchedulingV1beta1().Queues().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %v", err)
+	}
+	for i := range queues.Items {
+		snapshot.Queues = append(snapshot.Queues, &queues.Items[i])
+	}
+
+	return snapshot, nil
+}
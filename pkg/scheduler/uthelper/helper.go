@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -75,6 +76,7 @@ type TestCommonStruct struct {
 	Queues                    []*vcapisv1.Queue
 	PriClass                  []*schedulingv1.PriorityClass
 	ResourceQuotas            []*v1.ResourceQuota
+	Namespaces                []*v1.Namespace
 	// IgnoreProvisioners is the provisioners that need to be ignored
 	IgnoreProvisioners sets.Set[string]
 	PVs                []*v1.PersistentVolume
@@ -113,6 +115,10 @@ type TestCommonStruct struct {
 	ShardName    string
 	NodesInShard []string
 
+	// Recorder is the event recorder of the cache, a FakeRecorder buffering 100 events by default.
+	// Benchmarks with many jobs should set an unbuffered &record.FakeRecorder{} to not block.
+	Recorder record.EventRecorder
+
 	// fake interface instance when check results need
 	stop       chan struct{}
 	binder     cache.Binder
@@ -140,10 +146,13 @@ func (test *TestCommonStruct) createSchedulerCache() *cache.SchedulerCache {
 	test.evictor = evictor
 	test.stop = make(chan struct{})
 	// Create scheduler cache with self-defined binder and evictor
-	schedulerCache := cache.NewCustomMockSchedulerCache("utmock-scheduler", binder, evictor, test.stsUpdator, nil, nil)
+	schedulerCache := cache.NewCustomMockSchedulerCache("utmock-scheduler", binder, evictor, test.stsUpdator, nil, test.Recorder)
 
 	// Initial provisioning resources
 	kubeClient := schedulerCache.Client()
+	for _, ns := range test.Namespaces {
+		kubeClient.CoreV1().Namespaces().Create(context.Background(), ns, metav1.CreateOptions{})
+	}
 	for _, sc := range test.SCs {
 		kubeClient.StorageV1().StorageClasses().Create(context.Background(), sc, metav1.CreateOptions{})
 	}
@@ -32,6 +32,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/priority"
 	"volcano.sh/volcano/pkg/util"
 )
 
@@ -58,6 +59,10 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
 		return nil, nil, nil, nil, err
 	}
+	catalog, err := priority.NewSelectorCatalog(schedulerConf.PrioritySelectors)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("invalid prioritySelectors: %v", err)
+	}
 	// Set default settings for each plugin if not set
 	for i, tier := range schedulerConf.Tiers {
 		// drf with hierarchy enabled
@@ -74,6 +79,12 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 				proportion = true
 			}
 			plugins.ApplyPluginConfDefaults(&schedulerConf.Tiers[i].Plugins[j])
+			if err := catalog.ResolveRefs(tier.Plugins[j].Arguments); err != nil {
+				return nil, nil, nil, nil, fmt.Errorf("invalid arguments of plugin %s: %v", tier.Plugins[j].Name, err)
+			}
+			if err := framework.ValidatePluginArguments(tier.Plugins[j].Name, tier.Plugins[j].Arguments); err != nil {
+				return nil, nil, nil, nil, fmt.Errorf("invalid arguments of plugin %s: %v", tier.Plugins[j].Name, err)
+			}
 		}
 		if hdrf && proportion {
 			return nil, nil, nil, nil, fmt.Errorf("proportion and drf with hierarchy enabled conflicts")
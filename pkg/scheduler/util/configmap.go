@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+)
+
+// defaultSchedulerNamespace is the namespace the scheduler is deployed in by default.
+const defaultSchedulerNamespace = "volcano-system"
+
+// SchedulerNamespace returns the namespace of the scheduler, i.e. the namespace of its leader
+// election lock, or volcano-system if it is not set.
+func SchedulerNamespace() string {
+	if options.ServerOpts != nil && options.ServerOpts.LeaderElection.ResourceNamespace != "" {
+		return options.ServerOpts.LeaderElection.ResourceNamespace
+	}
+	return defaultSchedulerNamespace
+}
+
+// UpsertConfigMapData sets one data key of the ConfigMap, creating the ConfigMap if needed.
+func UpsertConfigMapData(client kubernetes.Interface, namespace, name, key, value string) error {
+	cms := client.CoreV1().ConfigMaps(namespace)
+	cm, err := cms.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Data: map[string]string{key: value},
+		}
+		_, err = cms.Create(context.TODO(), cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = value
+	_, err = cms.Update(context.TODO(), cm, metav1.UpdateOptions{})
+	return err
+}
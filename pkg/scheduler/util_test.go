@@ -21,6 +21,7 @@ limitations under the License.
 package scheduler
 
 import (
+	"fmt"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -253,3 +254,59 @@ tiers:
 			expectedConfigurations, configurations)
 	}
 }
+
+func TestUnmarshalSchedulerConfValidatesArguments(t *testing.T) {
+	configuration := `
+actions: "enqueue, allocate"
+tiers:
+- plugins:
+  - name: groupquota
+    arguments:
+      resourceMap:
+        cpu: %s
+`
+	if _, _, _, _, err := UnmarshalSchedulerConf(fmt.Sprintf(configuration, `"8"`)); err != nil {
+		t.Errorf("Failed to load Scheduler configuration: %v", err)
+	}
+	if _, _, _, _, err := UnmarshalSchedulerConf(fmt.Sprintf(configuration, "lots")); err == nil {
+		t.Errorf("Expected Scheduler configuration with invalid plugin arguments to be rejected")
+	}
+}
+
+func TestUnmarshalSchedulerConfResolvesPrioritySelectors(t *testing.T) {
+	configuration := `
+actions: "enqueue, allocate"
+prioritySelectors:
+  prodBand:
+    expressions:
+    - operator: GreaterThan
+      values: [%s]
+tiers:
+- plugins:
+  - name: groupquota
+    arguments:
+      resourceMap:
+        cpu: "8"
+      exemptPriorities:
+        selectorRef: %s
+`
+	_, tiers, _, _, err := UnmarshalSchedulerConf(fmt.Sprintf(configuration, "1000", "prodBand"))
+	if err != nil {
+		t.Fatalf("Failed to load Scheduler configuration: %v", err)
+	}
+	expected := map[interface{}]interface{}{
+		"expressions": []interface{}{
+			map[interface{}]interface{}{"operator": "GreaterThan", "values": []interface{}{1000}},
+		},
+	}
+	if got := tiers[0].Plugins[0].Arguments["exemptPriorities"]; !equality.Semantic.DeepEqual(got, expected) {
+		t.Errorf("Expected the reference to be replaced by %v, got %v", expected, got)
+	}
+
+	if _, _, _, _, err := UnmarshalSchedulerConf(fmt.Sprintf(configuration, "1000", "batchBand")); err == nil {
+		t.Errorf("Expected Scheduler configuration referencing an undefined priority selector to be rejected")
+	}
+	if _, _, _, _, err := UnmarshalSchedulerConf(fmt.Sprintf(configuration, "", "prodBand")); err == nil {
+		t.Errorf("Expected Scheduler configuration with an invalid priority selector to be rejected")
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package groupquota holds an optional pod admission webhook which keeps the pods of a
+// group over its hard quota, i.e. a GroupQuota with borrowingPolicy Never, from reaching
+// the scheduler. It is not enabled by default: add /pods/groupquota to --enabled-admission.
+package groupquota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	whv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	schedulinglisters "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/plugins/groupquota/accounting"
+	"volcano.sh/volcano/pkg/webhooks/router"
+	"volcano.sh/volcano/pkg/webhooks/schema"
+	"volcano.sh/volcano/pkg/webhooks/util"
+)
+
+const (
+	// ActionReject rejects the pods of a group over its hard quota.
+	ActionReject = "Reject"
+	// ActionGate admits the pods of a group over its hard quota with a scheduling gate,
+	// removed by the groupquota controller once the group is back under its limits.
+	ActionGate = "Gate"
+
+	defaultAnnotationKey = "example.com/group"
+	syncTimeout          = 10 * time.Second
+)
+
+// patchOperation define the patch operation structure
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+func init() {
+	router.RegisterAdmission(service)
+}
+
+var service = &router.AdmissionService{
+	Path:   "/pods/groupquota",
+	Func:   AdmitPods,
+	Config: config,
+	MutatingConfig: &whv1.MutatingWebhookConfiguration{
+		Webhooks: []whv1.MutatingWebhook{{
+			Name: "groupquotapod.volcano.sh",
+			Rules: []whv1.RuleWithOperations{
+				{
+					Operations: []whv1.OperationType{whv1.Create},
+					Rule: whv1.Rule{
+						APIGroups:   []string{""},
+						APIVersions: []string{"v1"},
+						Resources:   []string{"pods"},
+					},
+				},
+			},
+		}},
+	},
+}
+
+var config = &router.AdmissionServiceConfig{}
+
+var (
+	listerOnce sync.Once
+	lister     schedulinglisters.GroupQuotaLister
+)
+
+// AdmitPods rejects or gates the pods of the groups over their hard quota.
+func AdmitPods(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	klog.V(3).Infof("admitting pods against group quotas -- %s", ar.Request.Operation)
+
+	if ar.Request.Operation != admissionv1.Create {
+		return util.ToAdmissionResponse(fmt.Errorf("expect operation to be 'CREATE'"))
+	}
+	pod, err := schema.DecodePod(ar.Request.Object, ar.Request.Resource)
+	if err != nil {
+		return util.ToAdmissionResponse(err)
+	}
+	if pod.Namespace == "" {
+		pod.Namespace = ar.Request.Namespace
+	}
+
+	reviewResponse := &admissionv1.AdmissionResponse{Allowed: true}
+	if !slices.Contains(config.SchedulerNames, pod.Spec.SchedulerName) {
+		return reviewResponse
+	}
+
+	annotationKey, action := groupQuotaConfig()
+	group := podGroup(pod, annotationKey)
+	if group == "" {
+		return reviewResponse
+	}
+	gqs, err := listGroupQuotas()
+	if err != nil {
+		// The scheduler still enforces the quota, do not block pod creation on the webhook.
+		klog.Errorf("Failed to list GroupQuotas, admit pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+		return reviewResponse
+	}
+	gq := accounting.GroupQuotaOf(gqs, group)
+	if !accounting.IsOverHardQuota(gq) {
+		return reviewResponse
+	}
+
+	if action == ActionGate {
+		patch, err := json.Marshal(gatePatch(pod, group))
+		if err != nil {
+			return util.ToAdmissionResponse(err)
+		}
+		pt := admissionv1.PatchTypeJSONPatch
+		reviewResponse.Patch = patch
+		reviewResponse.PatchType = &pt
+		klog.V(3).Infof("Gate pod <%s/%s> of group %s over its hard quota", pod.Namespace, pod.Name, group)
+		return reviewResponse
+	}
+
+	reviewResponse.Allowed = false
+	reviewResponse.Result = &metav1.Status{
+		Message: fmt.Sprintf("group %s is over the hard quota of GroupQuota %s", group, gq.Name),
+	}
+	return reviewResponse
+}
+
+// groupQuotaConfig returns the annotation key and the action of the admission.
+func groupQuotaConfig() (string, string) {
+	annotationKey, action := defaultAnnotationKey, ActionReject
+	if config.ConfigData == nil {
+		return annotationKey, action
+	}
+	config.ConfigData.Lock()
+	defer config.ConfigData.Unlock()
+	if gqc := config.ConfigData.GroupQuotaConfig; gqc != nil {
+		if gqc.AnnotationKey != "" {
+			annotationKey = gqc.AnnotationKey
+		}
+		if gqc.Action == ActionGate {
+			action = ActionGate
+		}
+	}
+	return annotationKey, action
+}
+
+// podGroup returns the group of the pod, taken from the pod or else from its PodGroup.
+func podGroup(pod *v1.Pod, annotationKey string) string {
+	if group := accounting.PodGroup(pod, annotationKey); group != "" {
+		return group
+	}
+	pgName := pod.Annotations[vcv1beta1.KubeGroupNameAnnotationKey]
+	if pgName == "" || config.VolcanoClient == nil {
+		return ""
+	}
+	pg, err := config.VolcanoClient.SchedulingV1beta1().PodGroups(pod.Namespace).Get(context.TODO(), pgName, metav1.GetOptions{})
+	if err != nil {
+		klog.V(4).Infof("Failed to get PodGroup <%s/%s> of pod %s: %v", pod.Namespace, pgName, pod.Name, err)
+		return ""
+	}
+	return pg.Annotations[annotationKey]
+}
+
+// listGroupQuotas lists the GroupQuota objects, watching them from the first admission on
+// for the lifetime of the webhook manager. If the first sync fails, e.g. because the CRD is
+// not installed, every pod is admitted until the webhook manager restarts.
+func listGroupQuotas() ([]*vcv1beta1.GroupQuota, error) {
+	listerOnce.Do(func() {
+		if config.VolcanoClient == nil {
+			return
+		}
+		factory := vcinformer.NewSharedInformerFactory(config.VolcanoClient, 0)
+		informer := factory.Scheduling().V1beta1().GroupQuotas()
+		gqLister := informer.Lister()
+		factory.Start(make(chan struct{}))
+
+		timeout := make(chan struct{})
+		timer := time.AfterFunc(syncTimeout, func() { close(timeout) })
+		defer timer.Stop()
+		if cache.WaitForCacheSync(timeout, informer.Informer().HasSynced) {
+			lister = gqLister
+		}
+	})
+	if lister == nil {
+		return nil, fmt.Errorf("GroupQuota cache is not synced")
+	}
+	return lister.List(labels.Everything())
+}
+
+// gatePatch adds the groupquota scheduling gate to the pod, and marks the pod so that the
+// groupquota controller finds it when the group is back under its limits.
+func gatePatch(pod *v1.Pod, group string) []patchOperation {
+	var patch []patchOperation
+
+	gate := v1.PodSchedulingGate{Name: accounting.SchedulingGateName}
+	if len(pod.Spec.SchedulingGates) == 0 {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/schedulingGates", Value: []v1.PodSchedulingGate{gate}})
+	} else {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/schedulingGates/-", Value: gate})
+	}
+
+	if pod.Labels == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/metadata/labels", Value: map[string]string{accounting.GatedLabelKey: "true"}})
+	} else {
+		patch = append(patch, patchOperation{Op: "add", Path: "/metadata/labels/" + escapePath(accounting.GatedLabelKey), Value: "true"})
+	}
+
+	if pod.Annotations == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/metadata/annotations", Value: map[string]string{accounting.GatedGroupAnnotationKey: group}})
+	} else {
+		patch = append(patch, patchOperation{Op: "add", Path: "/metadata/annotations/" + escapePath(accounting.GatedGroupAnnotationKey), Value: group})
+	}
+	return patch
+}
+
+// escapePath escapes a key for use in a JSON patch path.
+func escapePath(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
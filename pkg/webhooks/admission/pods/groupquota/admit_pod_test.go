@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupquota
+
+import (
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	fakevcclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	webconfig "volcano.sh/volcano/pkg/webhooks/config"
+)
+
+func TestAdmitPods(t *testing.T) {
+	groupQuota := func(name, group string, policy vcv1beta1.BorrowingPolicy, usage string) *vcv1beta1.GroupQuota {
+		return &vcv1beta1.GroupQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: vcv1beta1.GroupQuotaSpec{
+				Group:           group,
+				Limits:          api.BuildResourceList("4", "8Gi"),
+				BorrowingPolicy: policy,
+			},
+			Status: vcv1beta1.GroupQuotaStatus{Usage: api.BuildResourceList(usage, "1Gi")},
+		}
+	}
+	config.SchedulerNames = []string{"volcano"}
+	config.VolcanoClient = fakevcclient.NewSimpleClientset(
+		groupQuota("hard-over", "team-a", vcv1beta1.BorrowingPolicyNever, "4"),
+		groupQuota("hard-under", "team-b", vcv1beta1.BorrowingPolicyNever, "2"),
+		groupQuota("soft-over", "team-c", vcv1beta1.BorrowingPolicyAllow, "6"),
+	)
+	defer func() {
+		config.SchedulerNames, config.VolcanoClient, config.ConfigData = nil, nil, nil
+	}()
+
+	tests := []struct {
+		name          string
+		action        string
+		group         string
+		schedulerName string
+		expectAllowed bool
+		expectGated   bool
+	}{
+		{name: "group over its hard quota", group: "team-a", schedulerName: "volcano"},
+		{name: "group under its hard quota", group: "team-b", schedulerName: "volcano", expectAllowed: true},
+		{name: "group allowed to borrow", group: "team-c", schedulerName: "volcano", expectAllowed: true},
+		{name: "group without GroupQuota", group: "team-d", schedulerName: "volcano", expectAllowed: true},
+		{name: "pod of another scheduler", group: "team-a", schedulerName: "default-scheduler", expectAllowed: true},
+		{name: "group over its hard quota gated", action: ActionGate, group: "team-a", schedulerName: "volcano", expectAllowed: true, expectGated: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config.ConfigData = &webconfig.AdmissionConfiguration{
+				GroupQuotaConfig: &webconfig.GroupQuotaConfig{Action: test.action},
+			}
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "ns1",
+					Name:        "pod1",
+					Annotations: map[string]string{defaultAnnotationKey: test.group},
+				},
+				Spec: v1.PodSpec{SchedulerName: test.schedulerName},
+			}
+			raw, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+			review := admissionv1.AdmissionReview{Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Resource:  metav1.GroupVersionResource{Version: "v1", Resource: "pods"},
+				Object:    runtime.RawExtension{Raw: raw},
+			}}
+
+			response := AdmitPods(review)
+			if response.Allowed != test.expectAllowed {
+				t.Fatalf("expected allowed %v, got %v: %v", test.expectAllowed, response.Allowed, response.Result)
+			}
+			if !test.expectGated {
+				if len(response.Patch) > 0 {
+					t.Errorf("expected no patch, got %s", response.Patch)
+				}
+				return
+			}
+
+			var patch []patchOperation
+			if err := json.Unmarshal(response.Patch, &patch); err != nil {
+				t.Fatalf("invalid patch %s: %v", response.Patch, err)
+			}
+			paths := map[string]bool{}
+			for _, op := range patch {
+				paths[op.Path] = true
+			}
+			for _, path := range []string{"/spec/schedulingGates", "/metadata/labels", "/metadata/annotations/volcano.sh~1groupquota-gated-group"} {
+				if !paths[path] {
+					t.Errorf("expected patch of %s, got %s", path, response.Patch)
+				}
+			}
+		})
+	}
+}
@@ -45,10 +45,19 @@ type ResGroupConfig struct {
 	Affinity      string            `yaml:"affinity"`
 }
 
+// GroupQuotaConfig defines the configuration of the groupquota pod admission.
+type GroupQuotaConfig struct {
+	// AnnotationKey is the pod annotation or label holding the group of the pod.
+	AnnotationKey string `yaml:"annotationKey"`
+	// Action is what happens to the pods of a group over its hard quota: Reject or Gate.
+	Action string `yaml:"action"`
+}
+
 // AdmissionConfiguration defines the configuration of admission.
 type AdmissionConfiguration struct {
 	sync.Mutex
-	ResGroupsConfig []ResGroupConfig `yaml:"resourceGroups"`
+	ResGroupsConfig  []ResGroupConfig  `yaml:"resourceGroups"`
+	GroupQuotaConfig *GroupQuotaConfig `yaml:"groupQuota"`
 }
 
 var admissionConf AdmissionConfiguration
@@ -73,6 +82,7 @@ func LoadAdmissionConf(confPath string) *AdmissionConfiguration {
 
 	admissionConf.Lock()
 	admissionConf.ResGroupsConfig = data.ResGroupsConfig
+	admissionConf.GroupQuotaConfig = data.GroupQuotaConfig
 	admissionConf.Unlock()
 	return &admissionConf
 }
@@ -533,3 +533,89 @@ type QueueList struct {
 	// items is the list of PodGroup
 	Items []Queue `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=groupquotas,scope=Cluster,shortName=gq
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="GROUP",type=string,JSONPath=`.spec.group`
+// +kubebuilder:printcolumn:name="OVERQUOTA",type=boolean,JSONPath=`.status.overQuota`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GroupQuota is the resource quota of the jobs of one group, enforced by the groupquota
+// scheduler plugin.
+type GroupQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Specification of the quota of the group.
+	// +optional
+	Spec GroupQuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+
+	// The usage of the group, as accounted by the scheduler.
+	// +optional
+	Status GroupQuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// BorrowingPolicy defines whether a group may use more resources than its quota.
+type BorrowingPolicy string
+
+const (
+	// BorrowingPolicyAllow lets the jobs of a group over its quota run when resources are idle;
+	// they are only ordered behind the jobs of the groups under their quota.
+	BorrowingPolicyAllow BorrowingPolicy = "Allow"
+	// BorrowingPolicyNever rejects the enqueue of the jobs of a group over its quota.
+	BorrowingPolicyNever BorrowingPolicy = "Never"
+)
+
+// GroupQuotaSpec represents the template of GroupQuota.
+type GroupQuotaSpec struct {
+	// Group is the value of the group annotation of the PodGroups the quota applies to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+
+	// Limits is the amount of resources the jobs of the group may use.
+	// +optional
+	Limits v1.ResourceList `json:"limits,omitempty" protobuf:"bytes,2,opt,name=limits"`
+
+	// BorrowingPolicy defines whether the group may use more resources than its limits.
+	// +optional
+	// +kubebuilder:default:=Allow
+	// +kubebuilder:validation:Enum=Allow;Never
+	BorrowingPolicy BorrowingPolicy `json:"borrowingPolicy,omitempty" protobuf:"bytes,3,opt,name=borrowingPolicy"`
+}
+
+// GroupQuotaStatus represents the status of GroupQuota.
+type GroupQuotaStatus struct {
+	// Usage is the amount of resources used by the jobs of the group.
+	// +optional
+	Usage v1.ResourceList `json:"usage,omitempty" protobuf:"bytes,1,opt,name=usage"`
+
+	// OverQuota is whether the usage of the group exceeds its limits.
+	// +optional
+	OverQuota bool `json:"overQuota,omitempty" protobuf:"bytes,2,opt,name=overQuota"`
+
+	// LastUpdateTime is the time the scheduler reported the usage.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// GroupQuotaList is a collection of group quotas.
+type GroupQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// items is the list of GroupQuota
+	Items []GroupQuota `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
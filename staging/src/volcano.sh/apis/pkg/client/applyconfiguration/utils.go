@@ -165,6 +165,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &schedulingv1beta1.AffinityApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Cluster"):
 		return &schedulingv1beta1.ClusterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GroupQuota"):
+		return &schedulingv1beta1.GroupQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GroupQuotaSpec"):
+		return &schedulingv1beta1.GroupQuotaSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GroupQuotaStatus"):
+		return &schedulingv1beta1.GroupQuotaStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Guarantee"):
 		return &schedulingv1beta1.GuaranteeApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("NetworkTopologySpec"):
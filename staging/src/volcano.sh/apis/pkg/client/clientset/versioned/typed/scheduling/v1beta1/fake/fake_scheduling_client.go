@@ -27,6 +27,10 @@ type FakeSchedulingV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSchedulingV1beta1) GroupQuotas() v1beta1.GroupQuotaInterface {
+	return newFakeGroupQuotas(c)
+}
+
 func (c *FakeSchedulingV1beta1) PodGroups(namespace string) v1beta1.PodGroupInterface {
 	return newFakePodGroups(c, namespace)
 }
@@ -27,6 +27,7 @@ import (
 
 type SchedulingV1beta1Interface interface {
 	RESTClient() rest.Interface
+	GroupQuotasGetter
 	PodGroupsGetter
 	QueuesGetter
 }
@@ -36,6 +37,10 @@ type SchedulingV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SchedulingV1beta1Client) GroupQuotas() GroupQuotaInterface {
+	return newGroupQuotas(c)
+}
+
 func (c *SchedulingV1beta1Client) PodGroups(namespace string) PodGroupInterface {
 	return newPodGroups(c, namespace)
 }
@@ -17,6 +17,10 @@ limitations under the License.
 
 package v1beta1
 
+// GroupQuotaListerExpansion allows custom methods to be added to
+// GroupQuotaLister.
+type GroupQuotaListerExpansion interface{}
+
 // PodGroupListerExpansion allows custom methods to be added to
 // PodGroupLister.
 type PodGroupListerExpansion interface{}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulingplugin
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	e2eutil "volcano.sh/volcano/test/e2e/util"
+)
+
+var _ = Describe("GroupQuota Plugin E2E on multiple nodes and scalar resources", func() {
+	It("prioritizes under-quota groups across multiple nodes", func() {
+		cmc := configureGroupQuota(map[string]interface{}{
+			"annotationKey": groupAnnotationKey,
+			"resourceMap": map[string]string{
+				"cpu": "2",
+			},
+		})
+		defer cmc.UndoChanged()
+
+		ctx := e2eutil.InitTestContext(e2eutil.Options{
+			NodesNumLimit:      2,
+			NodesResourceLimit: e2eutil.CPU2Mem2,
+		})
+		defer e2eutil.CleanupTestContext(ctx)
+
+		// The holder fills one node and uses the whole quota of team-a.
+		holderJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-mn-holder", e2eutil.CPU2Mem2, 1, false),
+			"", map[string]string{groupAnnotationKey: "team-a"})
+		err := e2eutil.WaitJobReady(ctx, holderJob)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Only one of the two jobs fits on the remaining node.
+		teamAJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-mn-team-a", e2eutil.CPU2Mem2, 1, true),
+			"", map[string]string{groupAnnotationKey: "team-a"})
+		teamBJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-mn-team-b", e2eutil.CPU2Mem2, 1, true),
+			"", map[string]string{groupAnnotationKey: "team-b"})
+		releaseGatedJobs(ctx, teamAJob, teamBJob)
+
+		err = e2eutil.WaitJobReady(ctx, teamBJob)
+		Expect(err).NotTo(HaveOccurred())
+		err = e2eutil.WaitJobStatePending(ctx, teamAJob)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("enforces quota on extended resources", func() {
+		cmc := configureGroupQuota(map[string]interface{}{
+			"annotationKey": groupAnnotationKey,
+			"resourceMap": map[string]string{
+				string(e2eutil.FakeGPUResource): "2",
+			},
+		})
+		defer cmc.UndoChanged()
+
+		ctx := e2eutil.InitTestContext(e2eutil.Options{})
+		defer e2eutil.CleanupTestContext(ctx)
+
+		nodes := e2eutil.SchedulableNodes(ctx)
+		Expect(nodes).NotTo(BeEmpty())
+		err := e2eutil.AddNodeScalarResource(ctx, nodes[0], e2eutil.FakeGPUResource, "4")
+		Expect(err).NotTo(HaveOccurred())
+		defer func() {
+			err := e2eutil.RemoveNodeScalarResource(ctx, nodes[0], e2eutil.FakeGPUResource)
+			Expect(err).NotTo(HaveOccurred())
+		}()
+
+		gpuReq := e2eutil.WithScalarResource(e2eutil.HalfCPU, e2eutil.FakeGPUResource, "2")
+
+		holderJob := e2eutil.CreateJobWithScalarResources(ctx, groupQuotaJobSpec("groupquota-gpu-holder", gpuReq, 1, false),
+			"", map[string]string{groupAnnotationKey: "team-a"})
+		err = e2eutil.WaitJobReady(ctx, holderJob)
+		Expect(err).NotTo(HaveOccurred())
+
+		teamAJob := e2eutil.CreateJobWithScalarResources(ctx, groupQuotaJobSpec("groupquota-gpu-team-a", gpuReq, 1, true),
+			"", map[string]string{groupAnnotationKey: "team-a"})
+		teamBJob := e2eutil.CreateJobWithScalarResources(ctx, groupQuotaJobSpec("groupquota-gpu-team-b", gpuReq, 1, true),
+			"", map[string]string{groupAnnotationKey: "team-b"})
+		releaseGatedJobs(ctx, teamAJob, teamBJob)
+
+		err = e2eutil.WaitJobReady(ctx, teamBJob)
+		Expect(err).NotTo(HaveOccurred())
+		err = e2eutil.WaitJobStatePending(ctx, teamAJob)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lets a group borrow idle resources and reclaims them for an under-quota group", func() {
+		cmc := configureGroupQuota(map[string]interface{}{
+			"annotationKey":    groupAnnotationKey,
+			"preemptOverQuota": true,
+			"resourceMap": map[string]string{
+				"cpu": "1",
+			},
+		})
+		defer cmc.UndoChanged()
+
+		ctx := e2eutil.InitTestContext(e2eutil.Options{
+			NodesNumLimit:      1,
+			NodesResourceLimit: e2eutil.CPU2Mem2,
+		})
+		defer e2eutil.CleanupTestContext(ctx)
+
+		// team-a borrows the whole node although its quota is a single cpu.
+		borrowerJob := e2eutil.CreateJobWithPodGroup(ctx, &e2eutil.JobSpec{
+			Name: "groupquota-borrower",
+			Tasks: []e2eutil.TaskSpec{
+				{
+					Img:     e2eutil.DefaultNginxImage,
+					Req:     e2eutil.CPU1Mem1,
+					Min:     1,
+					Rep:     2,
+					Command: "sleep 300",
+				},
+			},
+		}, "", map[string]string{groupAnnotationKey: "team-a"})
+		err := e2eutil.WaitTasksReady(ctx, borrowerJob, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		teamBJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-reclaimer", e2eutil.CPU1Mem1, 1, false),
+			"", map[string]string{groupAnnotationKey: "team-b"})
+		err = e2eutil.WaitJobReady(ctx, teamBJob)
+		Expect(err).NotTo(HaveOccurred())
+		err = e2eutil.WaitTasksReady(ctx, borrowerJob, 1)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reclaims the tasks that overran their max run time", func() {
+		const longQueue, competitorQueue = "groupquota-long", "groupquota-competitor"
+		cmc := configureGroupQuota(map[string]interface{}{
+			"annotationKey": groupAnnotationKey,
+			"resourceMap": map[string]string{
+				"cpu": "4",
+			},
+			"maxRunTime": map[string]interface{}{},
+		})
+		defer cmc.UndoChanged()
+
+		ctx := e2eutil.InitTestContext(e2eutil.Options{
+			Queues:             []string{longQueue, competitorQueue},
+			NodesNumLimit:      1,
+			NodesResourceLimit: e2eutil.CPU2Mem2,
+		})
+		defer e2eutil.CleanupTestContext(ctx)
+
+		// The long job fills the node and may only run for 30s.
+		longJob := e2eutil.CreateJob(ctx, &e2eutil.JobSpec{
+			Name:  "groupquota-long",
+			Queue: longQueue,
+			Tasks: []e2eutil.TaskSpec{
+				{
+					Img:         e2eutil.DefaultNginxImage,
+					Req:         e2eutil.CPU1Mem1,
+					Min:         1,
+					Rep:         2,
+					Command:     "sleep 3600",
+					Annotations: map[string]string{"volcano.sh/max-run-time": "30s"},
+				},
+			},
+		})
+		err := e2eutil.WaitTasksReady(ctx, longJob, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		since := time.Now()
+		competitorJob := e2eutil.CreateJob(ctx, &e2eutil.JobSpec{
+			Name:  "groupquota-competitor",
+			Queue: competitorQueue,
+			Tasks: []e2eutil.TaskSpec{
+				{
+					Img:     e2eutil.DefaultNginxImage,
+					Req:     e2eutil.CPU1Mem1,
+					Min:     1,
+					Rep:     1,
+					Command: "sleep 300",
+				},
+			},
+		})
+
+		victim, err := e2eutil.WaitTaskEvicted(ctx, longJob, since)
+		Expect(err).NotTo(HaveOccurred())
+		By("Pod " + victim + " of the long job was evicted")
+		err = e2eutil.WaitJobReady(ctx, competitorJob)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("reads the group from a custom annotation key", func() {
+		const customAnnotationKey = "team.example.com/name"
+		cmc := configureGroupQuota(map[string]interface{}{
+			"annotationKey": customAnnotationKey,
+			"resourceMap": map[string]string{
+				"cpu": "1",
+			},
+		})
+		defer cmc.UndoChanged()
+
+		ctx := e2eutil.InitTestContext(e2eutil.Options{
+			NodesNumLimit:      1,
+			NodesResourceLimit: e2eutil.CPU2Mem2,
+		})
+		defer e2eutil.CleanupTestContext(ctx)
+
+		holderJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-key-holder", e2eutil.CPU1Mem1, 1, false),
+			"", map[string]string{customAnnotationKey: "team-a"})
+		err := e2eutil.WaitJobReady(ctx, holderJob)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The default annotation key is ignored, so this job is not accounted to team-a.
+		teamAJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-key-team-a", e2eutil.CPU1Mem1, 1, true),
+			"", map[string]string{customAnnotationKey: "team-a", groupAnnotationKey: "team-b"})
+		teamBJob := e2eutil.CreateJobWithPodGroup(ctx, groupQuotaJobSpec("groupquota-key-team-b", e2eutil.CPU1Mem1, 1, true),
+			"", map[string]string{customAnnotationKey: "team-b"})
+		releaseGatedJobs(ctx, teamAJob, teamBJob)
+
+		err = e2eutil.WaitJobReady(ctx, teamBJob)
+		Expect(err).NotTo(HaveOccurred())
+		err = e2eutil.WaitJobStatePending(ctx, teamAJob)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+// configureGroupQuota replaces the groupquota arguments in the scheduler configuration.
+// Callers must undo the change with UndoChanged.
+func configureGroupQuota(args map[string]interface{}) *e2eutil.ConfigMapCase {
+	cmc := e2eutil.NewConfigMapCase("volcano-system", "integration-scheduler-configmap")
+	modifier := func(sc *e2eutil.SchedulerConfiguration) bool {
+		return upsertPlugin(sc, e2eutil.PluginOption{
+			Name:      groupQuotaPluginName,
+			Arguments: args,
+		})
+	}
+	cmc.ChangeBy(func(data map[string]string) (changed bool, changedBefore map[string]string) {
+		return e2eutil.ModifySchedulerConfig(data, modifier)
+	})
+	return cmc
+}
+
+// groupQuotaJobSpec returns a job of rep tasks each requesting req. Gated jobs stay pending
+// until released with releaseGatedJobs, so that several jobs are considered in one session.
+func groupQuotaJobSpec(name string, req corev1.ResourceList, rep int32, gated bool) *e2eutil.JobSpec {
+	task := e2eutil.TaskSpec{
+		Img:     e2eutil.DefaultNginxImage,
+		Req:     req,
+		Min:     rep,
+		Rep:     rep,
+		Command: "sleep 300",
+	}
+	if gated {
+		task.SchGates = []corev1.PodSchedulingGate{{Name: "gate"}}
+	}
+	return &e2eutil.JobSpec{
+		Name:  name,
+		Tasks: []e2eutil.TaskSpec{task},
+	}
+}
+
+// releaseGatedJobs waits for the gated jobs to be created and then removes their scheduling gates.
+func releaseGatedJobs(ctx *e2eutil.TestContext, jobs ...*batchv1alpha1.Job) {
+	for _, job := range jobs {
+		err := e2eutil.WaitTasksPending(ctx, job, int(job.Spec.MinAvailable))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	for _, job := range jobs {
+		err := e2eutil.RemovePodSchGates(ctx, job)
+		Expect(err).NotTo(HaveOccurred())
+	}
+}
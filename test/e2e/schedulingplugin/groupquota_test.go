@@ -124,6 +124,8 @@ var _ = Describe("GroupQuota Plugin E2E", func() {
 			"resourceMap": map[string]string{
 				"cpu": "4",
 			},
+			// The jobs of the group are ordered first come, first served.
+			"intraGroupOrder": "fifo",
 		}
 		modifier := func(sc *e2eutil.SchedulerConfiguration) bool {
 			return upsertPlugin(sc, e2eutil.PluginOption{
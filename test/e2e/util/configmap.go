@@ -18,6 +18,7 @@ package util
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/onsi/gomega"
@@ -151,6 +152,24 @@ func (t Tier) GetPluginIdxOf(name string) int {
 	return -1
 }
 
+// SetPluginArguments sets the arguments of the plugin in the tier of the given index, adding
+// the plugin at the end of the tier, and the missing tiers, if the plugin is not in it.
+func (sc *SchedulerConfiguration) SetPluginArguments(tier int, name string, arguments map[string]interface{}) bool {
+	for len(sc.Tiers) <= tier {
+		sc.Tiers = append(sc.Tiers, Tier{})
+	}
+	idx := sc.Tiers[tier].GetPluginIdxOf(name)
+	if idx < 0 {
+		sc.Tiers[tier].Plugins = append(sc.Tiers[tier].Plugins, PluginOption{Name: name, Arguments: arguments})
+		return true
+	}
+	if reflect.DeepEqual(sc.Tiers[tier].Plugins[idx].Arguments, arguments) {
+		return false
+	}
+	sc.Tiers[tier].Plugins[idx].Arguments = arguments
+	return true
+}
+
 // Configuration is configuration of action
 type Configuration struct {
 	// Name is name of action
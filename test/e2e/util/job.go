@@ -56,6 +56,8 @@ type TaskSpec struct {
 	MaxRetry              int32
 	SchGates              []v1.PodSchedulingGate
 	PartitionPolicy       *batchv1alpha1.PartitionPolicySpec
+	// Annotations are the annotations of the pods of the task.
+	Annotations map[string]string
 }
 
 type JobSpec struct {
@@ -76,6 +78,8 @@ type JobSpec struct {
 	MaxRetry int32
 	// network topology mode hard or soft
 	NetworkTopology *batchv1alpha1.NetworkTopologySpec
+	// PodGroupAnnotations are the annotations of the job, which the job controller copies to its PodGroup.
+	PodGroupAnnotations map[string]string
 }
 
 func Namespace(context *TestContext, job *JobSpec) string {
@@ -130,8 +134,9 @@ func CreateJobWithPodGroup(ctx *TestContext, jobSpec *JobSpec,
 			PartitionPolicy: task.PartitionPolicy,
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:   name,
-					Labels: task.Labels,
+					Name:        name,
+					Labels:      task.Labels,
+					Annotations: copyAnnotations(task.Annotations),
 				},
 				Spec: v1.PodSpec{
 					SchedulerName:     "volcano",
@@ -145,7 +150,10 @@ func CreateJobWithPodGroup(ctx *TestContext, jobSpec *JobSpec,
 		}
 
 		if pgName != "" {
-			ts.Template.ObjectMeta.Annotations = map[string]string{schedulingv1beta1.KubeGroupNameAnnotationKey: pgName}
+			if ts.Template.ObjectMeta.Annotations == nil {
+				ts.Template.ObjectMeta.Annotations = map[string]string{}
+			}
+			ts.Template.ObjectMeta.Annotations[schedulingv1beta1.KubeGroupNameAnnotationKey] = pgName
 		}
 
 		if task.DefaultGracefulPeriod != nil {
@@ -196,8 +204,9 @@ func CreateJobInner(ctx *TestContext, jobSpec *JobSpec) (*batchv1alpha1.Job, err
 
 	job := &batchv1alpha1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobSpec.Name,
-			Namespace: ns,
+			Name:        jobSpec.Name,
+			Namespace:   ns,
+			Annotations: copyAnnotations(jobSpec.PodGroupAnnotations),
 		},
 		Spec: batchv1alpha1.JobSpec{
 			SchedulerName:           "volcano",
@@ -238,8 +247,9 @@ func CreateJobInner(ctx *TestContext, jobSpec *JobSpec) (*batchv1alpha1.Job, err
 			PartitionPolicy: task.PartitionPolicy,
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:   name,
-					Labels: task.Labels,
+					Name:        name,
+					Labels:      task.Labels,
+					Annotations: copyAnnotations(task.Annotations),
 				},
 				Spec: v1.PodSpec{
 					RestartPolicy:     restartPolicy,
@@ -283,6 +293,19 @@ func CreateJobInner(ctx *TestContext, jobSpec *JobSpec) (*batchv1alpha1.Job, err
 	return ctx.Vcclient.BatchV1alpha1().Jobs(job.Namespace).Create(context.TODO(), job, metav1.CreateOptions{})
 }
 
+// copyAnnotations returns a copy of the annotations, so that specs shared by several jobs
+// are not modified when annotations are added to one of them.
+func copyAnnotations(annotations map[string]string) map[string]string {
+	if annotations == nil {
+		return nil
+	}
+	result := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		result[k] = v
+	}
+	return result
+}
+
 func WaitTaskPhase(ctx *TestContext, job *batchv1alpha1.Job, phase []v1.PodPhase, taskNum int) error {
 	var additionalError error
 	var podNotReadyCache map[string]*v1.Pod
@@ -442,6 +465,31 @@ func JobEvicted(ctx *TestContext, job *batchv1alpha1.Job, time time.Time) wait.C
 	}
 }
 
+// WaitTaskEvicted waits for the scheduler to evict a pod of the job after since, and returns
+// the name of the evicted pod.
+func WaitTaskEvicted(ctx *TestContext, job *batchv1alpha1.Job, since time.Time) (string, error) {
+	var evicted string
+	err := wait.Poll(100*time.Millisecond, FiveMinute, func() (bool, error) {
+		events, err := ctx.Kubeclient.CoreV1().Events(job.Namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return false, nil
+		}
+		for _, event := range events.Items {
+			target := event.InvolvedObject
+			if target.Kind == "Pod" && strings.HasPrefix(target.Name, job.Name+"-") &&
+				event.Reason == "Evict" && !event.LastTimestamp.Time.Before(since) {
+				evicted = target.Name
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil && strings.Contains(err.Error(), TimeOutMessage) {
+		return "", fmt.Errorf("[Wait time out]: expected a pod of job %s to be evicted, actual got nothing", job.Name)
+	}
+	return evicted, err
+}
+
 func WaitJobPhases(ctx *TestContext, job *batchv1alpha1.Job, phases []batchv1alpha1.JobPhase) error {
 	w, err := ctx.Vcclient.BatchV1alpha1().Jobs(job.Namespace).Watch(context.TODO(), metav1.ListOptions{})
 	if err != nil {
@@ -537,6 +585,51 @@ func WaitJobReady(ctx *TestContext, job *batchv1alpha1.Job) error {
 	return WaitTasksReady(ctx, job, int(job.Spec.MinAvailable))
 }
 
+// JobScheduledTime returns the earliest time a pod of the Job was scheduled, and false if
+// none of its pods is scheduled yet.
+func JobScheduledTime(ctx *TestContext, job *batchv1alpha1.Job) (time.Time, bool) {
+	pods, err := ctx.Kubeclient.CoreV1().Pods(job.Namespace).List(context.TODO(), metav1.ListOptions{})
+	Expect(err).NotTo(HaveOccurred(), "failed to list pods in namespace %s", job.Namespace)
+
+	var scheduled time.Time
+	for _, pod := range pods.Items {
+		if !metav1.IsControlledBy(&pod, job) {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type != v1.PodScheduled || cond.Status != v1.ConditionTrue {
+				continue
+			}
+			if scheduled.IsZero() || cond.LastTransitionTime.Time.Before(scheduled) {
+				scheduled = cond.LastTransitionTime.Time
+			}
+		}
+	}
+	return scheduled, !scheduled.IsZero()
+}
+
+// WaitJobsReadyInOrder waits for the Jobs to be ready, and checks that they were scheduled in
+// the given order. Jobs scheduled within the same second are considered in order, as the
+// scheduled time of pods has a precision of one second.
+func WaitJobsReadyInOrder(ctx *TestContext, jobs ...*batchv1alpha1.Job) error {
+	var previous time.Time
+	for i, job := range jobs {
+		if err := WaitJobReady(ctx, job); err != nil {
+			return err
+		}
+		scheduled, found := JobScheduledTime(ctx, job)
+		if !found {
+			return fmt.Errorf("job %s is ready but none of its pods is scheduled", job.Name)
+		}
+		if i > 0 && scheduled.Before(previous) {
+			return fmt.Errorf("expected job %s to be scheduled after job %s, actual scheduled at %v before %v",
+				job.Name, jobs[i-1].Name, scheduled, previous)
+		}
+		previous = scheduled
+	}
+	return nil
+}
+
 // WaitJobPending waits for the Job to be pending
 func WaitJobPending(ctx *TestContext, job *batchv1alpha1.Job) error {
 	return WaitTaskPhase(ctx, job, []v1.PodPhase{v1.PodPending}, int(job.Spec.MinAvailable))
@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/cli/podgroup"
 )
@@ -103,3 +104,60 @@ func GetPodGroupStatistics(ctx *TestContext, namespace, queue string) *podgroup.
 	}
 	return pgStats
 }
+
+// JobPodGroupName returns the name of the PodGroup created by the job controller for the job.
+func JobPodGroupName(job *batchv1alpha1.Job) string {
+	return job.Name + "-" + string(job.UID)
+}
+
+// WaitPodGroupCondition waits for the PodGroup to have a true condition of the type, with the
+// reason if it is not empty, and returns the condition found.
+func WaitPodGroupCondition(ctx *TestContext, namespace, name string, condType schedulingv1beta1.PodGroupConditionType,
+	reason string) (*schedulingv1beta1.PodGroupCondition, error) {
+	var found *schedulingv1beta1.PodGroupCondition
+	var additionalError error
+	err := wait.Poll(100*time.Millisecond, FiveMinute, func() (bool, error) {
+		pg, err := ctx.Vcclient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			additionalError = fmt.Errorf("expected to have podgroup %s, actual got error %s", name, err.Error())
+			return false, nil
+		}
+		for i, cond := range pg.Status.Conditions {
+			if cond.Type == condType && cond.Status == v1.ConditionTrue && (reason == "" || cond.Reason == reason) {
+				found = &pg.Status.Conditions[i]
+				return true, nil
+			}
+		}
+		additionalError = fmt.Errorf("expected podgroup %s to have condition %s with reason %q, actual got %v",
+			name, condType, reason, pg.Status.Conditions)
+		return false, nil
+	})
+	if err != nil && strings.Contains(err.Error(), TimeOutMessage) {
+		return nil, fmt.Errorf("[Wait time out]: %s", additionalError)
+	}
+	return found, err
+}
+
+// WaitPodGroupEvent waits for an event of the reason on the PodGroup recorded after since,
+// and returns the event found.
+func WaitPodGroupEvent(ctx *TestContext, namespace, name, reason string, since time.Time) (*v1.Event, error) {
+	var found *v1.Event
+	err := wait.Poll(100*time.Millisecond, FiveMinute, func() (bool, error) {
+		events, err := ctx.Kubeclient.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return false, nil
+		}
+		for i, event := range events.Items {
+			target := event.InvolvedObject
+			if target.Kind == "PodGroup" && target.Name == name && event.Reason == reason && !event.LastTimestamp.Time.Before(since) {
+				found = &events.Items[i]
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil && strings.Contains(err.Error(), TimeOutMessage) {
+		return nil, fmt.Errorf("[Wait time out]: expected to have %s event for podgroup %s, actual got nothing", reason, name)
+	}
+	return found, err
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/gomega"
+	schedv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/runtime"
+)
+
+// CreatePriorityClass creates a PriorityClass outside of the test context, e.g. in a single
+// test case. PriorityClasses shared by a whole suite are better given in Options.PriorityClasses.
+func CreatePriorityClass(ctx *TestContext, name string, value int32) *schedv1.PriorityClass {
+	pc, err := ctx.Kubeclient.SchedulingV1().PriorityClasses().Create(context.TODO(),
+		&schedv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Value:         value,
+			GlobalDefault: false,
+		},
+		metav1.CreateOptions{})
+	Expect(err).NotTo(HaveOccurred(), "failed to create priority class: %s", name)
+	return pc
+}
+
+// DeletePriorityClass deletes a PriorityClass created by CreatePriorityClass, it is not an
+// error if the PriorityClass is already gone.
+func DeletePriorityClass(ctx *TestContext, name string) {
+	err := ctx.Kubeclient.SchedulingV1().PriorityClasses().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return
+	}
+	Expect(err).NotTo(HaveOccurred(), "failed to delete priority class: %s", name)
+}
+
+// MaxRunTimeAnnotations returns the annotations limiting the run time of a job, when set in
+// JobSpec.PodGroupAnnotations, or of a task, when set in TaskSpec.Annotations.
+func MaxRunTimeAnnotations(maxRunTime time.Duration) map[string]string {
+	return map[string]string{runtime.MaxRunTimeAnnotation: maxRunTime.String()}
+}
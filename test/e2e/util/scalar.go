@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/gomega"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+)
+
+// FakeGPUResource is the extended resource advertised by AddNodeScalarResource in place of a GPU
+// device plugin, so that GPU scenarios can run on clusters without GPUs.
+const FakeGPUResource v1.ResourceName = "example.com/gpu"
+
+// WithScalarResource returns a copy of req that additionally requests quantity of the scalar resource.
+func WithScalarResource(req v1.ResourceList, name v1.ResourceName, quantity string) v1.ResourceList {
+	result := req.DeepCopy()
+	if result == nil {
+		result = v1.ResourceList{}
+	}
+	result[name] = resource.MustParse(quantity)
+	return result
+}
+
+// SchedulableNodes returns the names of the nodes without taints.
+func SchedulableNodes(ctx *TestContext) []string {
+	nodes, err := ctx.Kubeclient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	Expect(err).NotTo(HaveOccurred(), "failed to list nodes")
+
+	var names []string
+	for _, node := range nodes.Items {
+		if len(node.Spec.Taints) != 0 || !IsNodeReady(&node) {
+			continue
+		}
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+// AddNodeScalarResource advertises quantity of the scalar resource in the capacity and allocatable
+// of the node, the same way a device plugin would.
+func AddNodeScalarResource(ctx *TestContext, nodeName string, name v1.ResourceName, quantity string) error {
+	path := escapeJSONPointer(string(name))
+	patch := []map[string]string{
+		{"op": "add", "path": "/status/capacity/" + path, "value": quantity},
+		{"op": "add", "path": "/status/allocatable/" + path, "value": quantity},
+	}
+	return patchNodeStatus(ctx, nodeName, patch)
+}
+
+// RemoveNodeScalarResource removes the scalar resource added by AddNodeScalarResource from the node.
+func RemoveNodeScalarResource(ctx *TestContext, nodeName string, name v1.ResourceName) error {
+	path := escapeJSONPointer(string(name))
+	patch := []map[string]string{
+		{"op": "remove", "path": "/status/capacity/" + path},
+		{"op": "remove", "path": "/status/allocatable/" + path},
+	}
+	return patchNodeStatus(ctx, nodeName, patch)
+}
+
+// CreateJobWithScalarResources creates a job whose tasks request scalar resources. Kubernetes requires
+// the limit of an extended resource to equal its request, so the scalar requests are copied to the limits.
+func CreateJobWithScalarResources(ctx *TestContext, jobSpec *JobSpec,
+	pgName string, annotations map[string]string) *batchv1alpha1.Job {
+	for i := range jobSpec.Tasks {
+		task := &jobSpec.Tasks[i]
+		for name, quantity := range task.Req {
+			if name == v1.ResourceCPU || name == v1.ResourceMemory {
+				continue
+			}
+			if task.Limit == nil {
+				task.Limit = v1.ResourceList{}
+			}
+			task.Limit[name] = quantity
+		}
+	}
+	return CreateJobWithPodGroup(ctx, jobSpec, pgName, annotations)
+}
+
+func patchNodeStatus(ctx *TestContext, nodeName string, patch []map[string]string) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to encode patch for node %s: %v", nodeName, err)
+	}
+	_, err = ctx.Kubeclient.CoreV1().Nodes().Patch(context.TODO(), nodeName, types.JSONPatchType, data, metav1.PatchOptions{}, "status")
+	return err
+}
+
+// escapeJSONPointer escapes a resource name to be used as one segment of a JSON pointer.
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}